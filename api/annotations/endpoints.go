@@ -0,0 +1,12 @@
+package annotations
+
+// EndpointWeight is the annotation key for the load balancing weight of the endpoints of a Pod.
+// It can also be set as a label on the Pod, the annotation taking precedence over the label.
+// The value is an integer between 1 and 65535, e.g. "2" to send twice as many requests to the endpoints of the Pod
+// as to the endpoints of Pods without a weight, which have a weight of 1.
+// It is useful to balance the traffic across heterogeneous instance sizes, or to send a fraction of it to canary Pods.
+// The weight applies to the endpoints of the Services, and to the endpoints of the ServiceImports in the local cluster.
+// The slow start of the new endpoints is not configured per Pod, as Envoy applies it to all the endpoints of a backend:
+// it is set with the slowStart of the roundRobin or leastRequest load balancer of a BackendConfigPolicy, and scales
+// the weight of the endpoints during the slow start window.
+const EndpointWeight = "kgateway.dev/endpoint-weight"
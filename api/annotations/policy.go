@@ -5,6 +5,13 @@ const (
 	// the priority of corresponding policies attached that are inherited by attached routes or child routes respectively.
 	InheritedPolicyPriority = "kgateway.dev/inherited-policy-priority"
 
+	// InheritedPolicyPriorityFieldPrefix is the prefix of the annotations that can be set on a TrafficPolicy
+	// to specify the priority of an individual policy field when it is inherited by child routes, e.g.,
+	// "kgateway.dev/inherited-policy-priority.headerModifiers: DeepMergePreferParent".
+	// It takes precedence over the InheritedPolicyPriority annotation on the parent resource for that field,
+	// and accepts the same values.
+	InheritedPolicyPriorityFieldPrefix = InheritedPolicyPriority + "."
+
 	// PolicyPrecedenceWeight is an annotation that can be set on a policy CR to specify the weight of
 	// the policy as an integer value (negative values are allowed).
 	// Policies with higher weight implies higher priority, and are evaluated before policies with lower weight.
@@ -20,6 +27,12 @@ const (
 	// This is useful for cases where you want to disable Istio auto-mTLS for a specific backend, but still use other TLS mechanisms
 	// (by applying a BackendConfigPolicy or BackendTLSPolicy).
 	DisableIstioAutoMTLS = "kgateway.dev/disable-istio-auto-mtls"
+
+	// GlobalPolicyTier is an annotation that can be set on a TrafficPolicy or HTTPListenerPolicy defined in the
+	// global policy namespace (see Settings.GlobalPolicyNamespace) to specify how the policy is prioritized relative
+	// to policies defined in the namespace of the targeted resource.
+	// The annotation is ignored on policies that are not defined in the global policy namespace.
+	GlobalPolicyTier = "kgateway.dev/global-policy-tier"
 )
 
 // InheritedPolicyPriorityValue is the value for the InheritedPolicyPriority annotation
@@ -46,3 +59,18 @@ const (
 	// (attached to the Gateway or parent HTTPRoute) in case of conflicts.
 	DeepMergePreferChild InheritedPolicyPriorityValue = "DeepMergePreferChild"
 )
+
+// GlobalPolicyTierValue is the value for the GlobalPolicyTier annotation
+type GlobalPolicyTierValue string
+
+const (
+	// GlobalPolicyTierOverride is the value for the GlobalPolicyTier annotation to indicate that
+	// the global policy is a platform baseline: fields set by it take precedence over the same fields
+	// set by any other policy attached to the target, regardless of policy weight or merge strategy.
+	GlobalPolicyTierOverride GlobalPolicyTierValue = "Override"
+
+	// GlobalPolicyTierDefault is the value for the GlobalPolicyTier annotation to indicate that
+	// the global policy only provides defaults: fields set by it are only applied when they are not
+	// set by any other policy attached to the target.
+	GlobalPolicyTierDefault GlobalPolicyTierValue = "Default"
+)
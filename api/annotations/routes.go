@@ -6,4 +6,9 @@ const (
 	// Routes with higher weight implies higher priority, and are evaluated before routes with lower weight.
 	// By default, routes have a weight of 0.
 	RoutePrecedenceWeight = "kgateway.dev/route-weight"
+
+	// UDPRouteIdleTimeout is an annotation that can be set on a UDPRoute to specify the duration,
+	// e.g. "30s", after which a UDP session with no traffic in either direction is closed.
+	// By default, UDP sessions are closed after 60s of inactivity.
+	UDPRouteIdleTimeout = "kgateway.dev/udp-idle-timeout"
 )
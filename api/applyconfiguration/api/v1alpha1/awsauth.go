@@ -11,8 +11,10 @@ import (
 // AwsAuthApplyConfiguration represents a declarative configuration of the AwsAuth type for use
 // with apply.
 type AwsAuthApplyConfiguration struct {
-	Type      *apiv1alpha1.AwsAuthType `json:"type,omitempty"`
-	SecretRef *v1.LocalObjectReference `json:"secretRef,omitempty"`
+	Type        *apiv1alpha1.AwsAuthType          `json:"type,omitempty"`
+	SecretRef   *v1.LocalObjectReference          `json:"secretRef,omitempty"`
+	WebIdentity *AwsWebIdentityApplyConfiguration `json:"webIdentity,omitempty"`
+	AssumeRole  *AwsAssumeRoleApplyConfiguration  `json:"assumeRole,omitempty"`
 }
 
 // AwsAuthApplyConfiguration constructs a declarative configuration of the AwsAuth type for use with
@@ -36,3 +38,19 @@ func (b *AwsAuthApplyConfiguration) WithSecretRef(value v1.LocalObjectReference)
 	b.SecretRef = &value
 	return b
 }
+
+// WithWebIdentity sets the WebIdentity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WebIdentity field is set to the value of the last call.
+func (b *AwsAuthApplyConfiguration) WithWebIdentity(value *AwsWebIdentityApplyConfiguration) *AwsAuthApplyConfiguration {
+	b.WebIdentity = value
+	return b
+}
+
+// WithAssumeRole sets the AssumeRole field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AssumeRole field is set to the value of the last call.
+func (b *AwsAuthApplyConfiguration) WithAssumeRole(value *AwsAssumeRoleApplyConfiguration) *AwsAuthApplyConfiguration {
+	b.AssumeRole = value
+	return b
+}
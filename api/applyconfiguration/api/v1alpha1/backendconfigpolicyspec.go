@@ -19,6 +19,7 @@ type BackendConfigPolicySpecApplyConfiguration struct {
 	Http2ProtocolOptions          *Http2ProtocolOptionsApplyConfiguration        `json:"http2ProtocolOptions,omitempty"`
 	TLS                           *TLSApplyConfiguration                         `json:"tls,omitempty"`
 	LoadBalancer                  *LoadBalancerApplyConfiguration                `json:"loadBalancer,omitempty"`
+	LocalityFailover              *LocalityFailoverApplyConfiguration            `json:"localityFailover,omitempty"`
 	HealthCheck                   *HealthCheckApplyConfiguration                 `json:"healthCheck,omitempty"`
 	OutlierDetection              *OutlierDetectionApplyConfiguration            `json:"outlierDetection,omitempty"`
 }
@@ -119,6 +120,14 @@ func (b *BackendConfigPolicySpecApplyConfiguration) WithLoadBalancer(value *Load
 	return b
 }
 
+// WithLocalityFailover sets the LocalityFailover field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LocalityFailover field is set to the value of the last call.
+func (b *BackendConfigPolicySpecApplyConfiguration) WithLocalityFailover(value *LocalityFailoverApplyConfiguration) *BackendConfigPolicySpecApplyConfiguration {
+	b.LocalityFailover = value
+	return b
+}
+
 // WithHealthCheck sets the HealthCheck field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the HealthCheck field is set to the value of the last call.
@@ -15,6 +15,8 @@ type BackendSpecApplyConfiguration struct {
 	Static              *StaticBackendApplyConfiguration              `json:"static,omitempty"`
 	DynamicForwardProxy *DynamicForwardProxyBackendApplyConfiguration `json:"dynamicForwardProxy,omitempty"`
 	MCP                 *MCPApplyConfiguration                        `json:"mcp,omitempty"`
+	Gcp                 *GcpBackendApplyConfiguration                 `json:"gcp,omitempty"`
+	Azure               *AzureBackendApplyConfiguration               `json:"azure,omitempty"`
 }
 
 // BackendSpecApplyConfiguration constructs a declarative configuration of the BackendSpec type for use with
@@ -70,3 +72,19 @@ func (b *BackendSpecApplyConfiguration) WithMCP(value *MCPApplyConfiguration) *B
 	b.MCP = value
 	return b
 }
+
+// WithGcp sets the Gcp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Gcp field is set to the value of the last call.
+func (b *BackendSpecApplyConfiguration) WithGcp(value *GcpBackendApplyConfiguration) *BackendSpecApplyConfiguration {
+	b.Gcp = value
+	return b
+}
+
+// WithAzure sets the Azure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Azure field is set to the value of the last call.
+func (b *BackendSpecApplyConfiguration) WithAzure(value *AzureBackendApplyConfiguration) *BackendSpecApplyConfiguration {
+	b.Azure = value
+	return b
+}
@@ -25,6 +25,7 @@ type HTTPListenerPolicySpecApplyConfiguration struct {
 	PreserveHttp1HeaderCase    *bool                                          `json:"preserveHttp1HeaderCase,omitempty"`
 	AcceptHttp10               *bool                                          `json:"acceptHttp10,omitempty"`
 	DefaultHostForHttp10       *string                                        `json:"defaultHostForHttp10,omitempty"`
+	HTTP3                      *HTTP3ApplyConfiguration                       `json:"http3,omitempty"`
 }
 
 // HTTPListenerPolicySpecApplyConfiguration constructs a declarative configuration of the HTTPListenerPolicySpec type for use with
@@ -159,3 +160,11 @@ func (b *HTTPListenerPolicySpecApplyConfiguration) WithDefaultHostForHttp10(valu
 	b.DefaultHostForHttp10 = &value
 	return b
 }
+
+// WithHTTP3 sets the HTTP3 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTP3 field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithHTTP3(value *HTTP3ApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.HTTP3 = value
+	return b
+}
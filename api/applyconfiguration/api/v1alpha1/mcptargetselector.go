@@ -9,9 +9,10 @@ import (
 // McpTargetSelectorApplyConfiguration represents a declarative configuration of the McpTargetSelector type for use
 // with apply.
 type McpTargetSelectorApplyConfiguration struct {
-	Name     *v1.SectionName                `json:"name,omitempty"`
-	Selector *McpSelectorApplyConfiguration `json:"selector,omitempty"`
-	Static   *McpTargetApplyConfiguration   `json:"static,omitempty"`
+	Name       *v1.SectionName                `json:"name,omitempty"`
+	ToolPrefix *string                        `json:"toolPrefix,omitempty"`
+	Selector   *McpSelectorApplyConfiguration `json:"selector,omitempty"`
+	Static     *McpTargetApplyConfiguration   `json:"static,omitempty"`
 }
 
 // McpTargetSelectorApplyConfiguration constructs a declarative configuration of the McpTargetSelector type for use with
@@ -28,6 +29,14 @@ func (b *McpTargetSelectorApplyConfiguration) WithName(value v1.SectionName) *Mc
 	return b
 }
 
+// WithToolPrefix sets the ToolPrefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ToolPrefix field is set to the value of the last call.
+func (b *McpTargetSelectorApplyConfiguration) WithToolPrefix(value string) *McpTargetSelectorApplyConfiguration {
+	b.ToolPrefix = &value
+	return b
+}
+
 // WithSelector sets the Selector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Selector field is set to the value of the last call.
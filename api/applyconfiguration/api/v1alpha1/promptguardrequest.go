@@ -9,6 +9,7 @@ type PromptguardRequestApplyConfiguration struct {
 	Regex          *RegexApplyConfiguration          `json:"regex,omitempty"`
 	Webhook        *WebhookApplyConfiguration        `json:"webhook,omitempty"`
 	Moderation     *ModerationApplyConfiguration     `json:"moderation,omitempty"`
+	PII            *PIIDetectionApplyConfiguration   `json:"pii,omitempty"`
 }
 
 // PromptguardRequestApplyConfiguration constructs a declarative configuration of the PromptguardRequest type for use with
@@ -48,3 +49,11 @@ func (b *PromptguardRequestApplyConfiguration) WithModeration(value *ModerationA
 	b.Moderation = value
 	return b
 }
+
+// WithPII sets the PII field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PII field is set to the value of the last call.
+func (b *PromptguardRequestApplyConfiguration) WithPII(value *PIIDetectionApplyConfiguration) *PromptguardRequestApplyConfiguration {
+	b.PII = value
+	return b
+}
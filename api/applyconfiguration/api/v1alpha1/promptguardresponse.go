@@ -5,8 +5,9 @@ package v1alpha1
 // PromptguardResponseApplyConfiguration represents a declarative configuration of the PromptguardResponse type for use
 // with apply.
 type PromptguardResponseApplyConfiguration struct {
-	Regex   *RegexApplyConfiguration   `json:"regex,omitempty"`
-	Webhook *WebhookApplyConfiguration `json:"webhook,omitempty"`
+	Regex   *RegexApplyConfiguration        `json:"regex,omitempty"`
+	Webhook *WebhookApplyConfiguration      `json:"webhook,omitempty"`
+	PII     *PIIDetectionApplyConfiguration `json:"pii,omitempty"`
 }
 
 // PromptguardResponseApplyConfiguration constructs a declarative configuration of the PromptguardResponse type for use with
@@ -30,3 +31,11 @@ func (b *PromptguardResponseApplyConfiguration) WithWebhook(value *WebhookApplyC
 	b.Webhook = value
 	return b
 }
+
+// WithPII sets the PII field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PII field is set to the value of the last call.
+func (b *PromptguardResponseApplyConfiguration) WithPII(value *PIIDetectionApplyConfiguration) *PromptguardResponseApplyConfiguration {
+	b.PII = value
+	return b
+}
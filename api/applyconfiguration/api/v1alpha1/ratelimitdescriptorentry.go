@@ -9,9 +9,10 @@ import (
 // RateLimitDescriptorEntryApplyConfiguration represents a declarative configuration of the RateLimitDescriptorEntry type for use
 // with apply.
 type RateLimitDescriptorEntryApplyConfiguration struct {
-	Type    *apiv1alpha1.RateLimitDescriptorEntryType          `json:"type,omitempty"`
-	Generic *RateLimitDescriptorEntryGenericApplyConfiguration `json:"generic,omitempty"`
-	Header  *string                                            `json:"header,omitempty"`
+	Type     *apiv1alpha1.RateLimitDescriptorEntryType          `json:"type,omitempty"`
+	Generic  *RateLimitDescriptorEntryGenericApplyConfiguration `json:"generic,omitempty"`
+	Header   *string                                            `json:"header,omitempty"`
+	JWTClaim *string                                            `json:"jwtClaim,omitempty"`
 }
 
 // RateLimitDescriptorEntryApplyConfiguration constructs a declarative configuration of the RateLimitDescriptorEntry type for use with
@@ -43,3 +44,11 @@ func (b *RateLimitDescriptorEntryApplyConfiguration) WithHeader(value string) *R
 	b.Header = &value
 	return b
 }
+
+// WithJWTClaim sets the JWTClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWTClaim field is set to the value of the last call.
+func (b *RateLimitDescriptorEntryApplyConfiguration) WithJWTClaim(value string) *RateLimitDescriptorEntryApplyConfiguration {
+	b.JWTClaim = &value
+	return b
+}
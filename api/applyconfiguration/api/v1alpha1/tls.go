@@ -12,6 +12,7 @@ import (
 type TLSApplyConfiguration struct {
 	SecretRef               *v1.LocalObjectReference            `json:"secretRef,omitempty"`
 	Files                   *TLSFilesApplyConfiguration         `json:"files,omitempty"`
+	SDS                     *TLSSDSApplyConfiguration           `json:"sds,omitempty"`
 	WellKnownCACertificates *apisv1.WellKnownCACertificatesType `json:"wellKnownCACertificates,omitempty"`
 	InsecureSkipVerify      *bool                               `json:"insecureSkipVerify,omitempty"`
 	Sni                     *string                             `json:"sni,omitempty"`
@@ -44,6 +45,14 @@ func (b *TLSApplyConfiguration) WithFiles(value *TLSFilesApplyConfiguration) *TL
 	return b
 }
 
+// WithSDS sets the SDS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SDS field is set to the value of the last call.
+func (b *TLSApplyConfiguration) WithSDS(value *TLSSDSApplyConfiguration) *TLSApplyConfiguration {
+	b.SDS = value
+	return b
+}
+
 // WithWellKnownCACertificates sets the WellKnownCACertificates field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the WellKnownCACertificates field is set to the value of the last call.
@@ -5,21 +5,25 @@ package v1alpha1
 // TrafficPolicySpecApplyConfiguration represents a declarative configuration of the TrafficPolicySpec type for use
 // with apply.
 type TrafficPolicySpecApplyConfiguration struct {
-	TargetRefs      []LocalPolicyTargetReferenceWithSectionNameApplyConfiguration `json:"targetRefs,omitempty"`
-	TargetSelectors []LocalPolicyTargetSelectorWithSectionNameApplyConfiguration  `json:"targetSelectors,omitempty"`
-	AI              *AIPolicyApplyConfiguration                                   `json:"ai,omitempty"`
-	Transformation  *TransformationPolicyApplyConfiguration                       `json:"transformation,omitempty"`
-	ExtProc         *ExtProcPolicyApplyConfiguration                              `json:"extProc,omitempty"`
-	ExtAuth         *ExtAuthPolicyApplyConfiguration                              `json:"extAuth,omitempty"`
-	RateLimit       *RateLimitApplyConfiguration                                  `json:"rateLimit,omitempty"`
-	Cors            *CorsPolicyApplyConfiguration                                 `json:"cors,omitempty"`
-	Csrf            *CSRFPolicyApplyConfiguration                                 `json:"csrf,omitempty"`
-	HeaderModifiers *HeaderModifiersApplyConfiguration                            `json:"headerModifiers,omitempty"`
-	AutoHostRewrite *bool                                                         `json:"autoHostRewrite,omitempty"`
-	Buffer          *BufferApplyConfiguration                                     `json:"buffer,omitempty"`
-	Timeouts        *TimeoutsApplyConfiguration                                   `json:"timeouts,omitempty"`
-	Retry           *RetryApplyConfiguration                                      `json:"retry,omitempty"`
-	RBAC            *RBACApplyConfiguration                                       `json:"rbac,omitempty"`
+	TargetRefs            []LocalPolicyTargetReferenceWithSectionNameApplyConfiguration `json:"targetRefs,omitempty"`
+	TargetSelectors       []LocalPolicyTargetSelectorWithSectionNameApplyConfiguration  `json:"targetSelectors,omitempty"`
+	AI                    *AIPolicyApplyConfiguration                                   `json:"ai,omitempty"`
+	Transformation        *TransformationPolicyApplyConfiguration                       `json:"transformation,omitempty"`
+	ExtProc               *ExtProcPolicyApplyConfiguration                              `json:"extProc,omitempty"`
+	ExtAuth               *ExtAuthPolicyApplyConfiguration                              `json:"extAuth,omitempty"`
+	RateLimit             *RateLimitApplyConfiguration                                  `json:"rateLimit,omitempty"`
+	Cors                  *CorsPolicyApplyConfiguration                                 `json:"cors,omitempty"`
+	Csrf                  *CSRFPolicyApplyConfiguration                                 `json:"csrf,omitempty"`
+	HeaderModifiers       *HeaderModifiersApplyConfiguration                            `json:"headerModifiers,omitempty"`
+	AutoHostRewrite       *bool                                                         `json:"autoHostRewrite,omitempty"`
+	Buffer                *BufferApplyConfiguration                                     `json:"buffer,omitempty"`
+	Timeouts              *TimeoutsApplyConfiguration                                   `json:"timeouts,omitempty"`
+	Retry                 *RetryApplyConfiguration                                      `json:"retry,omitempty"`
+	RBAC                  *RBACApplyConfiguration                                       `json:"rbac,omitempty"`
+	MCP                   *MCPPolicyApplyConfiguration                                  `json:"mcp,omitempty"`
+	WAF                   *WAFApplyConfiguration                                        `json:"waf,omitempty"`
+	DestinationRuleSubset *string                                                       `json:"destinationRuleSubset,omitempty"`
+	ShadowMode            *ShadowModeApplyConfiguration                                 `json:"shadowMode,omitempty"`
 }
 
 // TrafficPolicySpecApplyConfiguration constructs a declarative configuration of the TrafficPolicySpec type for use with
@@ -157,3 +161,35 @@ func (b *TrafficPolicySpecApplyConfiguration) WithRBAC(value *RBACApplyConfigura
 	b.RBAC = value
 	return b
 }
+
+// WithMCP sets the MCP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MCP field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithMCP(value *MCPPolicyApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.MCP = value
+	return b
+}
+
+// WithWAF sets the WAF field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WAF field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithWAF(value *WAFApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.WAF = value
+	return b
+}
+
+// WithDestinationRuleSubset sets the DestinationRuleSubset field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DestinationRuleSubset field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithDestinationRuleSubset(value string) *TrafficPolicySpecApplyConfiguration {
+	b.DestinationRuleSubset = &value
+	return b
+}
+
+// WithShadowMode sets the ShadowMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShadowMode field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithShadowMode(value *ShadowModeApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.ShadowMode = value
+	return b
+}
@@ -26,6 +26,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIBackend
   map:
     fields:
+    - name: failover
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIFailover
     - name: llm
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LLMProvider
@@ -35,6 +38,57 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PriorityGroup
           elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIEmbedding
+  map:
+    fields:
+    - name: openai
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OpenAIConfig
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIFailover
+  map:
+    fields:
+    - name: consecutiveFailures
+      type:
+        scalar: numeric
+    - name: ejectionDuration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: loadBalancing
+      type:
+        scalar: string
+    - name: triggers
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIModelRoute
+  map:
+    fields:
+    - name: model
+      type:
+        scalar: string
+    - name: models
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: provider
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIModelRouting
+  map:
+    fields:
+    - name: routes
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIModelRoute
+          elementRelationship: atomic
+    - name: unknownModelResponse
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIUnknownModelResponse
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIPolicy
   map:
     fields:
@@ -44,6 +98,9 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FieldDefault
           elementRelationship: atomic
+    - name: modelRouting
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIModelRouting
     - name: promptEnrichment
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIPromptEnrichment
@@ -53,6 +110,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: routeType
       type:
         scalar: string
+    - name: semanticCache
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCache
+    - name: tokenRateLimit
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimit
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIPromptEnrichment
   map:
     fields:
@@ -77,6 +140,51 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: response
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PromptguardResponse
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCache
+  map:
+    fields:
+    - name: disable
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyDisable
+    - name: embedding
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIEmbedding
+    - name: partitionKey
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCachePartitionKey
+    - name: similarityThreshold
+      type:
+        scalar: numeric
+    - name: store
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIVectorStore
+    - name: ttl
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCachePartitionKey
+  map:
+    fields:
+    - name: header
+      type:
+        scalar: string
+    - name: jwtClaim
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIUnknownModelResponse
+  map:
+    fields:
+    - name: message
+      type:
+        scalar: string
+    - name: statusCode
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIVectorStore
+  map:
+    fields:
+    - name: redis
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RedisVectorStore
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AWSGuardrailConfig
   map:
     fields:
@@ -331,9 +439,28 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: prefix
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AwsAssumeRole
+  map:
+    fields:
+    - name: externalID
+      type:
+        scalar: string
+    - name: roleARN
+      type:
+        scalar: string
+      default: ""
+    - name: roleSessionName
+      type:
+        scalar: string
+    - name: sessionDuration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AwsAuth
   map:
     fields:
+    - name: assumeRole
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AwsAssumeRole
     - name: secretRef
       type:
         namedType: io.k8s.api.core.v1.LocalObjectReference
@@ -341,11 +468,18 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+    - name: webIdentity
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AwsWebIdentity
     unions:
     - discriminator: type
       fields:
+      - fieldName: assumeRole
+        discriminatorValue: AssumeRole
       - fieldName: secretRef
         discriminatorValue: SecretRef
+      - fieldName: webIdentity
+        discriminatorValue: WebIdentity
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AwsBackend
   map:
     fields:
@@ -382,6 +516,64 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: qualifier
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AwsWebIdentity
+  map:
+    fields:
+    - name: roleARN
+      type:
+        scalar: string
+      default: ""
+    - name: roleSessionName
+      type:
+        scalar: string
+    - name: tokenPath
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AzureAuth
+  map:
+    fields:
+    - name: entraID
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AzureEntraIDAuth
+    - name: secretRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+    - name: type
+      type:
+        scalar: string
+      default: ""
+    unions:
+    - discriminator: type
+      fields:
+      - fieldName: entraID
+        discriminatorValue: EntraID
+      - fieldName: secretRef
+        discriminatorValue: SecretRef
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AzureBackend
+  map:
+    fields:
+    - name: auth
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AzureAuth
+    - name: host
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AzureEntraIDAuth
+  map:
+    fields:
+    - name: clientID
+      type:
+        scalar: string
+      default: ""
+    - name: scope
+      type:
+        scalar: string
+      default: ""
+    - name: tenantID
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AzureOpenAIConfig
   map:
     fields:
@@ -464,6 +656,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: loadBalancer
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LoadBalancer
+    - name: localityFailover
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalityFailover
     - name: outlierDetection
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OutlierDetection
@@ -497,9 +692,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: aws
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AwsBackend
+    - name: azure
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AzureBackend
     - name: dynamicForwardProxy
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DynamicForwardProxyBackend
+    - name: gcp
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GcpBackend
     - name: mcp
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCP
@@ -517,8 +718,12 @@ var schemaYAML = typed.YAMLObject(`types:
         discriminatorValue: AI
       - fieldName: aws
         discriminatorValue: Aws
+      - fieldName: azure
+        discriminatorValue: Azure
       - fieldName: dynamicForwardProxy
         discriminatorValue: DynamicForwardProxy
+      - fieldName: gcp
+        discriminatorValue: Gcp
       - fieldName: mcp
         discriminatorValue: MCP
       - fieldName: static
@@ -1177,6 +1382,34 @@ var schemaYAML = typed.YAMLObject(`types:
         elementType:
           namedType: __untyped_deduced_
         elementRelationship: separable
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GcpAuth
+  map:
+    fields:
+    - name: secretRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+    - name: type
+      type:
+        scalar: string
+      default: ""
+    unions:
+    - discriminator: type
+      fields:
+      - fieldName: secretRef
+        discriminatorValue: SecretRef
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GcpBackend
+  map:
+    fields:
+    - name: audience
+      type:
+        scalar: string
+    - name: auth
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GcpAuth
+    - name: host
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GeminiConfig
   map:
     fields:
@@ -1213,6 +1446,18 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HTTP3
+  map:
+    fields:
+    - name: altSvcMaxAge
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: listeners
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HTTPListenerPolicy
   map:
     fields:
@@ -1252,6 +1497,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: healthCheck
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.EnvoyHealthCheck
+    - name: http3
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HTTP3
     - name: idleTimeout
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
@@ -1734,6 +1982,21 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: tokenBucket
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TokenBucket
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalityFailover
+  map:
+    fields:
+    - name: failoverPriority
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: minEndpoints
+      type:
+        scalar: numeric
+    - name: overprovisioningFactor
+      type:
+        scalar: numeric
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCP
   map:
     fields:
@@ -1745,6 +2008,91 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - name
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPAuthorizationRule
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: from
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPCallerMatch
+    - name: prompts
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPNameMatch
+          elementRelationship: atomic
+    - name: resources
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPNameMatch
+          elementRelationship: atomic
+    - name: target
+      type:
+        scalar: string
+    - name: tools
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPNameMatch
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPCallerMatch
+  map:
+    fields:
+    - name: claims
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPClaimMatch
+          elementRelationship: atomic
+    - name: headers
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPHeaderMatch
+          elementRelationship: associative
+          keys:
+          - name
+    - name: notClaims
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPClaimMatch
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPClaimMatch
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: values
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPNameMatch
+  map:
+    fields:
+    - name: type
+      type:
+        scalar: string
+    - name: value
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPPolicy
+  map:
+    fields:
+    - name: authorization
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPAuthorizationRule
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpSelector
   map:
     fields:
@@ -1784,6 +2132,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: static
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.McpTarget
+    - name: toolPrefix
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Message
   map:
     fields:
@@ -1961,6 +2312,27 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: maxEjectionPercent
       type:
         scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PIIDetection
+  map:
+    fields:
+    - name: entities
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PIIEntity
+          elementRelationship: associative
+          keys:
+          - type
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PIIEntity
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PathOverride
   map:
     fields:
@@ -2091,6 +2463,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: moderation
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Moderation
+    - name: pii
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PIIDetection
     - name: regex
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Regex
@@ -2100,6 +2475,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PromptguardResponse
   map:
     fields:
+    - name: pii
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PIIDetection
     - name: regex
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Regex
@@ -2161,6 +2539,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: header
       type:
         scalar: string
+    - name: jwtClaim
+      type:
+        scalar: string
     - name: type
       type:
         scalar: string
@@ -2208,6 +2589,19 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: xRateLimitHeaders
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RedisVectorStore
+  map:
+    fields:
+    - name: authToken
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SingleAuthToken
+    - name: backendRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendObjectReference
+      default: {}
+    - name: index
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Regex
   map:
     fields:
@@ -2363,6 +2757,15 @@ var schemaYAML = typed.YAMLObject(`types:
         map:
           elementType:
             scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ShadowMode
+  map:
+    fields:
+    - name: fields
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SingleAuthToken
   map:
     fields:
@@ -2470,6 +2873,87 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: keepAliveTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TCPPolicy
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TCPPolicySpec
+      default: {}
+    - name: status
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.PolicyStatus
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TCPPolicySpec
+  map:
+    fields:
+    - name: connectionRateLimit
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TokenBucket
+    - name: idleTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxConnectionDuration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: rbac
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TCPRBAC
+    - name: targetRefs
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReference
+          elementRelationship: atomic
+    - name: targetSelectors
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetSelector
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TCPRBAC
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: rules
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TCPRBACRule
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TCPRBACRule
+  map:
+    fields:
+    - name: principals
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: snis
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: sourceCIDRs
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TLS
   map:
     fields:
@@ -2491,6 +2975,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: parameters
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TLSParameters
+    - name: sds
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TLSSDS
     - name: secretRef
       type:
         namedType: io.k8s.api.core.v1.LocalObjectReference
@@ -2542,6 +3029,20 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: minVersion
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TLSSDS
+  map:
+    fields:
+    - name: certificateSecretName
+      type:
+        scalar: string
+      default: ""
+    - name: clusterName
+      type:
+        scalar: string
+    - name: validationContextSecretName
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Timeouts
   map:
     fields:
@@ -2640,6 +3141,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: csrf
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CSRFPolicy
+    - name: destinationRuleSubset
+      type:
+        scalar: string
     - name: extAuth
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthPolicy
@@ -2649,6 +3153,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: headerModifiers
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HeaderModifiers
+    - name: mcp
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MCPPolicy
     - name: rateLimit
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimit
@@ -2658,6 +3165,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: retry
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Retry
+    - name: shadowMode
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ShadowMode
     - name: targetRefs
       type:
         list:
@@ -2676,6 +3186,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: transformation
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TransformationPolicy
+    - name: waf
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.WAF
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Transform
   map:
     fields:
@@ -2752,6 +3265,49 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.WAF
+  map:
+    fields:
+    - name: disable
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyDisable
+    - name: mode
+      type:
+        scalar: string
+    - name: paranoiaLevel
+      type:
+        scalar: numeric
+    - name: ruleExclusions
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.WAFRuleExclusion
+          elementRelationship: atomic
+    - name: ruleSets
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.WAFRuleSet
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.WAFRuleExclusion
+  map:
+    fields:
+    - name: id
+      type:
+        scalar: string
+    - name: tag
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.WAFRuleSet
+  map:
+    fields:
+    - name: configMapRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+      default: {}
+    - name: key
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Webhook
   map:
     fields:
@@ -4729,6 +5285,25 @@ var schemaYAML = typed.YAMLObject(`types:
         elementRelationship: separable
 - name: io.k8s.apimachinery.pkg.util.intstr.IntOrString
   scalar: untyped
+- name: io.k8s.sigs.gateway-api.apis.v1.BackendObjectReference
+  map:
+    fields:
+    - name: group
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: namespace
+      type:
+        scalar: string
+    - name: port
+      type:
+        scalar: numeric
 - name: io.k8s.sigs.gateway-api.apis.v1.BackendRef
   map:
     fields:
@@ -27,24 +27,42 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.AgentgatewayApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIBackend"):
 		return &apiv1alpha1.AIBackendApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIEmbedding"):
+		return &apiv1alpha1.AIEmbeddingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AiExtension"):
 		return &apiv1alpha1.AiExtensionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AiExtensionStats"):
 		return &apiv1alpha1.AiExtensionStatsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AiExtensionTrace"):
 		return &apiv1alpha1.AiExtensionTraceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIFailover"):
+		return &apiv1alpha1.AIFailoverApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIModelRoute"):
+		return &apiv1alpha1.AIModelRouteApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIModelRouting"):
+		return &apiv1alpha1.AIModelRoutingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIPolicy"):
 		return &apiv1alpha1.AIPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIPromptEnrichment"):
 		return &apiv1alpha1.AIPromptEnrichmentApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIPromptGuard"):
 		return &apiv1alpha1.AIPromptGuardApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AISemanticCache"):
+		return &apiv1alpha1.AISemanticCacheApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AISemanticCachePartitionKey"):
+		return &apiv1alpha1.AISemanticCachePartitionKeyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIUnknownModelResponse"):
+		return &apiv1alpha1.AIUnknownModelResponseApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIVectorStore"):
+		return &apiv1alpha1.AIVectorStoreApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AnthropicConfig"):
 		return &apiv1alpha1.AnthropicConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AnyValue"):
 		return &apiv1alpha1.AnyValueApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AuthHeader"):
 		return &apiv1alpha1.AuthHeaderApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AwsAssumeRole"):
+		return &apiv1alpha1.AwsAssumeRoleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AwsAuth"):
 		return &apiv1alpha1.AwsAuthApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AwsBackend"):
@@ -53,6 +71,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.AWSGuardrailConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AwsLambda"):
 		return &apiv1alpha1.AwsLambdaApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AwsWebIdentity"):
+		return &apiv1alpha1.AwsWebIdentityApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AzureAuth"):
+		return &apiv1alpha1.AzureAuthApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AzureBackend"):
+		return &apiv1alpha1.AzureBackendApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AzureEntraIDAuth"):
+		return &apiv1alpha1.AzureEntraIDAuthApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AzureOpenAIConfig"):
 		return &apiv1alpha1.AzureOpenAIConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Backend"):
@@ -143,6 +169,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.GatewayParametersApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GatewayParametersSpec"):
 		return &apiv1alpha1.GatewayParametersSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GcpAuth"):
+		return &apiv1alpha1.GcpAuthApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GcpBackend"):
+		return &apiv1alpha1.GcpBackendApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GeminiConfig"):
 		return &apiv1alpha1.GeminiConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GracefulShutdownSpec"):
@@ -173,6 +203,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.Http1ProtocolOptionsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Http2ProtocolOptions"):
 		return &apiv1alpha1.Http2ProtocolOptionsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HTTP3"):
+		return &apiv1alpha1.HTTP3ApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HTTPListenerPolicy"):
 		return &apiv1alpha1.HTTPListenerPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HTTPListenerPolicySpec"):
@@ -201,6 +233,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.LoadBalancerRingHashConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LoadBalancerRoundRobinConfig"):
 		return &apiv1alpha1.LoadBalancerRoundRobinConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LocalityFailover"):
+		return &apiv1alpha1.LocalityFailoverApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LocalPolicyTargetReference"):
 		return &apiv1alpha1.LocalPolicyTargetReferenceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LocalPolicyTargetReferenceWithSectionName"):
@@ -213,6 +247,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.LocalRateLimitPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MCP"):
 		return &apiv1alpha1.MCPApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MCPAuthorizationRule"):
+		return &apiv1alpha1.MCPAuthorizationRuleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MCPCallerMatch"):
+		return &apiv1alpha1.MCPCallerMatchApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MCPClaimMatch"):
+		return &apiv1alpha1.MCPClaimMatchApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MCPNameMatch"):
+		return &apiv1alpha1.MCPNameMatchApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MCPPolicy"):
+		return &apiv1alpha1.MCPPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("McpSelector"):
 		return &apiv1alpha1.McpSelectorApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("McpTarget"):
@@ -247,6 +291,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.OutlierDetectionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PathOverride"):
 		return &apiv1alpha1.PathOverrideApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PIIDetection"):
+		return &apiv1alpha1.PIIDetectionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PIIEntity"):
+		return &apiv1alpha1.PIIEntityApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Pod"):
 		return &apiv1alpha1.PodApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Port"):
@@ -277,6 +325,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.RBACApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RBACPolicy"):
 		return &apiv1alpha1.RBACPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RedisVectorStore"):
+		return &apiv1alpha1.RedisVectorStoreApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Regex"):
 		return &apiv1alpha1.RegexApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RegexMatch"):
@@ -299,6 +349,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.ServiceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ServiceAccount"):
 		return &apiv1alpha1.ServiceAccountApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ShadowMode"):
+		return &apiv1alpha1.ShadowModeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SingleAuthToken"):
 		return &apiv1alpha1.SingleAuthTokenApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlowStart"):
@@ -313,6 +365,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.StringMatcherApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TCPKeepalive"):
 		return &apiv1alpha1.TCPKeepaliveApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TCPPolicy"):
+		return &apiv1alpha1.TCPPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TCPPolicySpec"):
+		return &apiv1alpha1.TCPPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TCPRBAC"):
+		return &apiv1alpha1.TCPRBACApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TCPRBACRule"):
+		return &apiv1alpha1.TCPRBACRuleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Timeouts"):
 		return &apiv1alpha1.TimeoutsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TLS"):
@@ -321,6 +381,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.TLSFilesApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TLSParameters"):
 		return &apiv1alpha1.TLSParametersApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TLSSDS"):
+		return &apiv1alpha1.TLSSDSApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TokenBucket"):
 		return &apiv1alpha1.TokenBucketApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Tracing"):
@@ -339,6 +401,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.UpgradeConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VertexAIConfig"):
 		return &apiv1alpha1.VertexAIConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("WAF"):
+		return &apiv1alpha1.WAFApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("WAFRuleExclusion"):
+		return &apiv1alpha1.WAFRuleExclusionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("WAFRuleSet"):
+		return &apiv1alpha1.WAFRuleSetApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Webhook"):
 		return &apiv1alpha1.WebhookApplyConfiguration{}
 
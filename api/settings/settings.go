@@ -184,6 +184,16 @@ type Settings struct {
 
 	// EnableWaypoint enables kgateway to translate istio waypoints
 	EnableWaypoint bool `split_words:"true" default:"false"`
+
+	// WafWasmPath is the path, in the proxy container, of the Coraza Wasm module used
+	// to implement the TrafficPolicy WAF. The default path is the module shipped in the
+	// envoy-wrapper image; custom proxy images must provide the module at this path.
+	WafWasmPath string `split_words:"true" default:"/etc/envoy/wasm/coraza-waf.wasm"`
+
+	// ClusterName is the name of the cluster kgateway runs in within its Multi-Cluster Services ClusterSet,
+	// as used by the MCS controller in the multicluster.kubernetes.io/source-cluster label of EndpointSlices.
+	// When set, the endpoints of ServiceImport backends in this cluster are preferred over the ones in other clusters.
+	ClusterName string `split_words:"true"`
 }
 
 // BuildSettings returns a zero-valued Settings obj if error is encountered when parsing env
@@ -47,6 +47,8 @@ func allEnvVarsSet() map[string]string {
 		"KGW_ENABLE_WAYPOINT":                "true",
 		"KGW_XDS_AUTH":                       "false",
 		"KGW_XDS_TLS":                        "true",
+		"KGW_WAF_WASM_PATH":                  "/custom/waf.wasm",
+		"KGW_CLUSTER_NAME":                   "cluster-a",
 	}
 }
 
@@ -99,6 +101,7 @@ func TestSettings(t *testing.T) {
 				EnableWaypoint:              false,
 				XdsAuth:                     true,
 				XdsTLS:                      false,
+				WafWasmPath:                 "/etc/envoy/wasm/coraza-waf.wasm",
 			},
 		},
 		{
@@ -136,6 +139,8 @@ func TestSettings(t *testing.T) {
 				EnableWaypoint:              true,
 				XdsAuth:                     false,
 				XdsTLS:                      true,
+				WafWasmPath:                 "/custom/waf.wasm",
+				ClusterName:                 "cluster-a",
 			},
 		},
 		{
@@ -201,6 +206,7 @@ func TestSettings(t *testing.T) {
 				PolicyMerge:                 "{}",
 				XdsAuth:                     true,
 				XdsTLS:                      false,
+				WafWasmPath:                 "/etc/envoy/wasm/coraza-waf.wasm",
 			},
 		},
 	}
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -44,6 +45,90 @@ type AIBackend struct {
 	// +kubebuilder:validation:MaxItems=32
 	// TODO: enable this rule when we don't need to support older k8s versions where this rule breaks // +kubebuilder:validation:XValidation:message="provider names must be unique across groups",rule="self.map(pg, pg.providers.map(pp, pp.name)).map(p, self.map(pg, pg.providers.map(pp, pp.name)).filter(cp, cp != p).exists(cp, p.exists(pn, pn in cp))).exists(p, !p)"
 	PriorityGroups []PriorityGroup `json:"priorityGroups,omitempty"`
+
+	// Failover configures how requests fail over from failing or rate limited providers to the
+	// other providers of the backend. Providers failing with server errors or timeouts are ejected,
+	// and requests fail over to the next priority group once all the providers of a group are ejected.
+	// Rate limited requests are retried on the other providers, after the delay of the Retry-After
+	// header of the rate limited response.
+	//
+	// Note: With agentgateway, the requests failing with the status codes of the triggers are retried
+	// on the other providers, and the failing providers are evicted by the built-in failover of
+	// agentgateway: rate limited providers are evicted until the time of their Retry-After header, and
+	// the healthy providers with the lowest latency are preferred. The consecutiveFailures,
+	// ejectionDuration and loadBalancing fields are not supported with agentgateway, and a Backend
+	// setting them is reported as partially accepted.
+	//
+	// Example configuration failing over rate limited requests and balancing across the providers
+	// with the lowest latency:
+	// ```yaml
+	// failover:
+	//   triggers:
+	//   - RateLimited
+	//   - ServerError
+	//   ejectionDuration: 1m
+	//   loadBalancing: LeastLatency
+	// ```
+	// +optional
+	Failover *AIFailover `json:"failover,omitempty"`
+}
+
+// AIFailoverTrigger is a provider response that triggers a failover to the other providers.
+// +kubebuilder:validation:Enum=RateLimited;ServerError;Timeout
+type AIFailoverTrigger string
+
+const (
+	// AIFailoverTriggerRateLimited fails over when a provider responds with a 429 status code.
+	AIFailoverTriggerRateLimited AIFailoverTrigger = "RateLimited"
+	// AIFailoverTriggerServerError fails over when a provider responds with a 5xx status code.
+	AIFailoverTriggerServerError AIFailoverTrigger = "ServerError"
+	// AIFailoverTriggerTimeout fails over when a provider cannot be reached or does not respond in time.
+	AIFailoverTriggerTimeout AIFailoverTrigger = "Timeout"
+)
+
+// AILoadBalancing is the algorithm used to balance requests across the providers of a priority group.
+// +kubebuilder:validation:Enum=RoundRobin;LeastLatency
+type AILoadBalancing string
+
+const (
+	// AILoadBalancingRoundRobin balances requests evenly across the providers.
+	AILoadBalancingRoundRobin AILoadBalancing = "RoundRobin"
+	// AILoadBalancingLeastLatency prefers the providers with the lowest latency.
+	// With Envoy, this is approximated by the providers with the fewest active requests.
+	AILoadBalancingLeastLatency AILoadBalancing = "LeastLatency"
+)
+
+// AIFailover configures the failover between the providers of an AI backend.
+type AIFailover struct {
+	// Triggers are the provider responses that fail a request over to the other providers.
+	// Defaults to RateLimited and ServerError.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=3
+	// +listType=set
+	Triggers []AIFailoverTrigger `json:"triggers,omitempty"`
+
+	// ConsecutiveFailures is the number of consecutive server errors or timeouts before a provider
+	// is ejected. Defaults to 5.
+	// A 429 response does not eject the provider: the rate limited request is retried on another
+	// provider instead, so a rate limited provider keeps receiving new requests.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	ConsecutiveFailures *int32 `json:"consecutiveFailures,omitempty"`
+
+	// EjectionDuration is the base time a provider is ejected for. The real time is equal to the
+	// base time multiplied by the number of times the provider has been ejected.
+	// Defaults to 30s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="ejectionDuration must be at least 1ms"
+	EjectionDuration *metav1.Duration `json:"ejectionDuration,omitempty"`
+
+	// LoadBalancing is the algorithm used to balance requests across the providers of a priority group.
+	// Defaults to RoundRobin.
+	// +optional
+	LoadBalancing *AILoadBalancing `json:"loadBalancing,omitempty"`
 }
 
 // LLMProvider specifies the target large language model provider that the backend should route requests to.
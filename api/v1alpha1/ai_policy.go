@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -22,11 +23,228 @@ type AIPolicy struct {
 	// Defaults do _not_ override the user input fields, unless you explicitly set `override` to `true`.
 	Defaults []FieldDefault `json:"defaults,omitempty"`
 
-	// The type of route to the LLM provider API. Currently, `CHAT` and `CHAT_STREAMING` are supported.
+	// The type of route to the LLM provider API, which determines how the requests and responses are
+	// parsed for prompt guards and token accounting, and which API of the LLM provider requests are sent to.
+	// `CHAT` and `CHAT_STREAMING` are supported by all the providers. `COMPLETIONS`, `EMBEDDINGS`, `RESPONSES`
+	// and `IMAGES` are supported by the OpenAI and Azure OpenAI providers. Use `AUTO` to detect the route type
+	// from the request path, such as `/v1/embeddings`, when a route serves several APIs.
 	// Note: This field is not applicable when using agentgateway
-	// +kubebuilder:validation:Enum=CHAT;CHAT_STREAMING
+	// +kubebuilder:validation:Enum=CHAT;CHAT_STREAMING;COMPLETIONS;EMBEDDINGS;RESPONSES;IMAGES;AUTO
 	// +kubebuilder:default=CHAT
 	RouteType *RouteType `json:"routeType,omitempty"`
+
+	// Limit the number of LLM tokens consumed by requests, counting both the prompt and the completion tokens.
+	// The token counts are parsed from the responses of the LLM provider, so a request is charged for its
+	// tokens once its response completes, and later requests are rejected while the budget is exhausted.
+	//
+	// The local token bucket is shared by all the requests the policy applies to. Use the global rate limit
+	// with descriptors, such as a header, a JWT claim or the client IP, to limit the tokens per client.
+	// +optional
+	TokenRateLimit *RateLimit `json:"tokenRateLimit,omitempty"`
+
+	// Route requests to the providers of the AI backend based on the model requested by the client,
+	// rewrite the model names, and reject requests for unknown models.
+	// This allows a single OpenAI-compatible endpoint to front several providers and models.
+	// The providers of an AI backend of different types, e.g. Azure OpenAI and Vertex AI, are sent
+	// the requests through their OpenAI-compatible API.
+	// Note: With agentgateway, only routes mapping a model name without wildcards to another model are supported.
+	// +optional
+	ModelRouting *AIModelRouting `json:"modelRouting,omitempty"`
+
+	// Cache the responses of the LLM provider, and serve the cached response to requests with a prompt
+	// that is similar to the prompt of a cached request, which saves the latency and the cost of the request.
+	// Note: This field is not applicable when using agentgateway
+	// +optional
+	SemanticCache *AISemanticCache `json:"semanticCache,omitempty"`
+}
+
+// AISemanticCache configures the semantic caching of the responses of the LLM provider.
+// The prompt of each request is converted to a vector with an embedding model, and the
+// vector store is searched for the response of a request with a similar prompt. Only the
+// successful responses of `CHAT` requests that are not streamed are cached.
+//
+// The following example caches the responses in Redis for an hour, and serves them to the
+// requests whose prompt embedding has a cosine similarity of at least 0.9 with a cached one.
+// ```yaml
+// semanticCache:
+//
+//	store:
+//	  redis:
+//	    backendRef:
+//	      name: redis
+//	      port: 6379
+//	embedding:
+//	  openai:
+//	    authToken:
+//	      kind: SecretRef
+//	      secretRef:
+//	        name: openai-secret
+//	    model: text-embedding-3-small
+//	similarityThreshold: 90
+//	ttl: 1h
+//
+// ```
+// +kubebuilder:validation:XValidation:rule="has(self.disable) || (has(self.store) && has(self.embedding))",message="store and embedding must be set unless the semantic cache is disabled"
+// +kubebuilder:validation:XValidation:rule="!(has(self.disable) && (has(self.store) || has(self.embedding)))",message="store and embedding must not be set when the semantic cache is disabled"
+type AISemanticCache struct {
+	// Store is the vector store that holds the embeddings of the cached prompts and their responses.
+	// +optional
+	Store *AIVectorStore `json:"store,omitempty"`
+
+	// Embedding is the model used to convert the prompts to vectors.
+	// +optional
+	Embedding *AIEmbedding `json:"embedding,omitempty"`
+
+	// SimilarityThreshold is the minimum cosine similarity, as a percentage, between the embeddings
+	// of the prompt of a request and of a cached prompt for the cached response to be served.
+	// Defaults to 95.
+	// +optional
+	// +kubebuilder:default=95
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	SimilarityThreshold *int32 `json:"similarityThreshold,omitempty"`
+
+	// TTL is the duration for which a response is cached. Defaults to 1h.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="ttl must be at least 1 second"
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// The cached responses are partitioned by policy, route and backend, so that they are only served to
+	// the requests of the route and backend they were cached for. PartitionKey additionally partitions the
+	// cached responses by a value of the request, such as a header or a JWT claim identifying the user or
+	// the tenant. The requests without this value are not cached.
+	// +optional
+	PartitionKey *AISemanticCachePartitionKey `json:"partitionKey,omitempty"`
+
+	// Disable the semantic cache.
+	// Can be used to disable the semantic cache for the routes of a policy applied at a higher level
+	// in the config hierarchy.
+	// +optional
+	Disable *PolicyDisable `json:"disable,omitempty"`
+}
+
+// AISemanticCachePartitionKey is the value of the request that partitions the cached responses.
+// +kubebuilder:validation:ExactlyOneOf=header;jwtClaim
+type AISemanticCachePartitionKey struct {
+	// Header is the name of the request header whose value partitions the cached responses.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Header *string `json:"header,omitempty"`
+
+	// JWTClaim is the claim of the validated JWT of the request whose value partitions the cached responses.
+	// The claim is read from the JWT payload that the JWT authentication filter stores in the
+	// `payload` key of its dynamic metadata.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	JWTClaim *string `json:"jwtClaim,omitempty"`
+}
+
+// AIVectorStore configures the vector store of the semantic cache.
+// +kubebuilder:validation:ExactlyOneOf=redis
+type AIVectorStore struct {
+	// Redis stores the embeddings and the responses in Redis, using the vector search of the
+	// Redis Query Engine, which is available in Redis Stack and Redis 8.
+	// +optional
+	Redis *RedisVectorStore `json:"redis,omitempty"`
+}
+
+// RedisVectorStore configures a Redis server used as a vector store.
+type RedisVectorStore struct {
+	// BackendRef references the Redis server, such as a Kubernetes Service or a static Backend.
+	// +required
+	BackendRef gwv1.BackendObjectReference `json:"backendRef"`
+
+	// The name of the search index of the cached prompts, which is created if it does not exist.
+	// Routes with different embedding models must use different indexes.
+	// Defaults to `kgateway-semantic-cache.<namespace>.<name>`, with the namespace and the name of the
+	// policy, so that each policy has its own index.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Index *string `json:"index,omitempty"`
+
+	// The password used to authenticate with the Redis server.
+	// If not specified, the connection is not authenticated.
+	// +optional
+	AuthToken *SingleAuthToken `json:"authToken,omitempty"`
+}
+
+// AIEmbedding configures the embedding model of the semantic cache.
+// +kubebuilder:validation:ExactlyOneOf=openai
+type AIEmbedding struct {
+	// Use the OpenAI embeddings API. The model defaults to `text-embedding-3-small`.
+	// +optional
+	OpenAI *OpenAIConfig `json:"openai,omitempty"`
+}
+
+// AIModelRouting configures how requests are routed based on the `model` field of the request body.
+//
+// The following example sends the requests for the `gpt-4o` models to the `azure-gpt-4o` provider,
+// serves the `fast` alias with `gpt-4o-mini`, and rejects the requests for any other model.
+// ```yaml
+// modelRouting:
+//
+//	routes:
+//	- models: ["gpt-4o", "gpt-4o-2024-*"]
+//	  provider: azure-gpt-4o
+//	- models: ["fast"]
+//	  provider: openai
+//	  model: gpt-4o-mini
+//	unknownModelResponse:
+//	  statusCode: 404
+//
+// ```
+type AIModelRouting struct {
+	// Routes maps the model names requested by clients to providers and models.
+	// The routes are evaluated in order, and the first route matching the requested model is used.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Routes []AIModelRoute `json:"routes"`
+
+	// UnknownModelResponse configures the response returned to the client when the requested model
+	// does not match any route. If not specified, these requests are sent to the backend unchanged.
+	// Note: This field is not supported with agentgateway.
+	// +optional
+	UnknownModelResponse *AIUnknownModelResponse `json:"unknownModelResponse,omitempty"`
+}
+
+// AIModelRoute routes the requests for a set of models.
+// +kubebuilder:validation:XValidation:rule="has(self.provider) || has(self.model)",message="at least one of provider or model must be set"
+type AIModelRoute struct {
+	// Models lists the model names matched by this route. A `*` in a name matches any sequence of characters,
+	// for example `gpt-4o*` matches `gpt-4o` and `gpt-4o-mini`.
+	// Note: Wildcards are not supported with agentgateway.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	Models []string `json:"models"`
+
+	// Provider is the name of the provider, in the priority groups of the AI backend, that the matched
+	// requests are sent to. If not specified, the requests are load balanced across the providers of the backend.
+	// Note: This field is not supported with agentgateway.
+	// +optional
+	Provider *gwv1.SectionName `json:"provider,omitempty"`
+
+	// Model is the model name sent to the provider in place of the requested model.
+	// A model configured on the provider takes precedence.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Model *string `json:"model,omitempty"`
+}
+
+// AIUnknownModelResponse configures the response returned for requests to unknown models.
+type AIUnknownModelResponse struct {
+	// A custom response message to return to the client. If not specified, defaults to
+	// "The requested model is not supported".
+	// +kubebuilder:default="The requested model is not supported"
+	Message *string `json:"message,omitempty"`
+
+	// The status code to return to the client. Defaults to 404.
+	// +kubebuilder:default=404
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	StatusCode *int32 `json:"statusCode,omitempty"`
 }
 
 // AIPromptEnrichment defines the config to enrich requests sent to the LLM provider by appending and prepending system prompts.
@@ -79,6 +297,21 @@ const (
 
 	// Stream responses to a client, which allows the LLM to stream out tokens as they are generated.
 	CHAT_STREAMING RouteType = "CHAT_STREAMING"
+
+	// The legacy text completions API, such as `/v1/completions`.
+	COMPLETIONS RouteType = "COMPLETIONS"
+
+	// The embeddings API, such as `/v1/embeddings`. Response guards do not apply to embeddings.
+	EMBEDDINGS RouteType = "EMBEDDINGS"
+
+	// The OpenAI Responses API, such as `/v1/responses`.
+	RESPONSES RouteType = "RESPONSES"
+
+	// The image generation API, such as `/v1/images/generations`.
+	IMAGES RouteType = "IMAGES"
+
+	// Detect the route type from the path of each request, defaulting to `CHAT` for unknown paths.
+	AUTO RouteType = "AUTO"
 )
 
 // An entry for a message to prepend or append to each prompt.
@@ -206,7 +439,7 @@ type Moderation struct {
 
 // PromptguardRequest defines the prompt guards to apply to requests sent by the client.
 // Multiple prompt guard configurations can be set, and they will be executed in the following order:
-// webhook → regex → moderation for requests, where each step can reject the request and stop further processing.
+// webhook → regex → pii → moderation for requests, where each step can reject the request and stop further processing.
 type PromptguardRequest struct {
 	// A custom response message to return to the client. If not specified, defaults to
 	// "The request was rejected due to inappropriate content".
@@ -221,11 +454,14 @@ type PromptguardRequest struct {
 	// Pass prompt data through an external moderation model endpoint,
 	// which compares the request prompt input to predefined content rules.
 	Moderation *Moderation `json:"moderation,omitempty"`
+
+	// Detect personally identifiable information (PII) and secrets in the prompts.
+	PII *PIIDetection `json:"pii,omitempty"`
 }
 
 // PromptguardResponse configures the response that the prompt guard applies to responses returned by the LLM provider.
-// Both webhook and regex can be set, they will be executed in the following order: webhook → regex, where each step
-// can reject the request and stop further processing.
+// Multiple prompt guard configurations can be set, and they will be executed in the following order:
+// webhook → regex → pii, where each step can reject the request and stop further processing.
 // Note: This is not yet supported for agentgateway.
 type PromptguardResponse struct {
 	// Regular expression (regex) matching for prompt guards and data masking.
@@ -233,8 +469,97 @@ type PromptguardResponse struct {
 
 	// Configure a webhook to forward responses to for prompt guarding.
 	Webhook *Webhook `json:"webhook,omitempty"`
+
+	// Detect personally identifiable information (PII) and secrets in the responses.
+	// A response rejected by the PII detection is replaced with a 403 error. The status code of
+	// a streamed response may already have been sent to the client, in which case the connection is closed.
+	PII *PIIDetection `json:"pii,omitempty"`
 }
 
+// PIIDetection configures the detection of personally identifiable information (PII) and secrets.
+// Unlike the built-in regexes, the entities are detected with named entity recognition and with
+// the validation of the checksums of the identifiers, which reduces the false positives.
+//
+// The following example rejects the prompts containing API keys, and hashes the IBANs and the
+// names of persons in the prompts.
+// ```yaml
+// promptGuard:
+//
+//	request:
+//	  pii:
+//	    entities:
+//	    - type: API_KEY
+//	      action: REJECT
+//	    - type: IBAN
+//	      action: HASH
+//	    - type: PERSON
+//	      action: HASH
+//
+// ```
+//
+// Note: With agentgateway, the `PERSON` and `LOCATION` entities and the `HASH` action are not supported,
+// `NATIONAL_ID` only matches US Social Security numbers, the identifiers are matched without validating
+// their checksums, and the detections are not counted in the `pii_detected` metric. A single action applies
+// to all the entities and the regexes of the prompt guard, which is `REJECT` if the regex or any entity
+// rejects, and otherwise `MASK`. These differences are reported on the status of the policy.
+type PIIDetection struct {
+	// The types of entities to detect, and the action to take on each.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=5
+	// +listType=map
+	// +listMapKey=type
+	Entities []PIIEntity `json:"entities"`
+}
+
+// PIIEntity configures the action taken on a type of PII entity.
+type PIIEntity struct {
+	// The type of entity to detect.
+	// +required
+	Type PIIEntityType `json:"type"`
+
+	// The action to take when the entity is detected. Defaults to `MASK`.
+	// +optional
+	// +kubebuilder:default=MASK
+	Action *PIIAction `json:"action,omitempty"`
+}
+
+// PIIEntityType is a type of PII entity.
+// +kubebuilder:validation:Enum=PERSON;LOCATION;NATIONAL_ID;IBAN;API_KEY
+type PIIEntityType string
+
+const (
+	// The names of persons, detected with named entity recognition.
+	PIIEntityPerson PIIEntityType = "PERSON"
+
+	// Locations such as addresses, cities and countries, detected with named entity recognition.
+	PIIEntityLocation PIIEntityType = "LOCATION"
+
+	// National identification numbers whose checksums are valid, such as US Social Security numbers,
+	// UK NHS numbers, Spanish NIFs, Italian fiscal codes and Australian tax file numbers.
+	PIIEntityNationalID PIIEntityType = "NATIONAL_ID"
+
+	// International bank account numbers whose checksums are valid.
+	PIIEntityIBAN PIIEntityType = "IBAN"
+
+	// API keys and secrets, such as OpenAI, AWS, GitHub, Slack and Stripe keys, and private keys.
+	PIIEntityAPIKey PIIEntityType = "API_KEY"
+)
+
+// PIIAction is the action taken on a detected PII entity.
+// +kubebuilder:validation:Enum=MASK;HASH;REJECT
+type PIIAction string
+
+const (
+	// Replace the entity with its type, such as `<IBAN>`.
+	PIIActionMask PIIAction = "MASK"
+
+	// Replace the entity with its SHA-256 hash, which keeps the distinct entities distinguishable.
+	PIIActionHash PIIAction = "HASH"
+
+	// Reject the request or the response.
+	PIIActionReject PIIAction = "REJECT"
+)
+
 // AIPromptGuard configures a prompt guards to block unwanted requests to the LLM provider and mask sensitive data.
 // Prompt guards can be used to reject requests based on the content of the prompt, as well as
 // mask responses based on the content of the response.
@@ -34,6 +34,13 @@ type BackendConfigPolicyList struct {
 
 // BackendConfigPolicySpec defines the desired state of BackendConfigPolicy.
 //
+// A Gateway API XBackendTrafficPolicy can target the same backends, as it configures the retry budget and
+// the session persistence, which a BackendConfigPolicy does not configure. The load balancer of the
+// BackendConfigPolicy picks the host of the first request of a session, and the session persistence then
+// pins the following requests to that host while it is healthy. The session persistence of an HTTPRoute rule
+// takes precedence over the one of an XBackendTrafficPolicy. XBackendTrafficPolicy is not supported with
+// agentgateway.
+//
 // +kubebuilder:validation:AtMostOneOf=http1ProtocolOptions;http2ProtocolOptions
 type BackendConfigPolicySpec struct {
 	// TargetRefs specifies the target references to attach the policy to.
@@ -87,6 +94,14 @@ type BackendConfigPolicySpec struct {
 	// +optional
 	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty"`
 
+	// LocalityFailover configures the priority of the endpoints of the backend based on their labels,
+	// to keep the traffic close to the proxy, e.g. in the same zone, and only fail over to farther
+	// endpoints when the closer ones are unhealthy.
+	// It takes precedence over the locality load balancer settings of an Istio DestinationRule and the
+	// traffic distribution of the Service.
+	// +optional
+	LocalityFailover *LocalityFailover `json:"localityFailover,omitempty"`
+
 	// HealthCheck contains the options necessary to configure the health check.
 	// +optional
 	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
@@ -198,7 +213,7 @@ type TCPKeepalive struct {
 	KeepAliveInterval *metav1.Duration `json:"keepAliveInterval,omitempty"`
 }
 
-// +kubebuilder:validation:ExactlyOneOf=secretRef;files;insecureSkipVerify;wellKnownCACertificates
+// +kubebuilder:validation:ExactlyOneOf=secretRef;files;sds;insecureSkipVerify;wellKnownCACertificates
 type TLS struct {
 	// Reference to the TLS secret containing the certificate, key, and optionally the root CA.
 	// +optional
@@ -208,6 +223,11 @@ type TLS struct {
 	// +optional
 	Files *TLSFiles `json:"files,omitempty"`
 
+	// SDS fetches the client certificate and the trusted CA from an SDS server,
+	// allowing the certificates to be rotated without updating the policy.
+	// +optional
+	SDS *TLSSDS `json:"sds,omitempty"`
+
 	// WellKnownCACertificates specifies whether to use a well-known set of CA
 	// certificates for validating the backend's certificate chain. Currently,
 	// only the system certificate pool is supported via SDS.
@@ -279,6 +299,27 @@ type TLSParameters struct {
 	EcdhCurves []string `json:"ecdhCurves,omitempty"`
 }
 
+// TLSSDS references the secrets served by an SDS server.
+type TLSSDS struct {
+	// CertificateSecretName is the name of the SDS secret holding the client certificate and key.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	CertificateSecretName string `json:"certificateSecretName"`
+
+	// ValidationContextSecretName is the name of the SDS secret holding the trusted CA used to
+	// validate the backend's certificate.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	ValidationContextSecretName string `json:"validationContextSecretName"`
+
+	// ClusterName is the name of the Envoy cluster serving SDS. The cluster must be defined in the
+	// proxy's bootstrap configuration. If unset, defaults to the SDS server deployed alongside the
+	// proxy, which requires the Istio integration to be enabled.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	ClusterName *string `json:"clusterName,omitempty"`
+}
+
 // +kubebuilder:validation:XValidation:rule="has(self.tlsCertificate) || has(self.tlsKey) || has(self.rootCA)",message="At least one of tlsCertificate, tlsKey, or rootCA must be set in TLSFiles"
 type TLSFiles struct {
 	// +optional
@@ -443,6 +484,48 @@ type (
 	}
 )
 
+// LocalityFailover configures the priorities of the endpoints of a backend.
+// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/priority) for more details.
+type LocalityFailover struct {
+	// FailoverPriority is the ordered list of the labels used to prioritize the endpoints.
+	// The endpoints whose values match the ones of the proxy for all the labels have the highest priority,
+	// and the endpoints have a lower priority the earlier the first label that does not match is in the list.
+	// A label can be suffixed with `=<value>` to match the endpoints against the value rather than
+	// against the label of the proxy.
+	// The endpoints have the topology.kubernetes.io/region and topology.kubernetes.io/zone labels of their node.
+	// For example, `[topology.kubernetes.io/region, topology.kubernetes.io/zone]` prefers the endpoints in the
+	// zone of the proxy, then the ones in its region, then all the others.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MinLength=1
+	// +required
+	FailoverPriority []string `json:"failoverPriority"`
+
+	// OverprovisioningFactor is the factor, in percent, applied to the ratio of healthy endpoints of a priority
+	// to compute the share of the traffic it receives. A priority receives all the traffic as long as the ratio
+	// multiplied by the factor is at least 100%, and the remaining traffic fails over to the next priority otherwise.
+	// Defaults to 140, i.e. the traffic starts to fail over when less than about 71% of the endpoints are healthy.
+	// Higher values keep more of the traffic close to the proxy at the cost of a higher load on the closer endpoints.
+	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/overprovisioning) for more details.
+	// +optional
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=1000
+	OverprovisioningFactor *int32 `json:"overprovisioningFactor,omitempty"`
+
+	// MinEndpoints is the minimum number of ready endpoints the backend must have for the failover priority
+	// to be applied. Backends with fewer endpoints load balance across all of them, as keeping the traffic close
+	// to the proxy would overload the few closer endpoints. Defaults to 1.
+	// Unlike the min_cluster_size of the Envoy zone aware load balancing, the threshold is applied by the
+	// control plane when it assigns the priorities, to the endpoints of the backend rather than to the ones
+	// of the proxy, and it defaults to 1 rather than 6. The Envoy zone aware load balancing is not used as it
+	// requires the proxy to know the endpoints of its own deployment, and only balances the traffic across the
+	// zones of the highest priority.
+	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/zone_aware) for more details.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinEndpoints *int32 `json:"minEndpoints,omitempty"`
+}
+
 type LocalityType string
 
 const (
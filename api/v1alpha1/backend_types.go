@@ -40,6 +40,10 @@ const (
 	BackendTypeDynamicForwardProxy BackendType = "DynamicForwardProxy"
 	// BackendTypeMCP is the type for MCP backends.
 	BackendTypeMCP BackendType = "MCP"
+	// BackendTypeGCP is the type for Google Cloud Run and Cloud Functions backends.
+	BackendTypeGCP BackendType = "GCP"
+	// BackendTypeAzure is the type for Azure Functions backends.
+	BackendTypeAzure BackendType = "Azure"
 )
 
 // BackendSpec defines the desired state of Backend.
@@ -49,11 +53,13 @@ const (
 // +kubebuilder:validation:XValidation:message="static backend must be specified when type is 'Static'",rule="self.type == 'Static' ? has(self.static) : true"
 // +kubebuilder:validation:XValidation:message="dynamicForwardProxy backend must be specified when type is 'DynamicForwardProxy'",rule="self.type == 'DynamicForwardProxy' ? has(self.dynamicForwardProxy) : true"
 // +kubebuilder:validation:XValidation:message="mcp backend must be specified when type is 'MCP'",rule="self.type == 'MCP' ? has(self.mcp) : true"
-// +kubebuilder:validation:ExactlyOneOf=ai;aws;static;dynamicForwardProxy;mcp
+// +kubebuilder:validation:XValidation:message="gcp backend must be specified when type is 'GCP'",rule="self.type == 'GCP' ? has(self.gcp) : true"
+// +kubebuilder:validation:XValidation:message="azure backend must be specified when type is 'Azure'",rule="self.type == 'Azure' ? has(self.azure) : true"
+// +kubebuilder:validation:ExactlyOneOf=ai;aws;static;dynamicForwardProxy;mcp;gcp;azure
 type BackendSpec struct {
 	// Type indicates the type of the backend to be used.
 	// +unionDiscriminator
-	// +kubebuilder:validation:Enum=AI;AWS;Static;DynamicForwardProxy;MCP
+	// +kubebuilder:validation:Enum=AI;AWS;Static;DynamicForwardProxy;MCP;GCP;Azure
 	// +required
 	Type BackendType `json:"type"`
 	// AI is the AI backend configuration.
@@ -73,6 +79,14 @@ type BackendSpec struct {
 	// MCP is the mcp backend configuration. The MCP backend type is only supported with agentgateway.
 	// +optional
 	MCP *MCP `json:"mcp,omitempty"`
+	// Gcp is the Google Cloud Run and Cloud Functions backend configuration.
+	// The Gcp backend type is only supported with envoy-based gateways, it is not supported in agentgateway.
+	// +optional
+	Gcp *GcpBackend `json:"gcp,omitempty"`
+	// Azure is the Azure Functions backend configuration.
+	// The Azure backend type is only supported with envoy-based gateways, it is not supported in agentgateway.
+	// +optional
+	Azure *AzureBackend `json:"azure,omitempty"`
 }
 
 // AppProtocol defines the application protocol to use when communicating with the backend.
@@ -141,22 +155,91 @@ type AwsAuthType string
 const (
 	// AwsAuthTypeSecret uses credentials stored in a Kubernetes Secret.
 	AwsAuthTypeSecret AwsAuthType = "Secret"
+	// AwsAuthTypeWebIdentity exchanges the web identity token of the proxy, such as a projected
+	// service account token, for temporary credentials using the AssumeRoleWithWebIdentity API.
+	// This is the method used by IAM Roles for Service Accounts (IRSA).
+	AwsAuthTypeWebIdentity AwsAuthType = "WebIdentity"
+	// AwsAuthTypePodIdentity obtains temporary credentials from the EKS Pod Identity agent.
+	AwsAuthTypePodIdentity AwsAuthType = "PodIdentity"
 )
 
 // AwsAuth specifies the authentication method to use for the backend.
 // +union
 // +kubebuilder:validation:XValidation:message="secretRef must be nil if the type is not 'Secret'",rule="!(has(self.secretRef) && self.type != 'Secret')"
 // +kubebuilder:validation:XValidation:message="secretRef must be specified when type is 'Secret'",rule="!(!has(self.secretRef) && self.type == 'Secret')"
+// +kubebuilder:validation:XValidation:message="webIdentity must be nil if the type is not 'WebIdentity'",rule="!(has(self.webIdentity) && self.type != 'WebIdentity')"
 type AwsAuth struct {
 	// Type specifies the authentication method to use for the backend.
+	// With an agentgateway-based Gateway, the WebIdentity and PodIdentity types use the default
+	// AWS credential chain of the proxy.
 	// +unionDiscriminator
 	// +required
-	// +kubebuilder:validation:Enum=Secret
+	// +kubebuilder:validation:Enum=Secret;WebIdentity;PodIdentity
 	Type AwsAuthType `json:"type"`
 	// SecretRef references a Kubernetes Secret containing the AWS credentials.
 	// The Secret must have keys "accessKey", "secretKey", and optionally "sessionToken".
 	// +optional
 	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	// WebIdentity configures the role to assume with the web identity token of the proxy.
+	// When omitted, the role ARN and the token file are read from the AWS_ROLE_ARN and
+	// AWS_WEB_IDENTITY_TOKEN_FILE environment variables of the proxy, as set by the EKS Pod Identity
+	// Webhook for a service account annotated with eks.amazonaws.com/role-arn.
+	// NOTE: This field is only supported with an Envoy-based Gateway. An agentgateway-based Gateway
+	// does not accept a Backend that sets it, and always reads the web identity from the environment of the proxy.
+	// +optional
+	WebIdentity *AwsWebIdentity `json:"webIdentity,omitempty"`
+	// AssumeRole configures a role to assume using the credentials obtained with the authentication method,
+	// e.g. to access a backend in another AWS account.
+	// NOTE: This field is only supported with an Envoy-based Gateway. An agentgateway-based Gateway
+	// does not accept a Backend that sets it.
+	// +optional
+	AssumeRole *AwsAssumeRole `json:"assumeRole,omitempty"`
+}
+
+// AwsWebIdentity configures the AssumeRoleWithWebIdentity API call.
+// NOTE: An explicit web identity configuration is only supported with an Envoy-based Gateway.
+type AwsWebIdentity struct {
+	// RoleARN is the ARN of the role to assume.
+	// +required
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern="^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$"
+	RoleARN string `json:"roleARN"`
+	// TokenPath is the path of the web identity token file in the proxy container.
+	// Defaults to the path of the token projected for IRSA, /var/run/secrets/eks.amazonaws.com/serviceaccount/token.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	TokenPath *string `json:"tokenPath,omitempty"`
+	// RoleSessionName is the name of the role session.
+	// +optional
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[\w+=,.@-]*$`
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+}
+
+// AwsAssumeRole configures the AssumeRole API call.
+type AwsAssumeRole struct {
+	// RoleARN is the ARN of the role to assume.
+	// +required
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern="^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$"
+	RoleARN string `json:"roleARN"`
+	// ExternalID is the external ID expected by the trust policy of the role.
+	// +optional
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=1224
+	ExternalID *string `json:"externalID,omitempty"`
+	// RoleSessionName is the name of the role session.
+	// +optional
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[\w+=,.@-]*$`
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+	// SessionDuration is the duration of the role session. Defaults to the maximum session duration of the role.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('900s') && duration(self) <= duration('43200s')",message="sessionDuration must be between 15 minutes and 12 hours"
+	SessionDuration *metav1.Duration `json:"sessionDuration,omitempty"`
 }
 
 const (
@@ -218,6 +301,129 @@ const (
 	AWSLambdaPayloadTransformEnvoy AWSLambdaPayloadTransformMode = "Envoy"
 )
 
+// GcpBackend is the configuration of a Google Cloud Run service or Cloud Function that
+// requires authentication.
+//
+// Requests are authenticated with a Google-signed ID token of a Google service account with the
+// Cloud Run Invoker or Cloud Functions Invoker role, see Auth.
+type GcpBackend struct {
+	// Host is the hostname of the Cloud Run service or Cloud Function,
+	// e.g. "my-service-abcdefghij-uc.a.run.app" or "us-central1-my-project.cloudfunctions.net".
+	// Requests are sent to the host on port 443 using TLS, and their host header is rewritten to it.
+	// +required
+	Host gwv1.PreciseHostname `json:"host"`
+
+	// Audience is the audience of the ID token.
+	// Defaults to "https://<host>", which is the audience expected by Cloud Run services and
+	// Cloud Functions unless a custom audience is configured on the service.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	Audience *string `json:"audience,omitempty"`
+
+	// Auth specifies how the ID tokens are obtained.
+	// When omitted, the ID tokens are fetched from the metadata server, as with the WorkloadIdentity type.
+	// +optional
+	Auth *GcpAuth `json:"auth,omitempty"`
+}
+
+// GcpAuthType specifies how the ID tokens of a GCP backend are obtained.
+type GcpAuthType string
+
+const (
+	// GcpAuthTypeWorkloadIdentity fetches the ID tokens of the Google service account of the proxy
+	// from the metadata server of the node running the proxy. On GKE, bind the Kubernetes service
+	// account of the proxy to the Google service account using Workload Identity Federation.
+	GcpAuthTypeWorkloadIdentity GcpAuthType = "WorkloadIdentity"
+	// GcpAuthTypeServiceAccountKey mints the ID tokens with a Google service account key. The tokens
+	// are minted by the control plane and sent to the proxy, which never sees the key.
+	GcpAuthTypeServiceAccountKey GcpAuthType = "ServiceAccountKey"
+)
+
+// GcpAuth specifies how the ID tokens of a GCP backend are obtained.
+// +union
+// +kubebuilder:validation:XValidation:message="secretRef must be specified when type is 'ServiceAccountKey'",rule="!(!has(self.secretRef) && self.type == 'ServiceAccountKey')"
+// +kubebuilder:validation:XValidation:message="secretRef must not be specified when type is 'WorkloadIdentity'",rule="!(has(self.secretRef) && self.type == 'WorkloadIdentity')"
+type GcpAuth struct {
+	// Type specifies how the ID tokens are obtained.
+	// +unionDiscriminator
+	// +required
+	// +kubebuilder:validation:Enum=WorkloadIdentity;ServiceAccountKey
+	Type GcpAuthType `json:"type"`
+	// SecretRef references a Kubernetes Secret containing the JSON key of the Google service account
+	// in the "serviceAccountKey" key.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// AzureBackend is the configuration of an Azure Function App.
+type AzureBackend struct {
+	// Host is the hostname of the Function App, e.g. "my-app.azurewebsites.net".
+	// Requests are sent to the host on port 443 using TLS, and their host header is rewritten to it.
+	// +required
+	Host gwv1.PreciseHostname `json:"host"`
+
+	// Auth specifies the authentication method to use for the Function App.
+	// When omitted, requests are sent without credentials, which is suitable for functions
+	// with the anonymous authorization level.
+	// +optional
+	Auth *AzureAuth `json:"auth,omitempty"`
+}
+
+// AzureAuthType specifies the authentication method to use for an Azure backend.
+type AzureAuthType string
+
+const (
+	// AzureAuthTypeFunctionKey authenticates requests with a function key or host key,
+	// sent in the x-functions-key header.
+	AzureAuthTypeFunctionKey AzureAuthType = "FunctionKey"
+	// AzureAuthTypeEntraID authenticates requests with a Microsoft Entra ID access token, sent in the
+	// Authorization header, for Function Apps requiring App Service authentication. The proxy obtains
+	// the tokens with the OAuth 2.0 client credentials of an Entra ID application, and refreshes them
+	// before they expire.
+	AzureAuthTypeEntraID AzureAuthType = "EntraID"
+)
+
+// AzureAuth specifies the authentication method to use for an Azure backend.
+// +union
+// +kubebuilder:validation:XValidation:message="secretRef must be specified",rule="has(self.secretRef)"
+// +kubebuilder:validation:XValidation:message="entraID must be specified if and only if type is 'EntraID'",rule="has(self.entraID) == (self.type == 'EntraID')"
+type AzureAuth struct {
+	// Type specifies the authentication method to use for the backend.
+	// +unionDiscriminator
+	// +required
+	// +kubebuilder:validation:Enum=FunctionKey;EntraID
+	Type AzureAuthType `json:"type"`
+	// SecretRef references a Kubernetes Secret containing the function key in the "functionKey" key
+	// when type is FunctionKey, or the client secret in the "clientSecret" key when type is EntraID.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	// EntraID configures the Microsoft Entra ID access tokens when type is EntraID.
+	// +optional
+	EntraID *AzureEntraIDAuth `json:"entraID,omitempty"`
+}
+
+// AzureEntraIDAuth configures the Microsoft Entra ID access tokens of an Azure backend.
+type AzureEntraIDAuth struct {
+	// TenantID is the ID of the Entra ID tenant issuing the tokens.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9.-]+$`
+	TenantID string `json:"tenantID"`
+	// ClientID is the client ID of the Entra ID application requesting the tokens.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	ClientID string `json:"clientID"`
+	// Scope is the scope of the tokens, which is the application ID URI of the Function App
+	// registration followed by "/.default", e.g. "api://my-function-app/.default".
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	Scope string `json:"scope"`
+}
+
 // StaticBackend references a static list of hosts.
 type StaticBackend struct {
 	// Hosts is a list of hosts to use for the backend.
@@ -123,6 +123,29 @@ type HTTPListenerPolicySpec struct {
 	// +optional
 	// +kubebuilder:validation:MinLength=1
 	DefaultHostForHttp10 *string `json:"defaultHostForHttp10,omitempty"`
+
+	// HTTP3 enables HTTP/3 over QUIC on the HTTPS listeners of the targeted Gateways.
+	// Each enabled listener gets a companion UDP listener on the same port that shares its TLS configuration,
+	// the proxy Service exposes the UDP port, and responses advertise HTTP/3 with the alt-svc header.
+	// +optional
+	HTTP3 *HTTP3 `json:"http3,omitempty"`
+}
+
+// HTTP3 configures HTTP/3 over QUIC for HTTPS listeners.
+type HTTP3 struct {
+	// Listeners restricts HTTP/3 to the named HTTPS listeners of the targeted Gateways.
+	// If unset, HTTP/3 is enabled on all HTTPS listeners.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Listeners []gwv1.SectionName `json:"listeners,omitempty"`
+
+	// AltSvcMaxAge is how long clients may remember that HTTP/3 is available, advertised as the
+	// max age of the alt-svc response header. Defaults to 24h.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="altSvcMaxAge must be at least 1 second"
+	AltSvcMaxAge *metav1.Duration `json:"altSvcMaxAge,omitempty"`
 }
 
 // AccessLog represents the top-level access log configuration.
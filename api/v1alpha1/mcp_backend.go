@@ -15,15 +15,27 @@ type MCP struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=32
 	// +kubebuilder:validation:XValidation:message="target names must be unique",rule="self.all(t1, self.exists_one(t2, t1.name == t2.name))"
+	// +kubebuilder:validation:XValidation:message="target tool prefixes must be unique",rule="self.all(t1, self.exists_one(t2, (has(t2.toolPrefix) ? t2.toolPrefix : t2.name) == (has(t1.toolPrefix) ? t1.toolPrefix : t1.name)))"
 	Targets []McpTargetSelector `json:"targets"`
 }
 
 // McpTargetSelector defines the MCP target to use for this backend.
 // +kubebuilder:validation:ExactlyOneOf=selector;static
+// +kubebuilder:validation:XValidation:message="toolPrefix is only supported for static targets",rule="!has(self.toolPrefix) || has(self.static)"
 type McpTargetSelector struct {
 	// Name of the MCP target.
 	Name gwv1.SectionName `json:"name"`
 
+	// ToolPrefix is the prefix of the names of the tools, prompts and resources of the target,
+	// which are exposed as `<toolPrefix>_<name>` when the backend has multiple targets.
+	// This avoids collisions between the tools with the same name of different targets.
+	// If unspecified, the name of the target is used.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	ToolPrefix *string `json:"toolPrefix,omitempty"`
+
 	// Selector is the selector to use to select the MCP targets.
 	// Note: Policies must target the resource selected by the target and
 	// not the name of the selector-based target on the Backend resource.
@@ -0,0 +1,136 @@
+package v1alpha1
+
+import (
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// MCPPolicy configures the access of the callers to the tools, prompts and resources of an MCP backend.
+// The tools, prompts and resources a caller is not allowed to access are also filtered from the
+// `tools/list`, `prompts/list` and `resources/list` responses.
+// The policy must target an MCP Backend, it is ignored for the other targets.
+// NOTE: This field is only supported with an agentgateway-based Gateway.
+type MCPPolicy struct {
+	// Authorization configures the rules that allow or deny the access to the MCP tools, prompts and resources.
+	// An item is denied if it matches a Deny rule. Otherwise, it is allowed if there are no Allow rules,
+	// or if it matches an Allow rule.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Authorization []MCPAuthorizationRule `json:"authorization,omitempty"`
+}
+
+// MCPAuthorizationRule allows or denies the access of a set of callers to a set of MCP tools, prompts
+// and resources. A rule matches an item if the caller and the item match the rule.
+//
+// The following example only allows the callers whose JWT has the `admin` group to call the
+// tools of the `github` target whose name starts with `delete_`.
+// ```yaml
+// mcp:
+//
+//	authorization:
+//	- action: Deny
+//	  target: github
+//	  tools:
+//	  - type: RegularExpression
+//	    value: "delete_.*"
+//	  from:
+//	    notClaims:
+//	    - name: groups
+//	      values: ["admin"]
+//
+// ```
+// +kubebuilder:validation:XValidation:message="at least one of tools, prompts or resources must be set",rule="has(self.tools) || has(self.prompts) || has(self.resources)"
+type MCPAuthorizationRule struct {
+	// Action defines whether the rule allows or denies the access to the matched items.
+	// If unspecified, the default is "Allow".
+	// +optional
+	// +kubebuilder:validation:Enum=Allow;Deny
+	// +kubebuilder:default=Allow
+	Action AuthorizationPolicyAction `json:"action,omitempty"`
+
+	// Target restricts the rule to the items of the target of the MCP backend with this name.
+	// If unspecified, the rule matches the items of all the targets.
+	// +optional
+	Target *gwv1.SectionName `json:"target,omitempty"`
+
+	// Tools matches the tools by name.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Tools []MCPNameMatch `json:"tools,omitempty"`
+
+	// Prompts matches the prompts by name.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Prompts []MCPNameMatch `json:"prompts,omitempty"`
+
+	// Resources matches the resources by URI.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Resources []MCPNameMatch `json:"resources,omitempty"`
+
+	// From matches the callers. If unspecified, the rule matches all the callers.
+	// +optional
+	From *MCPCallerMatch `json:"from,omitempty"`
+}
+
+// MCPNameMatch matches the name of an MCP tool or prompt, or the URI of an MCP resource.
+type MCPNameMatch struct {
+	// Type specifies how to match the value.
+	// +optional
+	// +kubebuilder:validation:Enum=Exact;RegularExpression
+	// +kubebuilder:default=Exact
+	Type *MCPNameMatchType `json:"type,omitempty"`
+
+	// Value is the name, or the RE2 regular expression the whole name must match.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Value string `json:"value"`
+}
+
+// MCPNameMatchType specifies the semantics of how MCP names are compared.
+type MCPNameMatchType string
+
+const (
+	// MCPNameMatchExact matches the exact name.
+	MCPNameMatchExact MCPNameMatchType = "Exact"
+
+	// MCPNameMatchRegularExpression matches the name with an RE2 regular expression.
+	MCPNameMatchRegularExpression MCPNameMatchType = "RegularExpression"
+)
+
+// MCPCallerMatch matches the callers of an MCP backend by the claims of their validated JWT and by
+// their request headers. A caller matches if all the conditions are satisfied.
+// +kubebuilder:validation:XValidation:message="at least one of claims, notClaims or headers must be set",rule="has(self.claims) || has(self.notClaims) || has(self.headers)"
+type MCPCallerMatch struct {
+	// Claims matches the callers whose JWT has all the claims.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Claims []MCPClaimMatch `json:"claims,omitempty"`
+
+	// NotClaims matches the callers whose JWT has none of the claims.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	NotClaims []MCPClaimMatch `json:"notClaims,omitempty"`
+
+	// Headers matches the callers whose requests have all the headers.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwv1.HTTPHeaderMatch `json:"headers,omitempty"`
+}
+
+// MCPClaimMatch matches a claim of the validated JWT of the caller.
+type MCPClaimMatch struct {
+	// Name is the name of the claim.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Values matches the claims equal to one of the values. For a claim that is a list,
+	// such as `groups`, the claim matches if it contains one of the values.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Values []string `json:"values"`
+}
@@ -31,6 +31,17 @@ const (
 	//
 	PolicyConditionAttached PolicyConditionType = "Attached"
 
+	// PolicyConditionPartiallyAccepted indicates that the policy has been accepted, but one or more
+	// of its fields are not applied by the data plane, e.g., because the field is not supported for
+	// the targeted kind or data plane, or because the field is invalid.
+	// The message lists the fields that are not applied and why.
+	//
+	// Possible reasons for this condition to be True are:
+	// * FieldsIgnored
+	// * FieldsInvalid
+	//
+	PolicyConditionPartiallyAccepted PolicyConditionType = "PartiallyAccepted"
+
 	// PolicyReasonValid is used with the "Accepted" condition when the policy
 	// has been accepted by the system.
 	PolicyReasonValid PolicyConditionReason = "Valid"
@@ -52,6 +63,14 @@ const (
 	// with another policy of higher priority.
 	PolicyReasonOverridden PolicyConditionReason = "Overridden"
 
+	// PolicyReasonFieldsIgnored is used with the "PartiallyAccepted" condition when one or more
+	// fields of the policy are ignored because they are not applicable to the targeted resources or data plane.
+	PolicyReasonFieldsIgnored PolicyConditionReason = "FieldsIgnored"
+
+	// PolicyReasonFieldsInvalid is used with the "PartiallyAccepted" condition when one or more
+	// fields of the policy are invalid and not applied, while the remaining fields are applied.
+	PolicyReasonFieldsInvalid PolicyConditionReason = "FieldsInvalid"
+
 	// PolicyReasonPending is used with the "Accepted" or "Attached" condition when the policy has been referenced but not yet fully processed by the controller.
 	PolicyReasonPending PolicyConditionReason = "Pending"
 )
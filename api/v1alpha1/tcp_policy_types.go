@@ -0,0 +1,125 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=tcppolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=tcppolicies/status,verbs=get;update;patch
+
+// +kubebuilder:printcolumn:name="Accepted",type=string,JSONPath=".status.ancestors[*].conditions[?(@.type=='Accepted')].status",description="TCP policy acceptance status"
+// +kubebuilder:printcolumn:name="Attached",type=string,JSONPath=".status.ancestors[*].conditions[?(@.type=='Attached')].status",description="TCP policy attachment status"
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={app=kgateway,app.kubernetes.io/name=kgateway}
+// +kubebuilder:resource:categories=kgateway
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=Direct"
+// TCPPolicy configures connection-level policies, such as network RBAC, connection rate limiting and
+// connection timeouts, for the traffic of the targeted TCPRoute and TLSRoute resources.
+// NOTE: TCPPolicy is only supported with an Envoy-based Gateway.
+type TCPPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TCPPolicySpec `json:"spec,omitempty"`
+
+	Status gwv1.PolicyStatus `json:"status,omitempty"`
+	// TODO: embed this into a typed Status field when
+	// https://github.com/kubernetes/kubernetes/issues/131533 is resolved
+}
+
+// +kubebuilder:object:root=true
+type TCPPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TCPPolicy `json:"items"`
+}
+
+// TCPPolicySpec defines the desired state of a TCP policy.
+type TCPPolicySpec struct {
+	// TargetRefs specifies the target resources by reference to attach the policy to.
+	// +optional
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'TCPRoute' || r.kind == 'TLSRoute') && (!has(r.group) || r.group == 'gateway.networking.k8s.io'))",message="targetRefs may only reference TCPRoute or TLSRoute resources"
+	TargetRefs []LocalPolicyTargetReference `json:"targetRefs,omitempty"`
+
+	// TargetSelectors specifies the target selectors to select resources to attach the policy to.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'TCPRoute' || r.kind == 'TLSRoute') && (!has(r.group) || r.group == 'gateway.networking.k8s.io'))",message="targetSelectors may only reference TCPRoute or TLSRoute resources"
+	TargetSelectors []LocalPolicyTargetSelector `json:"targetSelectors,omitempty"`
+
+	// RBAC allows or denies connections based on the client address, the requested SNI and the
+	// principal of the client certificate. It is enforced by the Envoy network RBAC filter before
+	// the connection is proxied to the backends.
+	// +optional
+	RBAC *TCPRBAC `json:"rbac,omitempty"`
+
+	// ConnectionRateLimit limits the rate at which new connections are accepted by each Envoy
+	// instance. Connections exceeding the limit are closed immediately.
+	// +optional
+	ConnectionRateLimit *TokenBucket `json:"connectionRateLimit,omitempty"`
+
+	// IdleTimeout is the amount of time a connection may have no data sent or received in either
+	// direction before it is closed. Envoy's default of 1h applies if unset; a value of 0s disables
+	// the idle timeout.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// MaxConnectionDuration is the maximum duration of a connection, after which it is closed
+	// regardless of activity. If unset, connections have no maximum duration.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="must be at least 1ms"
+	MaxConnectionDuration *metav1.Duration `json:"maxConnectionDuration,omitempty"`
+}
+
+// TCPRBAC defines the network-level role-based access control for connections.
+type TCPRBAC struct {
+	// Action defines whether connections matching any of the rules are allowed or denied.
+	// With the Allow action, connections that match none of the rules are denied; with the
+	// Deny action, connections that match none of the rules are allowed.
+	// If unspecified, the default is "Allow".
+	// +kubebuilder:validation:Enum=Allow;Deny
+	// +kubebuilder:default=Allow
+	Action AuthorizationPolicyAction `json:"action,omitempty"`
+
+	// Rules is the list of rules to match connections against. A connection matches the policy
+	// if it matches any of the rules.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Rules []TCPRBACRule `json:"rules"`
+}
+
+// TCPRBACRule matches a connection when all of its specified fields match.
+// Within a single field, matching any one of the listed values is sufficient.
+// +kubebuilder:validation:XValidation:rule="has(self.sourceCIDRs) || has(self.snis) || has(self.principals)",message="at least one of sourceCIDRs, snis or principals must be set"
+type TCPRBACRule struct {
+	// SourceCIDRs matches the address of the downstream peer, e.g. "10.0.0.0/8" or "2001:db8::/32".
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:XValidation:rule="isCIDR(self)",message="sourceCIDRs must be valid CIDRs"
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+
+	// SNIs matches the server name requested by the client in the TLS ClientHello.
+	// A leading "*." matches any subdomain, e.g. "*.example.com" matches "foo.example.com".
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	SNIs []string `json:"snis,omitempty"`
+
+	// Principals matches the identity of the client certificate presented over mTLS, that is its
+	// URI SAN, or its subject if there is no URI SAN, e.g. "spiffe://cluster.local/ns/default/sa/client".
+	// It only matches when the listener terminates TLS and validates client certificates.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Principals []string `json:"principals,omitempty"`
+}
@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"slices"
+
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -121,6 +123,36 @@ type TrafficPolicySpec struct {
 	// Agentgateway-based Gateway supports cumulative RBAC policies across different attachment points, such that
 	// an RBAC policy attached to a route augments policies applied to the gateway or listener without overriding them.
 	RBAC *RBAC `json:"rbac,omitempty"`
+
+	// MCP configures the access of the callers to the tools, prompts and resources of the targeted MCP backends.
+	// NOTE: This field is only supported with an agentgateway-based Gateway.
+	// +optional
+	MCP *MCPPolicy `json:"mcp,omitempty"`
+
+	// WAF configures a Web Application Firewall for the policy targets.
+	// NOTE: This field is only supported with an Envoy-based Gateway.
+	// +optional
+	WAF *WAF `json:"waf,omitempty"`
+
+	// DestinationRuleSubset selects a named subset of the Istio DestinationRule that applies to the
+	// backends of the targeted routes. To split traffic between subsets of the same Service, reference
+	// a TrafficPolicy per subset from the HTTPRoute backendRefs using an ExtensionRef filter.
+	// Backends with no subsets in their DestinationRule are not affected, while requests to a backend
+	// whose DestinationRule does not define the selected subset fail, as with Istio.
+	// This field is only applicable to route targets and requires the Istio integration to be enabled.
+	// NOTE: This field is only supported with an Envoy-based Gateway.
+	// +optional
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	DestinationRuleSubset *string `json:"destinationRuleSubset,omitempty"`
+
+	// ShadowMode marks the policy, or a subset of its fields, as evaluated but not enforced.
+	// This is useful to roll out a new policy and observe its decisions through metrics
+	// and access logs before enforcing it.
+	// NOTE: This field is only supported with an Envoy-based Gateway.
+	// +optional
+	ShadowMode *ShadowMode `json:"shadowMode,omitempty"`
 }
 
 // TransformationPolicy config is used to modify envoy behavior at a route level.
@@ -290,7 +322,7 @@ type RateLimitDescriptor struct {
 }
 
 // RateLimitDescriptorEntryType defines the type of a rate limit descriptor entry.
-// +kubebuilder:validation:Enum=Generic;Header;RemoteAddress;Path
+// +kubebuilder:validation:Enum=Generic;Header;RemoteAddress;Path;JWTClaim
 type RateLimitDescriptorEntryType string
 
 const (
@@ -305,11 +337,14 @@ const (
 
 	// RateLimitDescriptorEntryTypePath represents a descriptor entry that uses the request path as its value.
 	RateLimitDescriptorEntryTypePath RateLimitDescriptorEntryType = "Path"
+
+	// RateLimitDescriptorEntryTypeJWTClaim represents a descriptor entry that uses a claim of the validated JWT of the request as its value.
+	RateLimitDescriptorEntryTypeJWTClaim RateLimitDescriptorEntryType = "JWTClaim"
 )
 
 // RateLimitDescriptorEntry defines a single entry in a rate limit descriptor.
 // Only one entry type may be specified.
-// +kubebuilder:validation:XValidation:message="exactly one entry type must be specified",rule="(has(self.type) && (self.type == 'Generic' && has(self.generic) && !has(self.header)) || (self.type == 'Header' && has(self.header) && !has(self.generic)) || (self.type == 'RemoteAddress' && !has(self.generic) && !has(self.header)) || (self.type == 'Path' && !has(self.generic) && !has(self.header)))"
+// +kubebuilder:validation:XValidation:message="exactly one entry type must be specified",rule="(has(self.type) && (self.type == 'Generic' && has(self.generic) && !has(self.header) && !has(self.jwtClaim)) || (self.type == 'Header' && has(self.header) && !has(self.generic) && !has(self.jwtClaim)) || (self.type == 'RemoteAddress' && !has(self.generic) && !has(self.header) && !has(self.jwtClaim)) || (self.type == 'Path' && !has(self.generic) && !has(self.header) && !has(self.jwtClaim)) || (self.type == 'JWTClaim' && has(self.jwtClaim) && !has(self.generic) && !has(self.header)))"
 type RateLimitDescriptorEntry struct {
 	// Type specifies what kind of rate limit descriptor entry this is.
 	// +required
@@ -325,6 +360,14 @@ type RateLimitDescriptorEntry struct {
 	// +optional
 	// +kubebuilder:validation:MinLength=1
 	Header *string `json:"header,omitempty"`
+
+	// JWTClaim specifies the claim of the validated JWT of the request to extract the descriptor value from.
+	// With Envoy, the claim is read from the JWT payload that the JWT authentication filter stores in the
+	// `payload` key of its dynamic metadata. With agentgateway, the claim is read from the validated JWT.
+	// This field must be specified when Type is JWTClaim.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	JWTClaim *string `json:"jwtClaim,omitempty"`
 }
 
 // RateLimitDescriptorEntryGeneric defines a generic key-value descriptor entry.
@@ -474,3 +517,46 @@ type Timeouts struct {
 	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
 	StreamIdle *metav1.Duration `json:"streamIdle,omitempty"`
 }
+
+// ShadowMode configures which fields of a TrafficPolicy are evaluated without being enforced.
+//
+// In shadow mode:
+//   - RBAC rules are configured as Envoy shadow rules. The decision is recorded in the
+//     `shadow_` prefixed RBAC stats and in the `envoy.filters.http.rbac` dynamic metadata
+//     (`shadow_effective_policy_id` and `shadow_engine_result`), which can be used in access logs.
+//   - RateLimit decisions are computed and counted in the rate limit stats but requests over the
+//     limit are not rejected. Local rate limits add the `x-kgateway-ratelimit-shadow: limited`
+//     request header to requests that would have been limited.
+//
+// ExtAuth does not support shadow mode since the authorization server denials are always enforced.
+// A policy with extAuth that is in shadow mode for all its fields is not accepted.
+type ShadowMode struct {
+	// Fields restricts shadow mode to the listed fields of the policy.
+	// If unset or empty, all fields that support shadow mode are evaluated in shadow mode.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=2
+	Fields []ShadowModeField `json:"fields,omitempty"`
+}
+
+// ShadowModeField is a TrafficPolicy field that supports shadow mode.
+// +kubebuilder:validation:Enum=RBAC;RateLimit
+type ShadowModeField string
+
+const (
+	// ShadowModeFieldRBAC evaluates the rbac field in shadow mode.
+	ShadowModeFieldRBAC ShadowModeField = "RBAC"
+
+	// ShadowModeFieldRateLimit evaluates the rateLimit field in shadow mode.
+	ShadowModeFieldRateLimit ShadowModeField = "RateLimit"
+)
+
+// Shadows returns true if the given field is evaluated in shadow mode.
+func (s *ShadowMode) Shadows(field ShadowModeField) bool {
+	return s.ShadowsAll() || (s != nil && slices.Contains(s.Fields, field))
+}
+
+// ShadowsAll returns true if all the fields of the policy are evaluated in shadow mode.
+func (s *ShadowMode) ShadowsAll() bool {
+	return s != nil && len(s.Fields) == 0
+}
@@ -0,0 +1,94 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// WAF configures a Web Application Firewall for the policy targets.
+// The WAF is backed by a Coraza (ModSecurity SecLang compatible) Wasm filter, so that
+// OWASP Core Rule Set (CRS) style rule sets can be used as is.
+//
+// +kubebuilder:validation:ExactlyOneOf=ruleSets;disable
+// +kubebuilder:validation:XValidation:rule="!has(self.disable) || (!has(self.paranoiaLevel) && !has(self.ruleExclusions) && !has(self.mode))",message="paranoiaLevel, ruleExclusions and mode cannot be set when disable is set"
+type WAF struct {
+	// RuleSets is the list of rule sets evaluated by the WAF, in order.
+	// Each rule set is loaded from a ConfigMap in the same namespace as the policy.
+	// +optional
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	RuleSets []WAFRuleSet `json:"ruleSets,omitempty"`
+
+	// ParanoiaLevel sets the OWASP CRS blocking paranoia level.
+	// Higher levels enable more rules, at the cost of more false positives.
+	// If unset, the paranoia level configured in the rule sets is used.
+	// +optional
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4
+	ParanoiaLevel *int32 `json:"paranoiaLevel,omitempty"`
+
+	// RuleExclusions removes rules from the rule sets, for example to suppress false positives
+	// on a given route.
+	// +optional
+	//
+	// +kubebuilder:validation:MaxItems=64
+	RuleExclusions []WAFRuleExclusion `json:"ruleExclusions,omitempty"`
+
+	// Mode controls whether the WAF blocks the requests matching its rules or only
+	// logs them in the audit log. Defaults to Enforce.
+	// +optional
+	Mode *WAFMode `json:"mode,omitempty"`
+
+	// Disable the WAF for the policy targets, overriding any WAF configured at
+	// a less specific level.
+	// +optional
+	Disable *PolicyDisable `json:"disable,omitempty"`
+}
+
+// WAFRuleSet references SecLang directives stored in a ConfigMap.
+type WAFRuleSet struct {
+	// ConfigMapRef references the ConfigMap that contains the directives.
+	// +required
+	ConfigMapRef corev1.LocalObjectReference `json:"configMapRef"`
+
+	// Key is the key of the ConfigMap that contains the directives.
+	// If unset, the directives in all the keys of the ConfigMap are loaded,
+	// in lexicographical order of the keys.
+	// +optional
+	//
+	// +kubebuilder:validation:MinLength=1
+	Key *string `json:"key,omitempty"`
+}
+
+// WAFRuleExclusion removes rules from the WAF rule sets.
+//
+// +kubebuilder:validation:ExactlyOneOf=id;tag
+type WAFRuleExclusion struct {
+	// ID removes the rule with the given ID, or the rules in the given inclusive
+	// range of IDs, e.g. "942100" or "942100-942999".
+	// +optional
+	//
+	// +kubebuilder:validation:Pattern=`^[0-9]+(-[0-9]+)?$`
+	ID *string `json:"id,omitempty"`
+
+	// Tag removes the rules with the given tag, e.g. "attack-sqli".
+	// +optional
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[^\s"]+$`
+	Tag *string `json:"tag,omitempty"`
+}
+
+// WAFMode is the mode of the WAF rule engine.
+// +kubebuilder:validation:Enum=Enforce;AuditOnly
+type WAFMode string
+
+const (
+	// WAFModeEnforce blocks the requests matching the WAF rules.
+	WAFModeEnforce WAFMode = "Enforce"
+
+	// WAFModeAuditOnly evaluates the WAF rules and logs the matches in the audit log,
+	// without blocking any request.
+	WAFModeAuditOnly WAFMode = "AuditOnly"
+)
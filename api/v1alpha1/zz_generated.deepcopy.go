@@ -7,9 +7,9 @@ package v1alpha1
 import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -27,6 +27,11 @@ func (in *AIBackend) DeepCopyInto(out *AIBackend) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(AIFailover)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIBackend.
@@ -39,6 +44,118 @@ func (in *AIBackend) DeepCopy() *AIBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIEmbedding) DeepCopyInto(out *AIEmbedding) {
+	*out = *in
+	if in.OpenAI != nil {
+		in, out := &in.OpenAI, &out.OpenAI
+		*out = new(OpenAIConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIEmbedding.
+func (in *AIEmbedding) DeepCopy() *AIEmbedding {
+	if in == nil {
+		return nil
+	}
+	out := new(AIEmbedding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIFailover) DeepCopyInto(out *AIFailover) {
+	*out = *in
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]AIFailoverTrigger, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConsecutiveFailures != nil {
+		in, out := &in.ConsecutiveFailures, &out.ConsecutiveFailures
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EjectionDuration != nil {
+		in, out := &in.EjectionDuration, &out.EjectionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LoadBalancing != nil {
+		in, out := &in.LoadBalancing, &out.LoadBalancing
+		*out = new(AILoadBalancing)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIFailover.
+func (in *AIFailover) DeepCopy() *AIFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(AIFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIModelRoute) DeepCopyInto(out *AIModelRoute) {
+	*out = *in
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(apisv1.SectionName)
+		**out = **in
+	}
+	if in.Model != nil {
+		in, out := &in.Model, &out.Model
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIModelRoute.
+func (in *AIModelRoute) DeepCopy() *AIModelRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(AIModelRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIModelRouting) DeepCopyInto(out *AIModelRouting) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]AIModelRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnknownModelResponse != nil {
+		in, out := &in.UnknownModelResponse, &out.UnknownModelResponse
+		*out = new(AIUnknownModelResponse)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIModelRouting.
+func (in *AIModelRouting) DeepCopy() *AIModelRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(AIModelRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIPolicy) DeepCopyInto(out *AIPolicy) {
 	*out = *in
@@ -62,6 +179,21 @@ func (in *AIPolicy) DeepCopyInto(out *AIPolicy) {
 		*out = new(RouteType)
 		**out = **in
 	}
+	if in.TokenRateLimit != nil {
+		in, out := &in.TokenRateLimit, &out.TokenRateLimit
+		*out = new(RateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ModelRouting != nil {
+		in, out := &in.ModelRouting, &out.ModelRouting
+		*out = new(AIModelRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SemanticCache != nil {
+		in, out := &in.SemanticCache, &out.SemanticCache
+		*out = new(AISemanticCache)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIPolicy.
@@ -124,6 +256,121 @@ func (in *AIPromptGuard) DeepCopy() *AIPromptGuard {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AISemanticCache) DeepCopyInto(out *AISemanticCache) {
+	*out = *in
+	if in.Store != nil {
+		in, out := &in.Store, &out.Store
+		*out = new(AIVectorStore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Embedding != nil {
+		in, out := &in.Embedding, &out.Embedding
+		*out = new(AIEmbedding)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SimilarityThreshold != nil {
+		in, out := &in.SimilarityThreshold, &out.SimilarityThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PartitionKey != nil {
+		in, out := &in.PartitionKey, &out.PartitionKey
+		*out = new(AISemanticCachePartitionKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AISemanticCache.
+func (in *AISemanticCache) DeepCopy() *AISemanticCache {
+	if in == nil {
+		return nil
+	}
+	out := new(AISemanticCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AISemanticCachePartitionKey) DeepCopyInto(out *AISemanticCachePartitionKey) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+	if in.JWTClaim != nil {
+		in, out := &in.JWTClaim, &out.JWTClaim
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AISemanticCachePartitionKey.
+func (in *AISemanticCachePartitionKey) DeepCopy() *AISemanticCachePartitionKey {
+	if in == nil {
+		return nil
+	}
+	out := new(AISemanticCachePartitionKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIUnknownModelResponse) DeepCopyInto(out *AIUnknownModelResponse) {
+	*out = *in
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIUnknownModelResponse.
+func (in *AIUnknownModelResponse) DeepCopy() *AIUnknownModelResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(AIUnknownModelResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIVectorStore) DeepCopyInto(out *AIVectorStore) {
+	*out = *in
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisVectorStore)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIVectorStore.
+func (in *AIVectorStore) DeepCopy() *AIVectorStore {
+	if in == nil {
+		return nil
+	}
+	out := new(AIVectorStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSGuardrailConfig) DeepCopyInto(out *AWSGuardrailConfig) {
 	*out = *in
@@ -387,7 +634,7 @@ func (in *AiExtensionTrace) DeepCopyInto(out *AiExtensionTrace) {
 	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Protocol != nil {
@@ -500,6 +747,36 @@ func (in *AuthHeader) DeepCopy() *AuthHeader {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsAssumeRole) DeepCopyInto(out *AwsAssumeRole) {
+	*out = *in
+	if in.ExternalID != nil {
+		in, out := &in.ExternalID, &out.ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleSessionName != nil {
+		in, out := &in.RoleSessionName, &out.RoleSessionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionDuration != nil {
+		in, out := &in.SessionDuration, &out.SessionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsAssumeRole.
+func (in *AwsAssumeRole) DeepCopy() *AwsAssumeRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsAssumeRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwsAuth) DeepCopyInto(out *AwsAuth) {
 	*out = *in
@@ -508,6 +785,16 @@ func (in *AwsAuth) DeepCopyInto(out *AwsAuth) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.WebIdentity != nil {
+		in, out := &in.WebIdentity, &out.WebIdentity
+		*out = new(AwsWebIdentity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AssumeRole != nil {
+		in, out := &in.AssumeRole, &out.AssumeRole
+		*out = new(AwsAssumeRole)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsAuth.
@@ -566,6 +853,91 @@ func (in *AwsLambda) DeepCopy() *AwsLambda {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsWebIdentity) DeepCopyInto(out *AwsWebIdentity) {
+	*out = *in
+	if in.TokenPath != nil {
+		in, out := &in.TokenPath, &out.TokenPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleSessionName != nil {
+		in, out := &in.RoleSessionName, &out.RoleSessionName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsWebIdentity.
+func (in *AwsWebIdentity) DeepCopy() *AwsWebIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsWebIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureAuth) DeepCopyInto(out *AzureAuth) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.EntraID != nil {
+		in, out := &in.EntraID, &out.EntraID
+		*out = new(AzureEntraIDAuth)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAuth.
+func (in *AzureAuth) DeepCopy() *AzureAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBackend) DeepCopyInto(out *AzureBackend) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AzureAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBackend.
+func (in *AzureBackend) DeepCopy() *AzureBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEntraIDAuth) DeepCopyInto(out *AzureEntraIDAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEntraIDAuth.
+func (in *AzureEntraIDAuth) DeepCopy() *AzureEntraIDAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEntraIDAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureOpenAIConfig) DeepCopyInto(out *AzureOpenAIConfig) {
 	*out = *in
@@ -685,7 +1057,7 @@ func (in *BackendConfigPolicySpec) DeepCopyInto(out *BackendConfigPolicySpec) {
 	}
 	if in.ConnectTimeout != nil {
 		in, out := &in.ConnectTimeout, &out.ConnectTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.PerConnectionBufferLimitBytes != nil {
@@ -723,6 +1095,11 @@ func (in *BackendConfigPolicySpec) DeepCopyInto(out *BackendConfigPolicySpec) {
 		*out = new(LoadBalancer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LocalityFailover != nil {
+		in, out := &in.LocalityFailover, &out.LocalityFailover
+		*out = new(LocalityFailover)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HealthCheck != nil {
 		in, out := &in.HealthCheck, &out.HealthCheck
 		*out = new(HealthCheck)
@@ -805,6 +1182,16 @@ func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 		*out = new(MCP)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Gcp != nil {
+		in, out := &in.Gcp, &out.Gcp
+		*out = new(GcpBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureBackend)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSpec.
@@ -822,7 +1209,7 @@ func (in *BackendStatus) DeepCopyInto(out *BackendStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -845,7 +1232,7 @@ func (in *BackoffStrategy) DeepCopyInto(out *BackoffStrategy) {
 	out.BaseInterval = in.BaseInterval
 	if in.MaxInterval != nil {
 		in, out := &in.MaxInterval, &out.MaxInterval
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -1003,7 +1390,7 @@ func (in *CommonGrpcService) DeepCopyInto(out *CommonGrpcService) {
 	*out = *in
 	if in.BackendRef != nil {
 		in, out := &in.BackendRef, &out.BackendRef
-		*out = new(v1.BackendRef)
+		*out = new(apisv1.BackendRef)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authority != nil {
@@ -1023,7 +1410,7 @@ func (in *CommonGrpcService) DeepCopyInto(out *CommonGrpcService) {
 	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.InitialMetadata != nil {
@@ -1055,7 +1442,7 @@ func (in *CommonHttpProtocolOptions) DeepCopyInto(out *CommonHttpProtocolOptions
 	*out = *in
 	if in.IdleTimeout != nil {
 		in, out := &in.IdleTimeout, &out.IdleTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MaxHeadersCount != nil {
@@ -1065,7 +1452,7 @@ func (in *CommonHttpProtocolOptions) DeepCopyInto(out *CommonHttpProtocolOptions
 	}
 	if in.MaxStreamDuration != nil {
 		in, out := &in.MaxStreamDuration, &out.MaxStreamDuration
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MaxRequestsPerConnection != nil {
@@ -1110,7 +1497,7 @@ func (in *Cookie) DeepCopyInto(out *Cookie) {
 	}
 	if in.TTL != nil {
 		in, out := &in.TTL, &out.TTL
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Secure != nil {
@@ -1145,7 +1532,7 @@ func (in *CorsPolicy) DeepCopyInto(out *CorsPolicy) {
 	*out = *in
 	if in.HTTPCORSFilter != nil {
 		in, out := &in.HTTPCORSFilter, &out.HTTPCORSFilter
-		*out = new(v1.HTTPCORSFilter)
+		*out = new(apisv1.HTTPCORSFilter)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Disable != nil {
@@ -1648,7 +2035,7 @@ func (in *ExtGrpcService) DeepCopyInto(out *ExtGrpcService) {
 	*out = *in
 	if in.BackendRef != nil {
 		in, out := &in.BackendRef, &out.BackendRef
-		*out = new(v1.BackendRef)
+		*out = new(apisv1.BackendRef)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authority != nil {
@@ -1658,7 +2045,7 @@ func (in *ExtGrpcService) DeepCopyInto(out *ExtGrpcService) {
 	}
 	if in.RequestTimeout != nil {
 		in, out := &in.RequestTimeout, &out.RequestTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -1718,12 +2105,12 @@ func (in *ExtProcProvider) DeepCopyInto(out *ExtProcProvider) {
 	}
 	if in.MessageTimeout != nil {
 		in, out := &in.MessageTimeout, &out.MessageTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MaxMessageTimeout != nil {
 		in, out := &in.MaxMessageTimeout, &out.MaxMessageTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.StatPrefix != nil {
@@ -1922,7 +2309,7 @@ func (in *GatewayExtensionStatus) DeepCopyInto(out *GatewayExtensionStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2039,7 +2426,52 @@ func (in *GatewayParametersStatus) DeepCopy() *GatewayParametersStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GeminiConfig) DeepCopyInto(out *GeminiConfig) {
+func (in *GcpAuth) DeepCopyInto(out *GcpAuth) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpAuth.
+func (in *GcpAuth) DeepCopy() *GcpAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpBackend) DeepCopyInto(out *GcpBackend) {
+	*out = *in
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
+		**out = **in
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(GcpAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpBackend.
+func (in *GcpBackend) DeepCopy() *GcpBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeminiConfig) DeepCopyInto(out *GeminiConfig) {
 	*out = *in
 	in.AuthToken.DeepCopyInto(&out.AuthToken)
 }
@@ -2099,6 +2531,31 @@ func (in *GrpcStatusFilter) DeepCopy() *GrpcStatusFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTP3) DeepCopyInto(out *HTTP3) {
+	*out = *in
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]apisv1.SectionName, len(*in))
+		copy(*out, *in)
+	}
+	if in.AltSvcMaxAge != nil {
+		in, out := &in.AltSvcMaxAge, &out.AltSvcMaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP3.
+func (in *HTTP3) DeepCopy() *HTTP3 {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTP3)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPListenerPolicy) DeepCopyInto(out *HTTPListenerPolicy) {
 	*out = *in
@@ -2207,12 +2664,12 @@ func (in *HTTPListenerPolicySpec) DeepCopyInto(out *HTTPListenerPolicySpec) {
 	}
 	if in.StreamIdleTimeout != nil {
 		in, out := &in.StreamIdleTimeout, &out.StreamIdleTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.IdleTimeout != nil {
 		in, out := &in.IdleTimeout, &out.IdleTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.HealthCheck != nil {
@@ -2235,6 +2692,11 @@ func (in *HTTPListenerPolicySpec) DeepCopyInto(out *HTTPListenerPolicySpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.HTTP3 != nil {
+		in, out := &in.HTTP3, &out.HTTP3
+		*out = new(HTTP3)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPListenerPolicySpec.
@@ -2318,12 +2780,12 @@ func (in *HeaderModifiers) DeepCopyInto(out *HeaderModifiers) {
 	*out = *in
 	if in.Request != nil {
 		in, out := &in.Request, &out.Request
-		*out = new(v1.HTTPHeaderFilter)
+		*out = new(apisv1.HTTPHeaderFilter)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Response != nil {
 		in, out := &in.Response, &out.Response
-		*out = new(v1.HTTPHeaderFilter)
+		*out = new(apisv1.HTTPHeaderFilter)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -2378,12 +2840,12 @@ func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
 	*out = *in
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.UnhealthyThreshold != nil {
@@ -2818,7 +3280,7 @@ func (in *LLMProvider) DeepCopyInto(out *LLMProvider) {
 	}
 	if in.Port != nil {
 		in, out := &in.Port, &out.Port
-		*out = new(v1.PortNumber)
+		*out = new(apisv1.PortNumber)
 		**out = **in
 	}
 	if in.Path != nil {
@@ -2853,7 +3315,7 @@ func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 	}
 	if in.UpdateMergeWindow != nil {
 		in, out := &in.UpdateMergeWindow, &out.UpdateMergeWindow
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.LeastRequest != nil {
@@ -3051,7 +3513,7 @@ func (in *LocalPolicyTargetReferenceWithSectionName) DeepCopyInto(out *LocalPoli
 	out.LocalPolicyTargetReference = in.LocalPolicyTargetReference
 	if in.SectionName != nil {
 		in, out := &in.SectionName, &out.SectionName
-		*out = new(v1.SectionName)
+		*out = new(apisv1.SectionName)
 		**out = **in
 	}
 }
@@ -3094,7 +3556,7 @@ func (in *LocalPolicyTargetSelectorWithSectionName) DeepCopyInto(out *LocalPolic
 	in.LocalPolicyTargetSelector.DeepCopyInto(&out.LocalPolicyTargetSelector)
 	if in.SectionName != nil {
 		in, out := &in.SectionName, &out.SectionName
-		*out = new(v1.SectionName)
+		*out = new(apisv1.SectionName)
 		**out = **in
 	}
 }
@@ -3129,6 +3591,36 @@ func (in *LocalRateLimitPolicy) DeepCopy() *LocalRateLimitPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalityFailover) DeepCopyInto(out *LocalityFailover) {
+	*out = *in
+	if in.FailoverPriority != nil {
+		in, out := &in.FailoverPriority, &out.FailoverPriority
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OverprovisioningFactor != nil {
+		in, out := &in.OverprovisioningFactor, &out.OverprovisioningFactor
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinEndpoints != nil {
+		in, out := &in.MinEndpoints, &out.MinEndpoints
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalityFailover.
+func (in *LocalityFailover) DeepCopy() *LocalityFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalityFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCP) DeepCopyInto(out *MCP) {
 	*out = *in
@@ -3151,17 +3643,161 @@ func (in *MCP) DeepCopy() *MCP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPAuthorizationRule) DeepCopyInto(out *MCPAuthorizationRule) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(apisv1.SectionName)
+		**out = **in
+	}
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]MCPNameMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Prompts != nil {
+		in, out := &in.Prompts, &out.Prompts
+		*out = make([]MCPNameMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]MCPNameMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(MCPCallerMatch)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuthorizationRule.
+func (in *MCPAuthorizationRule) DeepCopy() *MCPAuthorizationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPAuthorizationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCallerMatch) DeepCopyInto(out *MCPCallerMatch) {
+	*out = *in
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]MCPClaimMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NotClaims != nil {
+		in, out := &in.NotClaims, &out.NotClaims
+		*out = make([]MCPClaimMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCallerMatch.
+func (in *MCPCallerMatch) DeepCopy() *MCPCallerMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCallerMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPClaimMatch) DeepCopyInto(out *MCPClaimMatch) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPClaimMatch.
+func (in *MCPClaimMatch) DeepCopy() *MCPClaimMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPClaimMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPNameMatch) DeepCopyInto(out *MCPNameMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(MCPNameMatchType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPNameMatch.
+func (in *MCPNameMatch) DeepCopy() *MCPNameMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPNameMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPPolicy) DeepCopyInto(out *MCPPolicy) {
+	*out = *in
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = make([]MCPAuthorizationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPolicy.
+func (in *MCPPolicy) DeepCopy() *MCPPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *McpSelector) DeepCopyInto(out *McpSelector) {
 	*out = *in
 	if in.Namespace != nil {
 		in, out := &in.Namespace, &out.Namespace
-		*out = new(metav1.LabelSelector)
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Service != nil {
 		in, out := &in.Service, &out.Service
-		*out = new(metav1.LabelSelector)
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -3204,6 +3840,11 @@ func (in *McpTarget) DeepCopy() *McpTarget {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *McpTargetSelector) DeepCopyInto(out *McpTargetSelector) {
 	*out = *in
+	if in.ToolPrefix != nil {
+		in, out := &in.ToolPrefix, &out.ToolPrefix
+		*out = new(string)
+		**out = **in
+	}
 	if in.Selector != nil {
 		in, out := &in.Selector, &out.Selector
 		*out = new(McpSelector)
@@ -3362,7 +4003,7 @@ func (in *NamespacedObjectReference) DeepCopyInto(out *NamespacedObjectReference
 	*out = *in
 	if in.Namespace != nil {
 		in, out := &in.Namespace, &out.Namespace
-		*out = new(v1.Namespace)
+		*out = new(apisv1.Namespace)
 		**out = **in
 	}
 }
@@ -3502,12 +4143,12 @@ func (in *OutlierDetection) DeepCopyInto(out *OutlierDetection) {
 	}
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.BaseEjectionTime != nil {
 		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MaxEjectionPercent != nil {
@@ -3527,6 +4168,48 @@ func (in *OutlierDetection) DeepCopy() *OutlierDetection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PIIDetection) DeepCopyInto(out *PIIDetection) {
+	*out = *in
+	if in.Entities != nil {
+		in, out := &in.Entities, &out.Entities
+		*out = make([]PIIEntity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIIDetection.
+func (in *PIIDetection) DeepCopy() *PIIDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(PIIDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PIIEntity) DeepCopyInto(out *PIIEntity) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(PIIAction)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIIEntity.
+func (in *PIIEntity) DeepCopy() *PIIEntity {
+	if in == nil {
+		return nil
+	}
+	out := new(PIIEntity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PathOverride) DeepCopyInto(out *PathOverride) {
 	*out = *in
@@ -3650,7 +4333,7 @@ func (in *PolicyAncestorStatus) DeepCopyInto(out *PolicyAncestorStatus) {
 	in.AncestorRef.DeepCopyInto(&out.AncestorRef)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -3687,7 +4370,7 @@ func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -3821,6 +4504,11 @@ func (in *PromptguardRequest) DeepCopyInto(out *PromptguardRequest) {
 		*out = new(Moderation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PII != nil {
+		in, out := &in.PII, &out.PII
+		*out = new(PIIDetection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromptguardRequest.
@@ -3846,6 +4534,11 @@ func (in *PromptguardResponse) DeepCopyInto(out *PromptguardResponse) {
 		*out = new(Webhook)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PII != nil {
+		in, out := &in.PII, &out.PII
+		*out = new(PIIDetection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromptguardResponse.
@@ -3979,6 +4672,11 @@ func (in *RateLimitDescriptorEntry) DeepCopyInto(out *RateLimitDescriptorEntry)
 		*out = new(string)
 		**out = **in
 	}
+	if in.JWTClaim != nil {
+		in, out := &in.JWTClaim, &out.JWTClaim
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptorEntry.
@@ -4050,6 +4748,32 @@ func (in *RateLimitProvider) DeepCopy() *RateLimitProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisVectorStore) DeepCopyInto(out *RedisVectorStore) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(SingleAuthToken)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisVectorStore.
+func (in *RedisVectorStore) DeepCopy() *RedisVectorStore {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisVectorStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Regex) DeepCopyInto(out *Regex) {
 	*out = *in
@@ -4157,17 +4881,17 @@ func (in *Retry) DeepCopyInto(out *Retry) {
 	}
 	if in.PerTryTimeout != nil {
 		in, out := &in.PerTryTimeout, &out.PerTryTimeout
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.StatusCodes != nil {
 		in, out := &in.StatusCodes, &out.StatusCodes
-		*out = make([]v1.HTTPRouteRetryStatusCode, len(*in))
+		*out = make([]apisv1.HTTPRouteRetryStatusCode, len(*in))
 		copy(*out, *in)
 	}
 	if in.BackoffBaseInterval != nil {
 		in, out := &in.BackoffBaseInterval, &out.BackoffBaseInterval
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -4377,6 +5101,26 @@ func (in *ServiceAccount) DeepCopy() *ServiceAccount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShadowMode) DeepCopyInto(out *ShadowMode) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]ShadowModeField, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShadowMode.
+func (in *ShadowMode) DeepCopy() *ShadowMode {
+	if in == nil {
+		return nil
+	}
+	out := new(ShadowMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SingleAuthToken) DeepCopyInto(out *SingleAuthToken) {
 	*out = *in
@@ -4407,7 +5151,7 @@ func (in *SlowStart) DeepCopyInto(out *SlowStart) {
 	*out = *in
 	if in.Window != nil {
 		in, out := &in.Window, &out.Window
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Aggression != nil {
@@ -4574,12 +5318,12 @@ func (in *TCPKeepalive) DeepCopyInto(out *TCPKeepalive) {
 	}
 	if in.KeepAliveTime != nil {
 		in, out := &in.KeepAliveTime, &out.KeepAliveTime
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.KeepAliveInterval != nil {
 		in, out := &in.KeepAliveInterval, &out.KeepAliveInterval
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -4594,6 +5338,164 @@ func (in *TCPKeepalive) DeepCopy() *TCPKeepalive {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPPolicy) DeepCopyInto(out *TCPPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPPolicy.
+func (in *TCPPolicy) DeepCopy() *TCPPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TCPPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPPolicyList) DeepCopyInto(out *TCPPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TCPPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPPolicyList.
+func (in *TCPPolicyList) DeepCopy() *TCPPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TCPPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPPolicySpec) DeepCopyInto(out *TCPPolicySpec) {
+	*out = *in
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetSelectors != nil {
+		in, out := &in.TargetSelectors, &out.TargetSelectors
+		*out = make([]LocalPolicyTargetSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RBAC != nil {
+		in, out := &in.RBAC, &out.RBAC
+		*out = new(TCPRBAC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRateLimit != nil {
+		in, out := &in.ConnectionRateLimit, &out.ConnectionRateLimit
+		*out = new(TokenBucket)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConnectionDuration != nil {
+		in, out := &in.MaxConnectionDuration, &out.MaxConnectionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPPolicySpec.
+func (in *TCPPolicySpec) DeepCopy() *TCPPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPRBAC) DeepCopyInto(out *TCPRBAC) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]TCPRBACRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRBAC.
+func (in *TCPRBAC) DeepCopy() *TCPRBAC {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPRBAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPRBACRule) DeepCopyInto(out *TCPRBACRule) {
+	*out = *in
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SNIs != nil {
+		in, out := &in.SNIs, &out.SNIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRBACRule.
+func (in *TCPRBACRule) DeepCopy() *TCPRBACRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPRBACRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLS) DeepCopyInto(out *TLS) {
 	*out = *in
@@ -4607,9 +5509,14 @@ func (in *TLS) DeepCopyInto(out *TLS) {
 		*out = new(TLSFiles)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SDS != nil {
+		in, out := &in.SDS, &out.SDS
+		*out = new(TLSSDS)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.WellKnownCACertificates != nil {
 		in, out := &in.WellKnownCACertificates, &out.WellKnownCACertificates
-		*out = new(v1.WellKnownCACertificatesType)
+		*out = new(apisv1.WellKnownCACertificatesType)
 		**out = **in
 	}
 	if in.InsecureSkipVerify != nil {
@@ -4724,17 +5631,37 @@ func (in *TLSParameters) DeepCopy() *TLSParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSDS) DeepCopyInto(out *TLSSDS) {
+	*out = *in
+	if in.ClusterName != nil {
+		in, out := &in.ClusterName, &out.ClusterName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSDS.
+func (in *TLSSDS) DeepCopy() *TLSSDS {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSDS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timeouts) DeepCopyInto(out *Timeouts) {
 	*out = *in
 	if in.Request != nil {
 		in, out := &in.Request, &out.Request
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.StreamIdle != nil {
 		in, out := &in.StreamIdle, &out.StreamIdle
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -4984,6 +5911,26 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(RBAC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MCP != nil {
+		in, out := &in.MCP, &out.MCP
+		*out = new(MCPPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WAF != nil {
+		in, out := &in.WAF, &out.WAF
+		*out = new(WAF)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationRuleSubset != nil {
+		in, out := &in.DestinationRuleSubset, &out.DestinationRuleSubset
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShadowMode != nil {
+		in, out := &in.ShadowMode, &out.ShadowMode
+		*out = new(ShadowMode)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficPolicySpec.
@@ -5097,13 +6044,103 @@ func (in *VertexAIConfig) DeepCopy() *VertexAIConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAF) DeepCopyInto(out *WAF) {
+	*out = *in
+	if in.RuleSets != nil {
+		in, out := &in.RuleSets, &out.RuleSets
+		*out = make([]WAFRuleSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ParanoiaLevel != nil {
+		in, out := &in.ParanoiaLevel, &out.ParanoiaLevel
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RuleExclusions != nil {
+		in, out := &in.RuleExclusions, &out.RuleExclusions
+		*out = make([]WAFRuleExclusion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(WAFMode)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAF.
+func (in *WAF) DeepCopy() *WAF {
+	if in == nil {
+		return nil
+	}
+	out := new(WAF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFRuleExclusion) DeepCopyInto(out *WAFRuleExclusion) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFRuleExclusion.
+func (in *WAFRuleExclusion) DeepCopy() *WAFRuleExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFRuleExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFRuleSet) DeepCopyInto(out *WAFRuleSet) {
+	*out = *in
+	out.ConfigMapRef = in.ConfigMapRef
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFRuleSet.
+func (in *WAFRuleSet) DeepCopy() *WAFRuleSet {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFRuleSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Webhook) DeepCopyInto(out *Webhook) {
 	*out = *in
 	in.Host.DeepCopyInto(&out.Host)
 	if in.ForwardHeaderMatches != nil {
 		in, out := &in.ForwardHeaderMatches, &out.ForwardHeaderMatches
-		*out = make([]v1.HTTPHeaderMatch, len(*in))
+		*out = make([]apisv1.HTTPHeaderMatch, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -275,6 +275,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("AI Backend with failover", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backend/ai-failover.yaml",
+			outputFile: "backend/ai-failover.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("Direct response", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "direct-response/manifest.yaml",
@@ -436,6 +447,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("AI TrafficPolicy with token rate limits", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "trafficpolicy/ai/token-rate-limit.yaml",
+			outputFile: "trafficpolicy/ai/token-rate-limit.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy with rbac on http route with Static backend", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "trafficpolicy/rbac/http-rbac.yaml",
@@ -458,6 +480,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("TrafficPolicy with MCP authorization on MCP backend with tool prefix", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "trafficpolicy/mcp-authorization.yaml",
+			outputFile: "trafficpolicy/mcp-authorization.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy with transformation", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "trafficpolicy/transformation.yaml",
@@ -17,6 +17,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
 )
 
@@ -26,6 +27,36 @@ const (
 	authPolicyPrefix = "auth"
 )
 
+// UnsupportedBackendFields returns the statuses of the fields of the Backend that agentgateway does not support.
+// The Backend is still translated, and these fields are reported as ignored on its status.
+func UnsupportedBackendFields(backend *v1alpha1.Backend) []reporter.PolicyFieldStatus {
+	if backend.Spec.AI == nil || backend.Spec.AI.Failover == nil {
+		return nil
+	}
+	// The failover triggers are translated to retries on the routes to the backend. agentgateway
+	// always evicts the failing providers and prefers the healthy providers with the lowest latency,
+	// but this cannot be configured.
+	failover := backend.Spec.AI.Failover
+	var out []reporter.PolicyFieldStatus
+	ignored := func(field string) {
+		out = append(out, reporter.PolicyFieldStatus{
+			Field:   "ai.failover." + field,
+			Reason:  reporter.PolicyFieldReasonIgnored,
+			Message: "not supported by agentgateway, the built-in provider eviction and load balancing are used",
+		})
+	}
+	if failover.ConsecutiveFailures != nil {
+		ignored("consecutiveFailures")
+	}
+	if failover.EjectionDuration != nil {
+		ignored("ejectionDuration")
+	}
+	if failover.LoadBalancing != nil {
+		ignored("loadBalancing")
+	}
+	return out
+}
+
 // BuildAgwBackendIr translates a Backend to an AgwBackendIr
 func BuildAgwBackendIr(
 	krtctx krt.HandlerContext,
@@ -365,7 +396,7 @@ func buildMCPIr(krtctx krt.HandlerContext, be *v1alpha1.Backend, services krt.Co
 			backends = append(backends, staticBackend)
 
 			mcpTarget := &api.MCPTarget{
-				Name: string(targetSelector.Name),
+				Name: utils.InternalMCPStaticTargetName(targetSelector),
 				Backend: &api.BackendReference{
 					Kind: &api.BackendReference_Backend{
 						Backend: staticBackendRef,
@@ -532,18 +563,22 @@ func buildBedrockAuthPolicy(krtctx krt.HandlerContext, region string, auth *v1al
 	var errs []error
 	if auth == nil {
 		logger.Warn("using implicit AWS auth for AI backend")
-		return &api.BackendAuthPolicy{
-			Kind: &api.BackendAuthPolicy_Aws{
-				Aws: &api.Aws{
-					Kind: &api.Aws_Implicit{
-						Implicit: &api.AwsImplicit{},
-					},
-				},
-			},
-		}, nil
+		return implicitAwsAuthPolicy(), nil
+	}
+	if auth.AssumeRole != nil {
+		return nil, errors.New("assumeRole is only supported with an Envoy-based Gateway")
 	}
 
 	switch auth.Type {
+	case v1alpha1.AwsAuthTypeWebIdentity:
+		if auth.WebIdentity != nil {
+			return nil, errors.New("webIdentity is only supported with an Envoy-based Gateway, agentgateway reads the role ARN and token file from the environment of the proxy")
+		}
+		// the default credential chain of agentgateway reads the web identity from the environment of the proxy
+		return implicitAwsAuthPolicy(), nil
+	case v1alpha1.AwsAuthTypePodIdentity:
+		// the default credential chain of agentgateway uses the EKS Pod Identity agent when available
+		return implicitAwsAuthPolicy(), nil
 	case v1alpha1.AwsAuthTypeSecret:
 		if auth.SecretRef == nil {
 			return nil, nil
@@ -598,3 +633,16 @@ func buildBedrockAuthPolicy(krtctx krt.HandlerContext, region string, auth *v1al
 		return nil, errors.Join(errs...)
 	}
 }
+
+// implicitAwsAuthPolicy returns the auth policy using the default AWS credential chain of agentgateway.
+func implicitAwsAuthPolicy() *api.BackendAuthPolicy {
+	return &api.BackendAuthPolicy{
+		Kind: &api.BackendAuthPolicy_Aws{
+			Aws: &api.Aws{
+				Kind: &api.Aws_Implicit{
+					Implicit: &api.AwsImplicit{},
+				},
+			},
+		},
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
 )
 
@@ -86,6 +87,48 @@ func TestBuildMCPIr(t *testing.T) {
 				return true
 			},
 		},
+		{
+			name: "Static MCP target backend with tool prefix",
+			backend: &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "static-mcp-backend",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeMCP,
+					MCP: &v1alpha1.MCP{
+						Targets: []v1alpha1.McpTargetSelector{
+							{
+								Name:       "static-target",
+								ToolPrefix: ptr.To("weather"),
+								Static: &v1alpha1.McpTarget{
+									Host: "mcp-server.example.com",
+									Port: 8080,
+								},
+							},
+						},
+					},
+				},
+			},
+			services:    createMockServiceCollection(t),
+			namespaces:  createMockNamespaceCollection(t),
+			expectError: false,
+			validate: func(ir *MCPIr) bool {
+				for _, backend := range ir.Backends {
+					if backend.Name == "test-ns/static-mcp-backend" {
+						mcp := backend.GetMcp()
+						if mcp == nil || len(mcp.Targets) != 1 {
+							return false
+						}
+						// the target is named by its tool prefix, while the static backend keeps the target name
+						target := mcp.Targets[0]
+						return target.Name == "weather" &&
+							target.Backend.GetBackend() == "test-ns/static-mcp-backend/static-target"
+					}
+				}
+				return false
+			},
+		},
 		{
 			name: "Service selector MCP backend - same namespace",
 			backend: &v1alpha1.Backend{
@@ -742,6 +785,119 @@ func TestBuildAIBackendIr(t *testing.T) {
 			secrets:     nil,
 			expectError: true,
 		},
+		{
+			name: "Bedrock backend with pod identity auth",
+			backend: &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bedrock-pod-identity",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeAI,
+					AI: &v1alpha1.AIBackend{
+						LLM: &v1alpha1.LLMProvider{
+							Bedrock: &v1alpha1.BedrockConfig{
+								Region: "us-east-1",
+								Auth: &v1alpha1.AwsAuth{
+									Type: v1alpha1.AwsAuthTypePodIdentity,
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets:     nil,
+			expectError: false,
+			validate: func(aiIr *AIIr) bool {
+				return aiIr != nil &&
+					len(aiIr.Policies) == 1 &&
+					aiIr.Policies[0].GetSpec().GetAuth().GetAws().GetImplicit() != nil
+			},
+		},
+		{
+			name: "Bedrock backend with web identity auth from the environment",
+			backend: &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bedrock-web-identity",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeAI,
+					AI: &v1alpha1.AIBackend{
+						LLM: &v1alpha1.LLMProvider{
+							Bedrock: &v1alpha1.BedrockConfig{
+								Region: "us-east-1",
+								Auth: &v1alpha1.AwsAuth{
+									Type: v1alpha1.AwsAuthTypeWebIdentity,
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets:     nil,
+			expectError: false,
+			validate: func(aiIr *AIIr) bool {
+				return aiIr != nil &&
+					len(aiIr.Policies) == 1 &&
+					aiIr.Policies[0].GetSpec().GetAuth().GetAws().GetImplicit() != nil
+			},
+		},
+		{
+			name: "Error case - Bedrock backend with explicit web identity",
+			backend: &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bedrock-explicit-web-identity",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeAI,
+					AI: &v1alpha1.AIBackend{
+						LLM: &v1alpha1.LLMProvider{
+							Bedrock: &v1alpha1.BedrockConfig{
+								Region: "us-east-1",
+								Auth: &v1alpha1.AwsAuth{
+									Type: v1alpha1.AwsAuthTypeWebIdentity,
+									WebIdentity: &v1alpha1.AwsWebIdentity{
+										RoleARN: "arn:aws:iam::123456789012:role/bedrock",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets:     nil,
+			expectError: true,
+		},
+		{
+			name: "Error case - Bedrock backend with assume role",
+			backend: &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bedrock-assume-role",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeAI,
+					AI: &v1alpha1.AIBackend{
+						LLM: &v1alpha1.LLMProvider{
+							Bedrock: &v1alpha1.BedrockConfig{
+								Region: "us-east-1",
+								Auth: &v1alpha1.AwsAuth{
+									Type: v1alpha1.AwsAuthTypePodIdentity,
+									AssumeRole: &v1alpha1.AwsAssumeRole{
+										RoleARN:    "arn:aws:iam::123456789012:role/bedrock",
+										ExternalID: ptr.To("external-id"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets:     nil,
+			expectError: true,
+		},
 		{
 			name: "Error case - no supported provider configured",
 			backend: &v1alpha1.Backend{
@@ -914,6 +1070,42 @@ func TestBuildStaticIr(t *testing.T) {
 	}
 }
 
+func TestUnsupportedBackendFields(t *testing.T) {
+	aiBackend := func(failover *v1alpha1.AIFailover) *v1alpha1.Backend {
+		return &v1alpha1.Backend{
+			Spec: v1alpha1.BackendSpec{
+				Type: v1alpha1.BackendTypeAI,
+				AI: &v1alpha1.AIBackend{
+					LLM:      &v1alpha1.LLMProvider{OpenAI: &v1alpha1.OpenAIConfig{}},
+					Failover: failover,
+				},
+			},
+		}
+	}
+
+	if fields := UnsupportedBackendFields(aiBackend(nil)); len(fields) != 0 {
+		t.Errorf("expected no unsupported fields, got %v", fields)
+	}
+
+	// the triggers are translated to retries on the routes
+	if fields := UnsupportedBackendFields(aiBackend(&v1alpha1.AIFailover{
+		Triggers: []v1alpha1.AIFailoverTrigger{v1alpha1.AIFailoverTriggerRateLimited},
+	})); len(fields) != 0 {
+		t.Errorf("expected no unsupported fields, got %v", fields)
+	}
+
+	fields := UnsupportedBackendFields(aiBackend(&v1alpha1.AIFailover{
+		Triggers:         []v1alpha1.AIFailoverTrigger{v1alpha1.AIFailoverTriggerRateLimited},
+		EjectionDuration: &metav1.Duration{Duration: time.Minute},
+	}))
+	if len(fields) != 1 {
+		t.Fatalf("expected 1 unsupported field, got %v", fields)
+	}
+	if fields[0].Field != "ai.failover.ejectionDuration" || fields[0].Reason != reporter.PolicyFieldReasonIgnored {
+		t.Errorf("expected ai.failover.ejectionDuration to be ignored, got %v", fields[0])
+	}
+}
+
 func TestGetSecretValue(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"reflect"
+	"slices"
 	"time"
 
 	"github.com/avast/retry-go/v4"
@@ -108,6 +110,8 @@ func NewAgwStatusSyncer(
 
 	// Register the built-in TrafficPolicy handler
 	syncer.RegisterPolicyStatusHandler(wellknown.TrafficPolicyGVK.String(), syncer.syncTrafficPolicyStatusHandler)
+	// Register the built-in XBackendTrafficPolicy handler
+	syncer.RegisterPolicyStatusHandler(wellknown.XBackendTrafficPolicyGVK.String(), syncer.syncBackendTrafficPolicyStatusHandler)
 
 	// Register any additional handlers provided
 	for gvk, handler := range additionalPolicyStatusHandlers {
@@ -227,7 +231,7 @@ func (s *AgentGwStatusSyncer) syncTrafficPolicyStatusHandler(ctx context.Context
 		ancestors = append(ancestors, gwv1.PolicyAncestorStatus{
 			AncestorRef:    ancestor.AncestorRef,
 			ControllerName: gwv1.GatewayController(ancestor.ControllerName),
-			Conditions:     ancestor.Conditions,
+			Conditions:     mergePolicyAncestorConditions(trafficpolicy.Status.Ancestors, ancestor),
 		})
 	}
 	trafficpolicy.Status = gwv1.PolicyStatus{
@@ -237,6 +241,61 @@ func (s *AgentGwStatusSyncer) syncTrafficPolicyStatusHandler(ctx context.Context
 	return client.Status().Update(ctx, &trafficpolicy)
 }
 
+// syncBackendTrafficPolicyStatusHandler handles status syncing for XBackendTrafficPolicy resources.
+// The policy is also reported on by the Envoy based gateway controller, so the ancestors of other controllers are preserved.
+func (s *AgentGwStatusSyncer) syncBackendTrafficPolicyStatusHandler(ctx context.Context, client client.Client, namespacedName types.NamespacedName, status gwv1.PolicyStatus) error {
+	policy := gwxv1a1.XBackendTrafficPolicy{}
+	err := client.Get(ctx, namespacedName, &policy)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug("skipping status sync for xbackendtrafficpolicy, resource not found", "namespaced_name", namespacedName.String())
+			return nil
+		}
+		return err
+	}
+
+	var ancestors []gwv1.PolicyAncestorStatus
+	for _, ancestor := range policy.Status.Ancestors {
+		if string(ancestor.ControllerName) != s.controllerName {
+			ancestors = append(ancestors, ancestor)
+		}
+	}
+	for _, ancestor := range status.Ancestors {
+		ancestors = append(ancestors, gwv1.PolicyAncestorStatus{
+			AncestorRef:    ancestor.AncestorRef,
+			ControllerName: gwv1.GatewayController(ancestor.ControllerName),
+			Conditions:     mergePolicyAncestorConditions(policy.Status.Ancestors, ancestor),
+		})
+	}
+	policy.Status = gwv1.PolicyStatus{
+		Ancestors: ancestors,
+	}
+
+	return client.Status().Update(ctx, &policy)
+}
+
+// mergePolicyAncestorConditions returns the conditions of the given ancestor, preserving the LastTransitionTime
+// of the conditions whose status is unchanged from the current status of the same ancestor.
+// Conditions that are no longer reported for the ancestor (e.g., PartiallyAccepted once all fields are applied)
+// are removed.
+func mergePolicyAncestorConditions(current []gwv1.PolicyAncestorStatus, ancestor gwv1.PolicyAncestorStatus) []metav1.Condition {
+	idx := slices.IndexFunc(current, func(s gwv1.PolicyAncestorStatus) bool {
+		return s.ControllerName == ancestor.ControllerName && reflect.DeepEqual(s.AncestorRef, ancestor.AncestorRef)
+	})
+	if idx == -1 {
+		return ancestor.Conditions
+	}
+
+	conditions := make([]metav1.Condition, 0, len(ancestor.Conditions))
+	for _, cond := range ancestor.Conditions {
+		if existing := meta.FindStatusCondition(current[idx].Conditions, cond.Type); existing != nil {
+			conditions = append(conditions, *existing)
+		}
+		meta.SetStatusCondition(&conditions, cond)
+	}
+	return conditions
+}
+
 // syncPolicyStatus handles status syncing for all policy types with a registered policy status handler
 func (s *AgentGwStatusSyncer) syncPolicyStatus(ctx context.Context, logger *slog.Logger, policyStatusUpdate krt.ObjectWithStatus[controllers.Object, gwv1.PolicyStatus]) {
 	stopwatch := utils.NewTranslatorStopWatch("PolicyStatusSyncer")
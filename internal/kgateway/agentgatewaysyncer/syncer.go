@@ -23,12 +23,14 @@ import (
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	agwbackend "github.com/kgateway-dev/kgateway/v2/internal/kgateway/agentgatewaysyncer/backend"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	agwir "github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/plugins"
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/translator"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 	krtpkg "github.com/kgateway-dev/kgateway/v2/pkg/utils/krtutil"
 )
@@ -350,6 +352,16 @@ func (s *Syncer) buildBackendFromBackend(ctx krt.HandlerContext,
 			},
 		},
 	}
+	if fieldStatuses := agwbackend.UnsupportedBackendFields(backend); len(fieldStatuses) > 0 {
+		partiallyAccepted := reporter.PartiallyAcceptedCondition(fieldStatuses, backend.Generation)
+		backendStatus.Conditions = append(backendStatus.Conditions, metav1.Condition{
+			Type:               partiallyAccepted.Type,
+			Status:             partiallyAccepted.Status,
+			Reason:             partiallyAccepted.Reason,
+			Message:            partiallyAccepted.Message,
+			ObservedGeneration: partiallyAccepted.ObservedGeneration,
+		})
+	}
 	return results, backendStatus
 }
 
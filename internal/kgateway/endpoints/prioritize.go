@@ -37,10 +37,19 @@ func PrioritizeEndpoints(
 		PodLocality: ucc.Locality,
 	}
 
-	if inputs.PriorityInfo == nil {
-		lbInfo.PriorityInfo = priorityInfoFromTrafficDistribution(inputs.EndpointsForBackend.TrafficDistribution)
-	} else {
+	switch {
+	case inputs.PriorityInfo != nil:
 		lbInfo.PriorityInfo = inputs.PriorityInfo
+	case len(inputs.EndpointsForBackend.FailoverPriority) > 0:
+		lbInfo.PriorityInfo = &PriorityInfo{
+			FailoverPriority: NewPriorities(inputs.EndpointsForBackend.FailoverPriority),
+		}
+	default:
+		lbInfo.PriorityInfo = priorityInfoFromTrafficDistribution(inputs.EndpointsForBackend.TrafficDistribution)
+	}
+	if lbInfo.PriorityInfo != nil && countEndpoints(inputs.EndpointsForBackend) < lbInfo.PriorityInfo.MinEndpoints {
+		// too few endpoints to keep the traffic close to the proxy, load balance across all of them
+		lbInfo.PriorityInfo = nil
 	}
 
 	return prioritizeWithLbInfo(logger, inputs.EndpointsForBackend, lbInfo)
@@ -61,6 +70,12 @@ type LoadBalancingInfo struct {
 type PriorityInfo struct {
 	FailoverPriority *Prioritizer
 	Failover         []*v1alpha3.LocalityLoadBalancerSetting_Failover
+	// OverprovisioningFactor overrides the Envoy default overprovisioning factor of 140 when set.
+	OverprovisioningFactor *uint32
+	// MinEndpoints is the minimum number of endpoints for the priorities to be applied.
+	// It plays the role of the Envoy zone aware min_cluster_size, which cannot be used as the proxies
+	// are not configured with a local cluster.
+	MinEndpoints int
 }
 
 type Prioritizer struct {
@@ -139,6 +154,12 @@ func prioritizeWithLbInfo(logger *slog.Logger, ep ir.EndpointsForBackend, lbInfo
 		cla.Endpoints = append(cla.GetEndpoints(), endpoints...)
 	}
 
+	if lbInfo.PriorityInfo != nil && lbInfo.PriorityInfo.OverprovisioningFactor != nil {
+		cla.Policy = &envoyendpointv3.ClusterLoadAssignment_Policy{
+			OverprovisioningFactor: wrapperspb.UInt32(*lbInfo.PriorityInfo.OverprovisioningFactor),
+		}
+	}
+
 	if lbInfo.PriorityInfo != nil && lbInfo.PriorityInfo.FailoverPriority == nil {
 		// if no priorities, fallback to failover
 		proxyLocality := envoycorev3.Locality{
@@ -158,6 +179,28 @@ func prioritizeWithLbInfo(logger *slog.Logger, ep ir.EndpointsForBackend, lbInfo
 	return cla
 }
 
+// countEndpoints returns the number of endpoints that can receive new requests.
+func countEndpoints(ep ir.EndpointsForBackend) int {
+	count := 0
+	for _, eps := range ep.LbEps {
+		for _, ep := range eps {
+			if ep.GetHealthStatus() != envoycorev3.HealthStatus_DRAINING {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// lbWeight returns the weight of the endpoint in the weight of its locality.
+// Draining endpoints don't count as they get no new requests.
+func lbWeight(ep *envoyendpointv3.LbEndpoint) uint32 {
+	if ep.GetHealthStatus() == envoycorev3.HealthStatus_DRAINING {
+		return 0
+	}
+	return ep.GetLoadBalancingWeight().GetValue()
+}
+
 // ensure we don't send invalid endpoints to envoy and cause NACKs
 func filterInvalidEps(eps []ir.EndpointWithMd) []ir.EndpointWithMd {
 	return slices.Filter(eps, func(ewm ir.EndpointWithMd) bool {
@@ -180,7 +223,7 @@ func getEndpoints(eps []ir.EndpointWithMd, lbinfo LoadBalancingInfo) []*envoyend
 	var weight uint32
 	for _, ep := range eps {
 		epsOut[0].LbEndpoints = append(epsOut[0].GetLbEndpoints(), ep.LbEndpoint)
-		weight += ep.LbEndpoint.GetLoadBalancingWeight().GetValue()
+		weight += lbWeight(ep.LbEndpoint)
 	}
 	// reset weight
 	if weight > 0 {
@@ -216,7 +259,7 @@ func applyFailoverPriorityPerLocality(
 		var weight uint32
 		for _, index := range priorityMap[priority] {
 			out[i].LbEndpoints = append(out[i].GetLbEndpoints(), eps[index].LbEndpoint)
-			weight += eps[index].GetLoadBalancingWeight().GetValue()
+			weight += lbWeight(eps[index].LbEndpoint)
 		}
 		// reset weight
 		if weight > 0 {
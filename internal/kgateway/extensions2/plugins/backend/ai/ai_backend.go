@@ -5,11 +5,15 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"net/http"
 	"os"
+	"slices"
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	previous_hostsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
+	previous_prioritiesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/priority/previous_priorities/v3"
 	envoytransformation "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -17,17 +21,28 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/trafficpolicy"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 // TODO: envoy-based AI gateway is deprecated in v2.1 and will be removed in v2.2. The files in this folder (and any associated tests) can be removed in v2.2.
 
+const (
+	previousHostsPredicateName = "envoy.retry_host_predicates.previous_hosts"
+	previousPrioritiesName     = "envoy.retry_priorities.previous_priorities"
+
+	// openAIProviderType is the type of the OpenAI providers, sent to the AI extension.
+	openAIProviderType = "openai"
+)
+
 // IR is the internal representation of an AI backend.
 type IR struct {
 	AISecret       *ir.Secret
 	AIMultiSecret  map[string]*ir.Secret
 	Transformation *envoytransformation.RouteTransformations
 	Extproc        *envoy_ext_proc_v3.ExtProcPerRoute
+	// RetryPolicy fails rate limited requests over to the other providers of the backend.
+	RetryPolicy *envoyroutev3.RetryPolicy
 }
 
 func (i *IR) Equals(otherAIIr *IR) bool {
@@ -55,6 +70,9 @@ func (i *IR) Equals(otherAIIr *IR) bool {
 		if !proto.Equal(i.Transformation, otherAIIr.Transformation) {
 			return false
 		}
+		if !proto.Equal(i.RetryPolicy, otherAIIr.RetryPolicy) {
+			return false
+		}
 	}
 	return true
 }
@@ -75,6 +93,10 @@ func ApplyAIBackend(ir *IR, pCtx *ir.RouteBackendContext, out *envoyroutev3.Rout
 		// merge the Backend extproc config with any config added by the TrafficPolicy
 		routeExtprocSettings := trafficpolicyExtprocSettingsProto.(*envoy_ext_proc_v3.ExtProcPerRoute)
 		copyBackendExtproc.GetOverrides().GrpcInitialMetadata = append(copyBackendExtproc.GetOverrides().GetGrpcInitialMetadata(), routeExtprocSettings.GetOverrides().GetGrpcInitialMetadata()...)
+		// the TrafficPolicy may override the processing mode, e.g. to buffer the request body for model routing
+		if mode := routeExtprocSettings.GetOverrides().GetProcessingMode(); mode != nil {
+			copyBackendExtproc.GetOverrides().ProcessingMode = mode
+		}
 	}
 	pCtx.TypedFilterConfig.AddTypedConfig(wellknown.AIExtProcFilterName, copyBackendExtproc)
 
@@ -89,6 +111,10 @@ func ApplyAIBackend(ir *IR, pCtx *ir.RouteBackendContext, out *envoyroutev3.Rout
 	out.GetRoute().HostRewriteSpecifier = &envoyroutev3.RouteAction_AutoHostRewrite{
 		AutoHostRewrite: wrapperspb.Bool(true),
 	}
+	// a retry policy set by a TrafficPolicy takes precedence over the failover of the backend
+	if ir.RetryPolicy != nil && out.GetRoute().GetRetryPolicy() == nil {
+		out.GetRoute().RetryPolicy = proto.Clone(ir.RetryPolicy).(*envoyroutev3.RetryPolicy)
+	}
 
 	return nil
 }
@@ -120,14 +146,20 @@ func PreprocessAIBackend(ctx context.Context, aiBackend *v1alpha1.AIBackend, ir
 		}
 	}
 
-	if len(byType) != 1 {
-		return fmt.Errorf("multiple AI backend types found for single ai route %+v", byType)
+	if len(byType) == 0 {
+		return fmt.Errorf("no AI backend type found for ai route")
 	}
 
-	// This is only len(1)
-	var llmProvider string
-	for k := range byType {
-		llmProvider = k
+	// The providers of a backend with providers of different types are all sent requests through
+	// their OpenAI-compatible API, so the requests and responses are parsed as OpenAI ones.
+	llmProvider := openAIProviderType
+	if len(byType) == 1 {
+		for k := range byType {
+			llmProvider = k
+		}
+	} else {
+		// the providers have different models
+		llmModel = ""
 	}
 
 	// We only want to add the transformation filter if we have a single AI backend
@@ -182,13 +214,84 @@ func PreprocessAIBackend(ctx context.Context, aiBackend *v1alpha1.AIBackend, ir
 	// Store extproc settings in IR
 	ir.Extproc = extProcRouteSettings
 
+	retryPolicy, err := failoverRetryPolicy(aiBackend)
+	if err != nil {
+		return err
+	}
+	ir.RetryPolicy = retryPolicy
+
 	return nil
 }
 
+// failoverRetryPolicy retries the rate limited requests on the other providers of the backend, as
+// Envoy cannot eject the providers responding with a 429. Each retry moves to the next priority group
+// and the providers already attempted are skipped. The retries are delayed by the Retry-After header
+// of the rate limited responses.
+func failoverRetryPolicy(aiBackend *v1alpha1.AIBackend) (*envoyroutev3.RetryPolicy, error) {
+	if aiBackend.Failover == nil || !slices.Contains(failoverTriggers(aiBackend.Failover), v1alpha1.AIFailoverTriggerRateLimited) {
+		return nil, nil
+	}
+	providers := 0
+	for _, group := range aiBackend.PriorityGroups {
+		providers += len(group.Providers)
+	}
+	if providers < 2 {
+		// there is no other provider to fail over to
+		return nil, nil
+	}
+
+	previousHosts, err := utils.MessageToAny(&previous_hostsv3.PreviousHostsPredicate{})
+	if err != nil {
+		return nil, err
+	}
+	retryPolicy := &envoyroutev3.RetryPolicy{
+		RetryOn:              "retriable-status-codes",
+		RetriableStatusCodes: []uint32{http.StatusTooManyRequests},
+		NumRetries:           wrapperspb.UInt32(uint32(providers - 1)), //nolint:gosec // G115: at most 32 groups of 32 providers
+		RetryHostPredicate: []*envoyroutev3.RetryPolicy_RetryHostPredicate{{
+			Name: previousHostsPredicateName,
+			ConfigType: &envoyroutev3.RetryPolicy_RetryHostPredicate_TypedConfig{
+				TypedConfig: previousHosts,
+			},
+		}},
+		HostSelectionRetryMaxAttempts: int64(providers),
+		RateLimitedRetryBackOff: &envoyroutev3.RetryPolicy_RateLimitedRetryBackOff{
+			ResetHeaders: []*envoyroutev3.RetryPolicy_ResetHeader{{
+				Name:   "Retry-After",
+				Format: envoyroutev3.RetryPolicy_SECONDS,
+			}},
+		},
+	}
+	if len(aiBackend.PriorityGroups) > 1 {
+		previousPriorities, err := utils.MessageToAny(&previous_prioritiesv3.PreviousPrioritiesConfig{UpdateFrequency: 1})
+		if err != nil {
+			return nil, err
+		}
+		retryPolicy.RetryPriority = &envoyroutev3.RetryPolicy_RetryPriority{
+			Name: previousPrioritiesName,
+			ConfigType: &envoyroutev3.RetryPolicy_RetryPriority_TypedConfig{
+				TypedConfig: previousPriorities,
+			},
+		}
+	}
+	return retryPolicy, nil
+}
+
+// hasMixedProviderTypes returns true if the priority groups of the backend have providers of different types.
+func hasMixedProviderTypes(aiBackend *v1alpha1.AIBackend) bool {
+	byType := map[string]struct{}{}
+	for _, group := range aiBackend.PriorityGroups {
+		for _, provider := range group.Providers {
+			getBackendModel(&provider.LLMProvider, byType)
+		}
+	}
+	return len(byType) > 1
+}
+
 func getBackendModel(provider *v1alpha1.LLMProvider, byType map[string]struct{}) string {
 	llmModel := ""
 	if provider.OpenAI != nil {
-		byType["openai"] = struct{}{}
+		byType[openAIProviderType] = struct{}{}
 		if provider.OpenAI.Model != nil {
 			llmModel = *provider.OpenAI.Model
 		}
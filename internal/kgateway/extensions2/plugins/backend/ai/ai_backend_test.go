@@ -9,9 +9,12 @@ import (
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
 	envoytransformation "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
@@ -81,7 +84,7 @@ func TestApplyAIBackend(t *testing.T) {
 											TransformationTemplate: &envoytransformation.TransformationTemplate{
 												Headers: map[string]*envoytransformation.InjaTemplate{
 													":path": {
-														Text: "/v1/chat/completions",
+														Text: "/v1/" + getOpenAIOperationPath(),
 													},
 													"Authorization": {
 														Text: `Bearer {% if host_metadata("auth_token") != "" %}{{host_metadata("auth_token")}}{% else %}{{dynamic_metadata("auth_token","ai.kgateway.io")}}{% endif %}`,
@@ -221,9 +224,65 @@ func TestApplyAIBackend(t *testing.T) {
 					},
 				},
 			},
-			out:                 outRoute,
-			expectedError:       "multiple AI backend types found for single ai route",
-			expectedTypedConfig: nil,
+			out:           outRoute,
+			expectedError: "",
+			expectedTypedConfig: &map[string]proto.Message{
+				wellknown.AIExtProcFilterName: &envoy_ext_proc_v3.ExtProcPerRoute{
+					Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+						Overrides: &envoy_ext_proc_v3.ExtProcOverrides{
+							GrpcInitialMetadata: []*envoycorev3.HeaderValue{
+								{
+									Key:   "x-llm-provider",
+									Value: "openai",
+								},
+								{
+									Key:   "x-request-id",
+									Value: "%REQ(X-REQUEST-ID)%",
+								},
+							},
+						},
+					},
+				},
+				wellknown.AIBackendTransformationFilterName: &envoytransformation.RouteTransformations{
+					Transformations: []*envoytransformation.RouteTransformations_RouteTransformation{
+						{
+							Match: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch_{
+								RequestMatch: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch{
+									RequestTransformation: &envoytransformation.Transformation{
+										LogRequestResponseInfo: &wrapperspb.BoolValue{},
+										TransformationType: &envoytransformation.Transformation_TransformationTemplate{
+											TransformationTemplate: &envoytransformation.TransformationTemplate{
+												Headers: map[string]*envoytransformation.InjaTemplate{
+													":path": {
+														Text: `{% if host_metadata("provider") == "openai" %}/v1/` + getOpenAIOperationPath() + `{% else if host_metadata("provider") == "anthropic" %}/v1/chat/completions{% endif %}`,
+													},
+													"Authorization": {
+														Text: `{% if host_metadata("provider") == "openai" %}Bearer {% if host_metadata("auth_token") != "" %}{{host_metadata("auth_token")}}{% else %}{{dynamic_metadata("auth_token","ai.kgateway.io")}}{% endif %}{% endif %}`,
+													},
+													"x-api-key": {
+														Text: `{% if host_metadata("provider") == "anthropic" %}{% if host_metadata("auth_token") != "" %}{{host_metadata("auth_token")}}{% else %}{{dynamic_metadata("auth_token","ai.kgateway.io")}}{% endif %}{% endif %}`,
+													},
+												},
+												BodyTransformation: &envoytransformation.TransformationTemplate_MergeJsonKeys{
+													MergeJsonKeys: &envoytransformation.MergeJsonKeys{
+														JsonKeys: map[string]*envoytransformation.MergeJsonKeys_OverridableTemplate{
+															"model": {
+																Tmpl: &envoytransformation.InjaTemplate{
+																	Text: `{% if host_metadata("model") != "" %}"{{host_metadata("model")}}"{% else %}"{{model}}"{% endif %}`,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -282,3 +341,62 @@ func TestApplyAIBackend(t *testing.T) {
 		})
 	}
 }
+
+func TestFailoverRetryPolicy(t *testing.T) {
+	openAI := func(name string) v1alpha1.NamedLLMProvider {
+		return v1alpha1.NamedLLMProvider{
+			Name: gwv1.SectionName(name),
+			LLMProvider: v1alpha1.LLMProvider{
+				OpenAI: &v1alpha1.OpenAIConfig{
+					AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("token")},
+				},
+			},
+		}
+	}
+	backend := &v1alpha1.AIBackend{
+		PriorityGroups: []v1alpha1.PriorityGroup{
+			{Providers: []v1alpha1.NamedLLMProvider{openAI("primary"), openAI("secondary")}},
+			{Providers: []v1alpha1.NamedLLMProvider{openAI("fallback")}},
+		},
+		Failover: &v1alpha1.AIFailover{},
+	}
+
+	t.Run("retries rate limited requests on the other providers", func(t *testing.T) {
+		aiIR := &IR{}
+		require.NoError(t, PreprocessAIBackend(context.Background(), backend, aiIR))
+		retryPolicy := aiIR.RetryPolicy
+		require.NotNil(t, retryPolicy)
+		assert.Equal(t, []uint32{429}, retryPolicy.GetRetriableStatusCodes())
+		assert.Equal(t, uint32(2), retryPolicy.GetNumRetries().GetValue())
+		assert.Equal(t, int64(3), retryPolicy.GetHostSelectionRetryMaxAttempts())
+		require.Len(t, retryPolicy.GetRetryHostPredicate(), 1)
+		assert.Equal(t, previousHostsPredicateName, retryPolicy.GetRetryHostPredicate()[0].GetName())
+		assert.Equal(t, previousPrioritiesName, retryPolicy.GetRetryPriority().GetName())
+		require.Len(t, retryPolicy.GetRateLimitedRetryBackOff().GetResetHeaders(), 1)
+		assert.Equal(t, "Retry-After", retryPolicy.GetRateLimitedRetryBackOff().GetResetHeaders()[0].GetName())
+		assert.Equal(t, envoyroutev3.RetryPolicy_SECONDS, retryPolicy.GetRateLimitedRetryBackOff().GetResetHeaders()[0].GetFormat())
+
+		out := &envoyroutev3.Route{}
+		require.NoError(t, ApplyAIBackend(aiIR, &ir.RouteBackendContext{TypedFilterConfig: ir.TypedFilterConfigMap{}}, out))
+		assert.True(t, proto.Equal(retryPolicy, out.GetRoute().GetRetryPolicy()))
+	})
+
+	t.Run("keeps the retry policy of the route", func(t *testing.T) {
+		aiIR := &IR{}
+		require.NoError(t, PreprocessAIBackend(context.Background(), backend, aiIR))
+		routeRetry := &envoyroutev3.RetryPolicy{RetryOn: "5xx"}
+		out := &envoyroutev3.Route{
+			Action: &envoyroutev3.Route_Route{Route: &envoyroutev3.RouteAction{RetryPolicy: routeRetry}},
+		}
+		require.NoError(t, ApplyAIBackend(aiIR, &ir.RouteBackendContext{TypedFilterConfig: ir.TypedFilterConfigMap{}}, out))
+		assert.Equal(t, routeRetry, out.GetRoute().GetRetryPolicy())
+	})
+
+	t.Run("no retries without the rate limited trigger", func(t *testing.T) {
+		noRateLimited := backend.DeepCopy()
+		noRateLimited.Failover.Triggers = []v1alpha1.AIFailoverTrigger{v1alpha1.AIFailoverTriggerServerError}
+		retryPolicy, err := failoverRetryPolicy(noRateLimited)
+		require.NoError(t, err)
+		assert.Nil(t, retryPolicy)
+	})
+}
@@ -140,7 +140,8 @@ func AddExtprocHTTPFilter() ([]plugins.StagedHttpFilter, error) {
 				Typed:   []string{"envoy.filters.ai.solo.io"},
 			},
 			ReceivingNamespaces: &envoy_ext_proc_v3.MetadataOptions_MetadataNamespaces{
-				Untyped: []string{"ai.kgateway.io"},
+				// envoy.lb is set by the model routing to select the provider of the backend
+				Untyped: []string{"ai.kgateway.io", wellknown.EnvoyLbMetadataNamespace},
 			},
 		},
 	}
@@ -3,6 +3,9 @@ package ai
 import (
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -11,7 +14,9 @@ import (
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	envoytransformation "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -19,6 +24,7 @@ import (
 	aiutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	kgwwellknown "github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 const (
@@ -28,6 +34,12 @@ const (
 	OpenAIHost    = "api.openai.com"
 	GeminiHost    = "generativelanguage.googleapis.com"
 	AnthropicHost = "api.anthropic.com"
+
+	// transformationMetadataNamespace is the namespace of the endpoint metadata read by the transformation
+	// of the requests with host_metadata.
+	transformationMetadataNamespace = "io.solo.transformation"
+	// providerMetadataKey is the endpoint metadata key holding the name of the provider of the endpoint.
+	providerMetadataKey = "provider"
 )
 
 func tlsMatch() *structpb.Struct {
@@ -61,8 +73,9 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 	}
 
 	// We are reliant on https://github.com/envoyproxy/envoy/pull/34154 to merge
-	// before we can do OutlierDetection on 429s here
-	// out.OutlierDetection = getOutlierDetectionConfig(aiUs)
+	// before we can do OutlierDetection on 429s here, rate limited requests are
+	// retried on the other providers by the route instead, see failoverRetryPolicy.
+	applyFailover(aiUs.Failover, out)
 
 	var prioritized []*envoyendpointv3.LocalityLbEndpoints
 	var err error
@@ -72,14 +85,13 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 			return err
 		}
 	} else {
-		epByType := map[string]struct{}{}
+		mixedProviderTypes := hasMixedProviderTypes(aiUs)
 		prioritized = make([]*envoyendpointv3.LocalityLbEndpoints, 0, len(aiUs.PriorityGroups))
 		for idx, group := range aiUs.PriorityGroups {
 			eps := make([]*envoyendpointv3.LbEndpoint, 0, len(group.Providers))
 			for jdx, ep := range group.Providers {
 				var result *envoyendpointv3.LbEndpoint
 				var err error
-				epByType[fmt.Sprintf("%T", ep)] = struct{}{}
 				if ep.OpenAI != nil {
 					var secretForMultiPool *ir.Secret
 					if ep.OpenAI.AuthToken.Kind == v1alpha1.SecretRef {
@@ -122,6 +134,14 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 				if err != nil {
 					return err
 				}
+				if result == nil {
+					continue
+				}
+				setProviderLbMetadata(result, string(ep.Name))
+				if mixedProviderTypes {
+					// the transformation of the request depends on the provider of the endpoint
+					setProviderTransformationMetadata(result, string(ep.Name))
+				}
 				eps = append(eps, result)
 			}
 			priority := idx
@@ -130,8 +150,13 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 				LbEndpoints: eps,
 			})
 		}
-		if len(epByType) > 1 {
-			return fmt.Errorf("multi backend pools must all be of the same type, got %v", epByType)
+		// the model routing of the AI policy selects the endpoints of a provider by name,
+		// requests without a provider selected are load balanced across all the providers
+		out.LbSubsetConfig = &envoyclusterv3.Cluster_LbSubsetConfig{
+			SubsetSelectors: []*envoyclusterv3.Cluster_LbSubsetConfig_LbSubsetSelector{{
+				Keys: []string{kgwwellknown.AIProviderMetadataKey},
+			}},
+			FallbackPolicy: envoyclusterv3.Cluster_LbSubsetConfig_ANY_ENDPOINT,
 		}
 	}
 
@@ -177,6 +202,59 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 	return nil
 }
 
+// applyFailover ejects the providers failing with server errors or timeouts, so that requests
+// fail over to the other providers of the group and then to the next priority group.
+func applyFailover(failover *v1alpha1.AIFailover, out *envoyclusterv3.Cluster) {
+	if failover == nil {
+		return
+	}
+	if ptr.Deref(failover.LoadBalancing, v1alpha1.AILoadBalancingRoundRobin) == v1alpha1.AILoadBalancingLeastLatency {
+		// slow providers accumulate active requests, so least request favors the providers with the lowest latency
+		out.LbPolicy = envoyclusterv3.Cluster_LEAST_REQUEST
+	}
+
+	triggers := failoverTriggers(failover)
+	ejectOnServerError := slices.Contains(triggers, v1alpha1.AIFailoverTriggerServerError)
+	ejectOnTimeout := slices.Contains(triggers, v1alpha1.AIFailoverTriggerTimeout)
+	if !ejectOnServerError && !ejectOnTimeout {
+		return
+	}
+
+	consecutiveFailures := wrapperspb.UInt32(uint32(ptr.Deref(failover.ConsecutiveFailures, 5))) //nolint:gosec // G115: kubebuilder validation ensures 1 <= value <= 100
+	outlierDetection := &envoyclusterv3.OutlierDetection{
+		BaseEjectionTime: durationpb.New(30 * time.Second),
+		// all the providers of a group may be ejected to fail over to the next priority group
+		MaxEjectionPercent: wrapperspb.UInt32(100),
+		// connection failures and timeouts are only counted as failures when the Timeout trigger is set
+		SplitExternalLocalOriginErrors:         true,
+		EnforcingConsecutive_5Xx:               wrapperspb.UInt32(0),
+		EnforcingConsecutiveGatewayFailure:     wrapperspb.UInt32(0),
+		EnforcingConsecutiveLocalOriginFailure: wrapperspb.UInt32(0),
+		EnforcingSuccessRate:                   wrapperspb.UInt32(0),
+		EnforcingLocalOriginSuccessRate:        wrapperspb.UInt32(0),
+	}
+	if failover.EjectionDuration != nil {
+		outlierDetection.BaseEjectionTime = durationpb.New(failover.EjectionDuration.Duration)
+	}
+	if ejectOnServerError {
+		outlierDetection.Consecutive_5Xx = consecutiveFailures
+		outlierDetection.EnforcingConsecutive_5Xx = wrapperspb.UInt32(100)
+	}
+	if ejectOnTimeout {
+		outlierDetection.ConsecutiveLocalOriginFailure = consecutiveFailures
+		outlierDetection.EnforcingConsecutiveLocalOriginFailure = wrapperspb.UInt32(100)
+	}
+	out.OutlierDetection = outlierDetection
+}
+
+// failoverTriggers returns the failover triggers of the backend, defaulting to RateLimited and ServerError.
+func failoverTriggers(failover *v1alpha1.AIFailover) []v1alpha1.AIFailoverTrigger {
+	if len(failover.Triggers) == 0 {
+		return []v1alpha1.AIFailoverTrigger{v1alpha1.AIFailoverTriggerRateLimited, v1alpha1.AIFailoverTriggerServerError}
+	}
+	return failover.Triggers
+}
+
 func buildLLMEndpoint(aiUs *v1alpha1.AIBackend, aiSecrets *ir.Secret) ([]*envoyendpointv3.LocalityLbEndpoints, error) {
 	var prioritized []*envoyendpointv3.LocalityLbEndpoints
 	provider := aiUs.LLM
@@ -332,6 +410,21 @@ func buildLocalityLbEndpoint(
 	}
 }
 
+// setProviderLbMetadata sets the name of the provider of the endpoint in the metadata matched by the subset load balancer.
+func setProviderLbMetadata(ep *envoyendpointv3.LbEndpoint, providerName string) {
+	ep.GetMetadata().GetFilterMetadata()[kgwwellknown.EnvoyLbMetadataNamespace] = &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			kgwwellknown.AIProviderMetadataKey: structpb.NewStringValue(providerName),
+		},
+	}
+}
+
+// setProviderTransformationMetadata sets the name of the provider of the endpoint in the metadata read by the
+// transformation of the requests sent to backends with providers of different types.
+func setProviderTransformationMetadata(ep *envoyendpointv3.LbEndpoint, providerName string) {
+	ep.GetMetadata().GetFilterMetadata()[transformationMetadataNamespace].GetFields()[providerMetadataKey] = structpb.NewStringValue(providerName)
+}
+
 // `buildEndpointMeta` builds the metadata for the endpoint.
 // This metadata is used by the post routing transformation filter to modify the request body.
 func buildEndpointMeta(token, model string, additionalFields map[string]string) *envoycorev3.Metadata {
@@ -346,7 +439,7 @@ func buildEndpointMeta(token, model string, additionalFields map[string]string)
 	}
 	return &envoycorev3.Metadata{
 		FilterMetadata: map[string]*structpb.Struct{
-			"io.solo.transformation": {
+			transformationMetadataNamespace: {
 				Fields: fields,
 			},
 		},
@@ -360,17 +453,22 @@ func createTransformationTemplate(aiBackend *v1alpha1.AIBackend) *envoytransform
 		Headers: map[string]*envoytransformation.InjaTemplate{},
 	}
 
+	if aiBackend.LLM == nil && hasMixedProviderTypes(aiBackend) {
+		setOpenAICompatibleTransformation(aiBackend, transformationTemplate)
+		return transformationTemplate
+	}
+
 	var headerName, prefix, path string
 	var bodyTransformation *envoytransformation.TransformationTemplate_MergeJsonKeys
 	if aiBackend.LLM != nil {
 		headerName, prefix, path, bodyTransformation = getTransformation(aiBackend.LLM)
 	} else if len(aiBackend.PriorityGroups) > 0 {
-		// We already know that all the backends are the same type so we can just take the first one
+		// All the providers are of the same type so we can just take the first one
 		provider := aiBackend.PriorityGroups[0].Providers[0]
 		headerName, prefix, path, bodyTransformation = getTransformation(&provider.LLMProvider)
 	}
 	transformationTemplate.GetHeaders()[headerName] = &envoytransformation.InjaTemplate{
-		Text: prefix + `{% if host_metadata("auth_token") != "" %}{{host_metadata("auth_token")}}{% else %}{{dynamic_metadata("auth_token","ai.kgateway.io")}}{% endif %}`,
+		Text: prefix + authTokenTemplate,
 	}
 	transformationTemplate.GetHeaders()[":path"] = &envoytransformation.InjaTemplate{
 		Text: path,
@@ -379,13 +477,137 @@ func createTransformationTemplate(aiBackend *v1alpha1.AIBackend) *envoytransform
 	return transformationTemplate
 }
 
+// authTokenTemplate renders the auth token of the endpoint, or the one set by the AI extension.
+const authTokenTemplate = `{% if host_metadata("auth_token") != "" %}{{host_metadata("auth_token")}}{% else %}{{dynamic_metadata("auth_token","ai.kgateway.io")}}{% endif %}`
+
+// providerTemplate is the template of a header or of the model of the requests sent to a provider.
+type providerTemplate struct {
+	provider string
+	text     string
+}
+
+// setOpenAICompatibleTransformation sets the transformation of a backend with providers of different types.
+// The requests are sent to the OpenAI-compatible API of each provider, so that clients send OpenAI requests for
+// all the models, e.g. to select the provider by model with the model routing of the AI policy. The auth header,
+// path and model of each request are those of the provider of the endpoint the request is sent to.
+func setOpenAICompatibleTransformation(aiBackend *v1alpha1.AIBackend, out *envoytransformation.TransformationTemplate) {
+	var headerNames []string
+	authHeaders := map[string][]providerTemplate{}
+	var paths, models []providerTemplate
+	for _, group := range aiBackend.PriorityGroups {
+		for _, provider := range group.Providers {
+			headerName, prefix, path, model := getOpenAICompatibleTransformation(&provider.LLMProvider)
+			if path == "" {
+				// the provider is not supported
+				continue
+			}
+			name := string(provider.Name)
+			if _, ok := authHeaders[headerName]; !ok {
+				headerNames = append(headerNames, headerName)
+			}
+			authHeaders[headerName] = append(authHeaders[headerName], providerTemplate{provider: name, text: prefix + authTokenTemplate})
+			paths = append(paths, providerTemplate{provider: name, text: path})
+			if model != "" {
+				models = append(models, providerTemplate{provider: name, text: model})
+			}
+		}
+	}
+
+	// the auth headers of the other providers are rendered empty, which removes them
+	for _, headerName := range headerNames {
+		out.GetHeaders()[headerName] = &envoytransformation.InjaTemplate{
+			Text: providerSwitch(authHeaders[headerName], ""),
+		}
+	}
+	out.GetHeaders()[":path"] = &envoytransformation.InjaTemplate{
+		Text: providerSwitch(paths, ""),
+	}
+	out.BodyTransformation = &envoytransformation.TransformationTemplate_MergeJsonKeys{
+		MergeJsonKeys: &envoytransformation.MergeJsonKeys{
+			JsonKeys: map[string]*envoytransformation.MergeJsonKeys_OverridableTemplate{
+				"model": {
+					Tmpl: &envoytransformation.InjaTemplate{
+						Text: providerSwitch(models, defaultModelTemplate),
+					},
+				},
+			},
+		},
+	}
+}
+
+// providerSwitch returns a template rendering the template of the provider of the endpoint the request is sent to,
+// or the default template if the provider has none.
+func providerSwitch(templates []providerTemplate, defaultTemplate string) string {
+	if len(templates) == 0 {
+		return defaultTemplate
+	}
+	var sb strings.Builder
+	for i, t := range templates {
+		if i == 0 {
+			sb.WriteString("{% if ")
+		} else {
+			sb.WriteString("{% else if ")
+		}
+		fmt.Fprintf(&sb, `host_metadata("%s") == "%s" %%}`, providerMetadataKey, t.provider)
+		sb.WriteString(t.text)
+	}
+	if defaultTemplate != "" {
+		sb.WriteString("{% else %}")
+		sb.WriteString(defaultTemplate)
+	}
+	sb.WriteString("{% endif %}")
+	return sb.String()
+}
+
+// getOpenAICompatibleTransformation returns the auth header name and prefix, the path and the model template of the
+// OpenAI-compatible API of the provider. The model template is empty if the model of the endpoint is used as is, and
+// the path is empty if the provider has no OpenAI-compatible API.
+func getOpenAICompatibleTransformation(provider *v1alpha1.LLMProvider) (string, string, string, string) {
+	headerName := "Authorization"
+	prefix := "Bearer "
+	var path, model string
+	if provider.OpenAI != nil {
+		path = "/v1/" + getOpenAIOperationPath()
+	} else if provider.Anthropic != nil {
+		headerName = "x-api-key"
+		prefix = ""
+		path = "/v1/chat/completions"
+	} else if provider.AzureOpenAI != nil {
+		// the Azure OpenAI API is OpenAI-compatible
+		headerName = "api-key"
+		prefix = ""
+		path = `/openai/deployments/{{ host_metadata("model") }}/` + getOpenAIOperationPath() + `?api-version={{ host_metadata("api_version" )}}`
+	} else if provider.Gemini != nil {
+		path = `/{{host_metadata("api_version")}}/openai/chat/completions`
+	} else if provider.VertexAI != nil {
+		path = `/{{host_metadata("api_version")}}/projects/{{host_metadata("project")}}/locations/{{host_metadata("location")}}/endpoints/openapi/chat/completions`
+		// the models of the Vertex AI OpenAI-compatible API are prefixed with their publisher
+		model = `"{{host_metadata("publisher")}}/{{host_metadata("model")}}"`
+	}
+	if path == "" {
+		return "", "", "", ""
+	}
+	if provider.Path != nil && provider.Path.Full != nil {
+		path = *provider.Path.Full
+	}
+	if provider.AuthHeader != nil {
+		if provider.AuthHeader.HeaderName != nil {
+			headerName = *provider.AuthHeader.HeaderName
+		}
+		if provider.AuthHeader.Prefix != nil {
+			prefix = *provider.AuthHeader.Prefix
+		}
+	}
+	return headerName, prefix, path, model
+}
+
 func getTransformation(provider *v1alpha1.LLMProvider) (string, string, string, *envoytransformation.TransformationTemplate_MergeJsonKeys) {
 	headerName := "Authorization"
 	var prefix, path string
 	var bodyTransformation *envoytransformation.TransformationTemplate_MergeJsonKeys
 	if provider.OpenAI != nil {
 		prefix = "Bearer "
-		path = "/v1/chat/completions"
+		path = "/v1/" + getOpenAIOperationPath()
 		bodyTransformation = defaultBodyTransformation()
 	} else if provider.Anthropic != nil {
 		headerName = "x-api-key"
@@ -393,7 +615,7 @@ func getTransformation(provider *v1alpha1.LLMProvider) (string, string, string,
 		bodyTransformation = defaultBodyTransformation()
 	} else if provider.AzureOpenAI != nil {
 		headerName = "api-key"
-		path = `/openai/deployments/{{ host_metadata("model") }}/chat/completions?api-version={{ host_metadata("api_version" )}}`
+		path = `/openai/deployments/{{ host_metadata("model") }}/` + getOpenAIOperationPath() + `?api-version={{ host_metadata("api_version" )}}`
 	} else if provider.Gemini != nil {
 		headerName = "key"
 		path = getGeminiPath()
@@ -435,6 +657,12 @@ func getTransformation(provider *v1alpha1.LLMProvider) (string, string, string,
 	return headerName, prefix, path, bodyTransformation
 }
 
+// getOpenAIOperationPath returns the path of the OpenAI API matching the route type set by the AI policy,
+// defaulting to the chat completions API.
+func getOpenAIOperationPath() string {
+	return `{% if dynamic_metadata("route_type") == "COMPLETIONS" %}completions{% else if dynamic_metadata("route_type") == "EMBEDDINGS" %}embeddings{% else if dynamic_metadata("route_type") == "RESPONSES" %}responses{% else if dynamic_metadata("route_type") == "IMAGES" %}images/generations{% else %}chat/completions{% endif %}`
+}
+
 func getGeminiPath() string {
 	return `/{{host_metadata("api_version")}}/models/{{host_metadata("model")}}:{% if dynamic_metadata("route_type") == "CHAT_STREAMING" %}streamGenerateContent?key={{host_metadata("auth_token")}}&alt=sse{% else %}generateContent?key={{host_metadata("auth_token")}}{% endif %}`
 }
@@ -443,6 +671,9 @@ func getVertexAIGeminiModelPath() string {
 	return `models/{{host_metadata("model")}}:{% if dynamic_metadata("route_type") == "CHAT_STREAMING" %}streamGenerateContent?alt=sse{% else %}generateContent{% endif %}`
 }
 
+// defaultModelTemplate renders the model of the endpoint, or the model of the request if the endpoint has none.
+const defaultModelTemplate = `{% if host_metadata("model") != "" %}"{{host_metadata("model")}}"{% else %}"{{model}}"{% endif %}`
+
 func defaultBodyTransformation() *envoytransformation.TransformationTemplate_MergeJsonKeys {
 	return &envoytransformation.TransformationTemplate_MergeJsonKeys{
 		MergeJsonKeys: &envoytransformation.MergeJsonKeys{
@@ -450,7 +681,7 @@ func defaultBodyTransformation() *envoytransformation.TransformationTemplate_Mer
 				"model": {
 					Tmpl: &envoytransformation.InjaTemplate{
 						// Merge the model into the body
-						Text: `{% if host_metadata("model") != "" %}"{{host_metadata("model")}}"{% else %}"{{model}}"{% endif %}`,
+						Text: defaultModelTemplate,
 					},
 				},
 			},
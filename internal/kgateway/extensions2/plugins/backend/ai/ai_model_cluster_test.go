@@ -3,15 +3,18 @@ package ai
 import (
 	"strings"
 	"testing"
+	"time"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 func TestProcessAIBackend_Empty(t *testing.T) {
@@ -425,6 +428,75 @@ func TestProcessAIBackend_MultiPool(t *testing.T) {
 	require.NotNil(t, metadata1)
 	assert.Equal(t, "fallback-token", metadata1.Fields["auth_token"].GetStringValue())
 	assert.Equal(t, "gpt-3.5-turbo", metadata1.Fields["model"].GetStringValue())
+
+	// Verify the providers can be selected by name with the subset load balancer
+	require.NotNil(t, cluster.GetLbSubsetConfig())
+	assert.Equal(t, envoyclusterv3.Cluster_LbSubsetConfig_ANY_ENDPOINT, cluster.GetLbSubsetConfig().GetFallbackPolicy())
+	require.Len(t, cluster.GetLbSubsetConfig().GetSubsetSelectors(), 1)
+	assert.Equal(t, []string{wellknown.AIProviderMetadataKey}, cluster.GetLbSubsetConfig().GetSubsetSelectors()[0].GetKeys())
+	lbMetadata0 := endpoint0.Metadata.FilterMetadata[wellknown.EnvoyLbMetadataNamespace]
+	assert.Equal(t, "openai-primary", lbMetadata0.GetFields()[wellknown.AIProviderMetadataKey].GetStringValue())
+	lbMetadata1 := endpoint1.Metadata.FilterMetadata[wellknown.EnvoyLbMetadataNamespace]
+	assert.Equal(t, "openai-fallback", lbMetadata1.GetFields()[wellknown.AIProviderMetadataKey].GetStringValue())
+}
+
+func TestProcessAIBackend_MultiPoolMixedProviderTypes(t *testing.T) {
+	aiBackend := &v1alpha1.AIBackend{
+		PriorityGroups: []v1alpha1.PriorityGroup{
+			{
+				Providers: []v1alpha1.NamedLLMProvider{
+					{
+						Name: "openai",
+						LLMProvider: v1alpha1.LLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{
+								AuthToken: v1alpha1.SingleAuthToken{
+									Kind:   v1alpha1.Inline,
+									Inline: ptr.To("openai-token"),
+								},
+							},
+						},
+					},
+					{
+						Name: "gemini",
+						LLMProvider: v1alpha1.LLMProvider{
+							Gemini: &v1alpha1.GeminiConfig{
+								Model:      "gemini-1.5-pro",
+								ApiVersion: "v1beta",
+								AuthToken: v1alpha1.SingleAuthToken{
+									Kind:   v1alpha1.Inline,
+									Inline: ptr.To("gemini-token"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cluster := &envoyclusterv3.Cluster{Name: "mixed-cluster"}
+	err := ProcessAIBackend(aiBackend, &ir.Secret{}, map[string]*ir.Secret{}, cluster)
+	require.NoError(t, err)
+
+	// Verify the endpoints carry the name of their provider, for the model routing and the transformation
+	endpoints := cluster.GetLoadAssignment().GetEndpoints()
+	require.Len(t, endpoints, 1)
+	require.Len(t, endpoints[0].GetLbEndpoints(), 2)
+	for i, provider := range []string{"openai", "gemini"} {
+		metadata := endpoints[0].GetLbEndpoints()[i].GetMetadata()
+		assert.Equal(t, provider, metadata.GetFilterMetadata()[wellknown.EnvoyLbMetadataNamespace].GetFields()[wellknown.AIProviderMetadataKey].GetStringValue())
+		assert.Equal(t, provider, metadata.GetFilterMetadata()["io.solo.transformation"].GetFields()["provider"].GetStringValue())
+	}
+
+	// Verify the requests are sent to the OpenAI-compatible API of the provider of the endpoint
+	transformation := createTransformationTemplate(aiBackend)
+	assert.Equal(t,
+		`{% if host_metadata("provider") == "openai" %}/v1/`+getOpenAIOperationPath()+`{% else if host_metadata("provider") == "gemini" %}/{{host_metadata("api_version")}}/openai/chat/completions{% endif %}`,
+		transformation.GetHeaders()[":path"].GetText())
+	assert.Equal(t,
+		`{% if host_metadata("provider") == "openai" %}Bearer `+authTokenTemplate+`{% else if host_metadata("provider") == "gemini" %}Bearer `+authTokenTemplate+`{% endif %}`,
+		transformation.GetHeaders()["Authorization"].GetText())
+	assert.Equal(t, defaultModelTemplate, transformation.GetMergeJsonKeys().GetJsonKeys()["model"].GetTmpl().GetText())
 }
 
 // findTransportSocketMatchByPrefix finds a transport socket match with a name starting with prefix
@@ -446,3 +518,53 @@ func findTransportSocketMatchByName(matches []*envoyclusterv3.Cluster_TransportS
 	}
 	return nil
 }
+
+func TestApplyFailover(t *testing.T) {
+	t.Run("no failover", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{}
+		applyFailover(nil, cluster)
+		assert.Nil(t, cluster.GetOutlierDetection())
+		assert.Equal(t, envoyclusterv3.Cluster_ROUND_ROBIN, cluster.GetLbPolicy())
+	})
+
+	t.Run("defaults eject providers on server errors", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{}
+		applyFailover(&v1alpha1.AIFailover{}, cluster)
+
+		od := cluster.GetOutlierDetection()
+		require.NotNil(t, od)
+		assert.Equal(t, uint32(5), od.GetConsecutive_5Xx().GetValue())
+		assert.Equal(t, uint32(100), od.GetEnforcingConsecutive_5Xx().GetValue())
+		assert.Equal(t, uint32(0), od.GetEnforcingConsecutiveLocalOriginFailure().GetValue())
+		assert.Equal(t, uint32(100), od.GetMaxEjectionPercent().GetValue())
+		assert.Equal(t, 30*time.Second, od.GetBaseEjectionTime().AsDuration())
+		assert.True(t, od.GetSplitExternalLocalOriginErrors())
+	})
+
+	t.Run("timeouts with least latency", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{}
+		applyFailover(&v1alpha1.AIFailover{
+			Triggers:            []v1alpha1.AIFailoverTrigger{v1alpha1.AIFailoverTriggerTimeout},
+			ConsecutiveFailures: ptr.To[int32](2),
+			EjectionDuration:    &metav1.Duration{Duration: time.Minute},
+			LoadBalancing:       ptr.To(v1alpha1.AILoadBalancingLeastLatency),
+		}, cluster)
+
+		od := cluster.GetOutlierDetection()
+		require.NotNil(t, od)
+		assert.Nil(t, od.GetConsecutive_5Xx())
+		assert.Equal(t, uint32(0), od.GetEnforcingConsecutive_5Xx().GetValue())
+		assert.Equal(t, uint32(2), od.GetConsecutiveLocalOriginFailure().GetValue())
+		assert.Equal(t, uint32(100), od.GetEnforcingConsecutiveLocalOriginFailure().GetValue())
+		assert.Equal(t, time.Minute, od.GetBaseEjectionTime().AsDuration())
+		assert.Equal(t, envoyclusterv3.Cluster_LEAST_REQUEST, cluster.GetLbPolicy())
+	})
+
+	t.Run("rate limited only does not eject", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{}
+		applyFailover(&v1alpha1.AIFailover{
+			Triggers: []v1alpha1.AIFailoverTrigger{v1alpha1.AIFailoverTriggerRateLimited},
+		}, cluster)
+		assert.Nil(t, cluster.GetOutlierDetection())
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
@@ -38,6 +39,8 @@ const (
 	awsRequestSigningFilterName = "envoy.filters.http.aws_request_signing"
 	// upstreamCodecFilterName is the name of the upstream codec filter.
 	upstreamCodecFilterName = "envoy.filters.http.upstream_codec"
+	// defaultWebIdentityTokenPath is the path of the web identity token projected by the EKS Pod Identity Webhook for IRSA.
+	defaultWebIdentityTokenPath = "/var/run/secrets/eks.amazonaws.com/serviceaccount/token" //nolint:gosec // G101: This is a well-known file path, not a credential
 )
 
 // AwsIr is the internal representation of an AWS backend.
@@ -127,31 +130,85 @@ func processAws(ir *AwsIr, out *envoyclusterv3.Cluster) error {
 }
 
 // configureAWSAuth configures AWS authentication for the given backend.
-func configureAWSAuth(secret *ir.Secret, region string) (*envoy_request_signing_v3.AwsRequestSigning, error) {
-	// when no auth is specified, use the default aws auth provider documented by the lambda filter:
-	// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/aws_lambda_filter#credentials.
-	if secret == nil || secret.Data == nil {
-		return &envoy_request_signing_v3.AwsRequestSigning{
-			ServiceName: lambdaServiceName,
-			Region:      region,
-		}, nil
-	}
-	// handle secret-based auth. configure inline credentials.
-	derived, err := deriveStaticSecret(secret)
+func configureAWSAuth(auth *v1alpha1.AwsAuth, secret *ir.Secret, region string) (*envoy_request_signing_v3.AwsRequestSigning, error) {
+	credentialProvider, err := buildAWSCredentialProvider(auth, secret)
 	if err != nil {
-		return nil, fmt.Errorf("failed to derive static secret: %v", err)
+		return nil, err
 	}
-
 	return &envoy_request_signing_v3.AwsRequestSigning{
-		ServiceName: lambdaServiceName,
-		Region:      region,
-		CredentialProvider: &envoy_aws_common_v3.AwsCredentialProvider{
+		ServiceName:        lambdaServiceName,
+		Region:             region,
+		CredentialProvider: credentialProvider,
+	}, nil
+}
+
+// buildAWSCredentialProvider builds the credential provider for the given auth. When nil is returned,
+// the default aws auth provider documented by the lambda filter is used:
+// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/aws_lambda_filter#credentials.
+func buildAWSCredentialProvider(auth *v1alpha1.AwsAuth, secret *ir.Secret) (*envoy_aws_common_v3.AwsCredentialProvider, error) {
+	if auth == nil {
+		return nil, nil
+	}
+
+	var credentialProvider *envoy_aws_common_v3.AwsCredentialProvider
+	switch auth.Type {
+	case v1alpha1.AwsAuthTypeSecret:
+		if secret == nil || secret.Data == nil {
+			break
+		}
+		// handle secret-based auth. configure inline credentials.
+		derived, err := deriveStaticSecret(secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive static secret: %v", err)
+		}
+		credentialProvider = &envoy_aws_common_v3.AwsCredentialProvider{
 			InlineCredential: &envoy_aws_common_v3.InlineCredentialProvider{
 				AccessKeyId:     derived.access,
 				SecretAccessKey: derived.secret,
 				SessionToken:    derived.session,
 			},
-		},
+		}
+	case v1alpha1.AwsAuthTypeWebIdentity:
+		// without an explicit web identity, the default provider reads it from the environment of the proxy.
+		if auth.WebIdentity == nil {
+			break
+		}
+		credentialProvider = &envoy_aws_common_v3.AwsCredentialProvider{
+			CustomCredentialProviderChain: true,
+			AssumeRoleWithWebIdentityProvider: &envoy_aws_common_v3.AssumeRoleWithWebIdentityCredentialProvider{
+				WebIdentityTokenDataSource: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_Filename{
+						Filename: ptr.Deref(auth.WebIdentity.TokenPath, defaultWebIdentityTokenPath),
+					},
+				},
+				RoleArn:         auth.WebIdentity.RoleARN,
+				RoleSessionName: ptr.Deref(auth.WebIdentity.RoleSessionName, ""),
+			},
+		}
+	case v1alpha1.AwsAuthTypePodIdentity:
+		credentialProvider = &envoy_aws_common_v3.AwsCredentialProvider{
+			CustomCredentialProviderChain: true,
+			ContainerCredentialProvider:   &envoy_aws_common_v3.ContainerCredentialProvider{},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported aws auth type %q", auth.Type)
+	}
+
+	if auth.AssumeRole == nil {
+		return credentialProvider, nil
+	}
+	assumeRole := &envoy_aws_common_v3.AssumeRoleCredentialProvider{
+		RoleArn:            auth.AssumeRole.RoleARN,
+		RoleSessionName:    ptr.Deref(auth.AssumeRole.RoleSessionName, ""),
+		ExternalId:         ptr.Deref(auth.AssumeRole.ExternalID, ""),
+		CredentialProvider: credentialProvider,
+	}
+	if auth.AssumeRole.SessionDuration != nil {
+		assumeRole.SessionDuration = durationpb.New(auth.AssumeRole.SessionDuration.Duration)
+	}
+	return &envoy_aws_common_v3.AwsCredentialProvider{
+		CustomCredentialProviderChain: true,
+		AssumeRoleCredentialProvider:  assumeRole,
 	}, nil
 }
 
@@ -175,6 +232,7 @@ func (u *lambdaFilters) Equals(other *lambdaFilters) bool {
 func buildLambdaFilters(
 	arn string,
 	region string,
+	auth *v1alpha1.AwsAuth,
 	secret *ir.Secret,
 	invokeMode envoy_lambda_v3.Config_InvocationMode,
 	payloadTransformMode v1alpha1.AWSLambdaPayloadTransformMode,
@@ -196,7 +254,7 @@ func buildLambdaFilters(
 		return nil, fmt.Errorf("failed to create lambda config: %v", err)
 	}
 
-	awsRequestSigning, err := configureAWSAuth(secret, region)
+	awsRequestSigning, err := configureAWSAuth(auth, secret, region)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create aws request signing config: %v", err)
 	}
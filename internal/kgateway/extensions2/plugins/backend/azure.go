@@ -0,0 +1,169 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_mutation_rules_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_header_mutation_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_oauth2_credential_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/oauth2/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/sslutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+)
+
+const (
+	// headerMutationFilterName is the name of the header mutation filter.
+	headerMutationFilterName = "envoy.filters.http.header_mutation"
+	// azureFunctionKeyHeader is the header used to send the function key to Azure Functions.
+	azureFunctionKeyHeader = "x-functions-key"
+	// azureEntraIDClusterName is the name of the cluster used by the credential injector filter
+	// to fetch access tokens from Microsoft Entra ID.
+	azureEntraIDClusterName = "azure_entra_id"
+	// azureEntraIDHost is the hostname of the Microsoft Entra ID token endpoints.
+	azureEntraIDHost = "login.microsoftonline.com"
+)
+
+// azureEntraIDTokenFetchTimeout is the timeout for fetching an access token from Microsoft Entra ID.
+var azureEntraIDTokenFetchTimeout = &durationpb.Duration{Seconds: 10}
+
+// AzureIr is the internal representation of an Azure backend.
+type AzureIr struct {
+	host string
+	// authFilter is the upstream filter adding the credentials to requests,
+	// nil if requests are sent without credentials.
+	authFilter *envoy_hcm.HttpFilter
+	// usesEntraID is set when the access tokens of the backend are fetched from Microsoft Entra ID.
+	usesEntraID bool
+}
+
+// Equals checks if two AzureIr objects are equal.
+func (u *AzureIr) Equals(other *AzureIr) bool {
+	return cmputils.CompareWithNils(u, other, func(a, b *AzureIr) bool {
+		return a.host == b.host && proto.Equal(a.authFilter, b.authFilter) && a.usesEntraID == b.usesEntraID
+	})
+}
+
+// buildAzureIr builds the internal representation of the given Azure backend.
+// The secret is the one referenced by the auth, if any.
+func buildAzureIr(in *v1alpha1.AzureBackend, secret *ir.Secret) (*AzureIr, error) {
+	azureIr := &AzureIr{
+		host: string(in.Host),
+	}
+	if in.Auth == nil {
+		return azureIr, nil
+	}
+
+	switch in.Auth.Type {
+	case v1alpha1.AzureAuthTypeFunctionKey:
+		if secret == nil {
+			return azureIr, errors.New("function key secret not found")
+		}
+		functionKey := strings.TrimSpace(string(secret.Data[wellknown.FunctionKey]))
+		if functionKey == "" {
+			return azureIr, fmt.Errorf("secret %s has no %s", secret.Name, wellknown.FunctionKey)
+		}
+		authFilterAny, err := utils.MessageToAny(&envoy_header_mutation_v3.HeaderMutation{
+			Mutations: &envoy_header_mutation_v3.Mutations{
+				RequestMutations: []*envoy_mutation_rules_v3.HeaderMutation{{
+					Action: &envoy_mutation_rules_v3.HeaderMutation_Append{
+						Append: &envoycorev3.HeaderValueOption{
+							Header: &envoycorev3.HeaderValue{
+								Key:   azureFunctionKeyHeader,
+								Value: functionKey,
+							},
+							AppendAction: envoycorev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+						},
+					},
+				}},
+			},
+		})
+		if err != nil {
+			return azureIr, fmt.Errorf("failed to create header mutation config: %v", err)
+		}
+		azureIr.authFilter = &envoy_hcm.HttpFilter{
+			Name: headerMutationFilterName,
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: authFilterAny,
+			},
+		}
+	case v1alpha1.AzureAuthTypeEntraID:
+		if secret == nil {
+			return azureIr, errors.New("client secret not found")
+		}
+		if in.Auth.EntraID == nil {
+			return azureIr, errors.New("entraID must be specified")
+		}
+		if len(secret.Data[wellknown.ClientSecret]) == 0 {
+			return azureIr, fmt.Errorf("secret %s has no %s", secret.Name, wellknown.ClientSecret)
+		}
+		// The client secret is served to the proxy over SDS, as the OAuth2 credential cannot be
+		// configured with an inline client secret.
+		authFilter, err := credentialInjectorFilter(oauth2CredentialName, &envoy_oauth2_credential_v3.OAuth2{
+			TokenEndpoint: &envoycorev3.HttpUri{
+				Uri: "https://" + azureEntraIDHost + "/" + in.Auth.EntraID.TenantID + "/oauth2/v2.0/token",
+				HttpUpstreamType: &envoycorev3.HttpUri_Cluster{
+					Cluster: azureEntraIDClusterName,
+				},
+				Timeout: azureEntraIDTokenFetchTimeout,
+			},
+			Scopes: []string{in.Auth.EntraID.Scope},
+			FlowType: &envoy_oauth2_credential_v3.OAuth2_ClientCredentials_{
+				ClientCredentials: &envoy_oauth2_credential_v3.OAuth2_ClientCredentials{
+					ClientId: in.Auth.EntraID.ClientID,
+					ClientSecret: &envoytlsv3.SdsSecretConfig{
+						Name:      sslutils.KubernetesGenericSdsSecretName(secret.Namespace, secret.Name, wellknown.ClientSecret),
+						SdsConfig: sslutils.AdsConfigSource(),
+					},
+					AuthType: envoy_oauth2_credential_v3.OAuth2_URL_ENCODED_BODY,
+				},
+			},
+		})
+		if err != nil {
+			return azureIr, err
+		}
+		azureIr.authFilter = authFilter
+		azureIr.usesEntraID = true
+	default:
+		return azureIr, fmt.Errorf("unsupported azure auth type %q", in.Auth.Type)
+	}
+	return azureIr, nil
+}
+
+// processAzure processes an Azure backend.
+func processAzure(ir *AzureIr, out *envoyclusterv3.Cluster) error {
+	// defensive check; this should never happen with union types
+	if ir == nil {
+		return fmt.Errorf("azure ir is nil")
+	}
+
+	var upstreamFilters []*envoy_hcm.HttpFilter
+	if ir.authFilter != nil {
+		upstreamFilters = append(upstreamFilters, ir.authFilter)
+	}
+	return processHttpsHost(ir.host, upstreamFilters, out)
+}
+
+// azureEntraIDCluster returns the cluster used by the credential injector filter to fetch
+// access tokens from Microsoft Entra ID.
+func azureEntraIDCluster() (*envoyclusterv3.Cluster, error) {
+	out := &envoyclusterv3.Cluster{
+		Name:           azureEntraIDClusterName,
+		ConnectTimeout: &durationpb.Duration{Seconds: 5},
+	}
+	if err := processHttpsHost(azureEntraIDHost, nil, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
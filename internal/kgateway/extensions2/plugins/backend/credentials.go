@@ -0,0 +1,47 @@
+package backend
+
+import (
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_credential_injector_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/credential_injector/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+)
+
+const (
+	// credentialInjectorFilterName is the name of the credential injector filter.
+	credentialInjectorFilterName = "envoy.filters.http.credential_injector"
+	// oauth2CredentialName is the name of the credential extension fetching OAuth 2.0 access tokens.
+	oauth2CredentialName = "envoy.http.injected_credentials.oauth2"
+	// genericCredentialName is the name of the credential extension injecting a credential served over SDS.
+	genericCredentialName = "envoy.http.injected_credentials.generic"
+)
+
+// credentialInjectorFilter returns an upstream filter injecting the given credential into each request
+// sent to the backend, overwriting the credential sent by the client, if any. Requests are rejected if
+// the credential is not available.
+func credentialInjectorFilter(credentialName string, credential proto.Message) (*envoy_hcm.HttpFilter, error) {
+	credentialAny, err := utils.MessageToAny(credential)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s config: %v", credentialName, err)
+	}
+	filterConfigAny, err := utils.MessageToAny(&envoy_credential_injector_v3.CredentialInjector{
+		Overwrite: true,
+		Credential: &envoycorev3.TypedExtensionConfig{
+			Name:        credentialName,
+			TypedConfig: credentialAny,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create credential injector config: %v", err)
+	}
+	return &envoy_hcm.HttpFilter{
+		Name: credentialInjectorFilterName,
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: filterConfigAny,
+		},
+	}, nil
+}
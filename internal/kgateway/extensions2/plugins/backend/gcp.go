@@ -0,0 +1,180 @@
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_gcp_authn_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/gcp_authn/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_generic_credential_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/generic/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/sslutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+)
+
+const (
+	// gcpAuthnFilterName is the name of the gcp authn filter.
+	gcpAuthnFilterName = "envoy.filters.http.gcp_authn"
+	// gcpMetadataServerClusterName is the name of the cluster used by the gcp authn filter
+	// to fetch ID tokens from the metadata server.
+	gcpMetadataServerClusterName = "gcp_metadata_server"
+	// gcpMetadataServerHost is the hostname of the metadata server.
+	gcpMetadataServerHost = "metadata.google.internal"
+	// gcpMetadataServerPort is the port of the metadata server.
+	gcpMetadataServerPort = 80
+	// gcpServiceAccountKeyType is the type of the JSON keys of Google service accounts.
+	gcpServiceAccountKeyType = "service_account"
+	// authorizationHeader is the header the ID tokens are sent in.
+	authorizationHeader = "Authorization"
+)
+
+// gcpTokenFetchTimeout is the timeout for fetching an ID token from the metadata server.
+var gcpTokenFetchTimeout = &durationpb.Duration{Seconds: 10}
+
+// GcpIr is the internal representation of a GCP backend.
+type GcpIr struct {
+	host string
+	// audienceAny is the audience of the ID tokens fetched from the metadata server,
+	// nil if the ID tokens are minted with a service account key.
+	audienceAny *anypb.Any
+	// authFilter is the upstream filter adding the ID tokens to requests.
+	authFilter *envoy_hcm.HttpFilter
+}
+
+// Equals checks if two GcpIr objects are equal.
+func (u *GcpIr) Equals(other *GcpIr) bool {
+	return cmputils.CompareWithNils(u, other, func(a, b *GcpIr) bool {
+		return a.host == b.host && proto.Equal(a.audienceAny, b.audienceAny) && proto.Equal(a.authFilter, b.authFilter)
+	})
+}
+
+// usesMetadataServer returns true if the ID tokens of the backend are fetched from the metadata server.
+func (u *GcpIr) usesMetadataServer() bool {
+	return u != nil && u.audienceAny != nil
+}
+
+// buildGcpIr builds the internal representation of the given GCP backend.
+// The secret is the one referenced by the service account key auth, if any.
+func buildGcpIr(in *v1alpha1.GcpBackend, secret *ir.Secret) (*GcpIr, error) {
+	host := string(in.Host)
+	audience := ptr.Deref(in.Audience, "https://"+host)
+	gcpIr := &GcpIr{
+		host: host,
+	}
+
+	if in.Auth != nil && in.Auth.Type == v1alpha1.GcpAuthTypeServiceAccountKey {
+		if secret == nil {
+			return gcpIr, errors.New("service account key secret not found")
+		}
+		if err := validateGcpServiceAccountKey(secret.Data[wellknown.ServiceAccountKey]); err != nil {
+			return gcpIr, fmt.Errorf("secret %s has an invalid %s: %v", secret.Name, wellknown.ServiceAccountKey, err)
+		}
+		// The proxy cannot mint ID tokens with a service account key, so the ID tokens are minted
+		// by the control plane and served to the proxy over SDS.
+		authFilter, err := credentialInjectorFilter(genericCredentialName, &envoy_generic_credential_v3.Generic{
+			Credential: &envoytlsv3.SdsSecretConfig{
+				Name:      sslutils.GcpIDTokenSdsSecretName(secret.Namespace, secret.Name, audience),
+				SdsConfig: sslutils.AdsConfigSource(),
+			},
+			Header: authorizationHeader,
+		})
+		if err != nil {
+			return gcpIr, err
+		}
+		gcpIr.authFilter = authFilter
+		return gcpIr, nil
+	}
+
+	audienceAny, err := utils.MessageToAny(&envoy_gcp_authn_v3.Audience{
+		Url: audience,
+	})
+	if err != nil {
+		return gcpIr, fmt.Errorf("failed to create gcp authn audience: %v", err)
+	}
+	filterConfigAny, err := utils.MessageToAny(&envoy_gcp_authn_v3.GcpAuthnFilterConfig{
+		Cluster: gcpMetadataServerClusterName,
+		Timeout: gcpTokenFetchTimeout,
+	})
+	if err != nil {
+		return gcpIr, fmt.Errorf("failed to create gcp authn filter config: %v", err)
+	}
+	gcpIr.audienceAny = audienceAny
+	gcpIr.authFilter = &envoy_hcm.HttpFilter{
+		Name: gcpAuthnFilterName,
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: filterConfigAny,
+		},
+	}
+	return gcpIr, nil
+}
+
+// validateGcpServiceAccountKey checks that the given data is the JSON key of a Google service account.
+func validateGcpServiceAccountKey(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("key not found")
+	}
+	var key struct {
+		Type        string `json:"type"`
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return err
+	}
+	if key.Type != gcpServiceAccountKeyType || key.ClientEmail == "" || key.PrivateKey == "" {
+		return fmt.Errorf("not a %s key", gcpServiceAccountKeyType)
+	}
+	return nil
+}
+
+// processGcp processes a GCP backend. The auth filter adds an ID token for the audience of the
+// backend to each request sent to the backend.
+func processGcp(ir *GcpIr, out *envoyclusterv3.Cluster) error {
+	// defensive check; this should never happen with union types
+	if ir == nil {
+		return fmt.Errorf("gcp ir is nil")
+	}
+
+	if ir.audienceAny != nil {
+		// the gcp authn filter reads the audience from the cluster metadata
+		if out.GetMetadata() == nil {
+			out.Metadata = &envoycorev3.Metadata{}
+		}
+		if out.GetMetadata().GetTypedFilterMetadata() == nil {
+			out.Metadata.TypedFilterMetadata = map[string]*anypb.Any{}
+		}
+		out.Metadata.TypedFilterMetadata[gcpAuthnFilterName] = ir.audienceAny
+	}
+
+	var upstreamFilters []*envoy_hcm.HttpFilter
+	if ir.authFilter != nil {
+		upstreamFilters = append(upstreamFilters, ir.authFilter)
+	}
+	return processHttpsHost(ir.host, upstreamFilters, out)
+}
+
+// gcpMetadataServerCluster returns the cluster used by the gcp authn filter to fetch ID tokens.
+func gcpMetadataServerCluster() *envoyclusterv3.Cluster {
+	out := &envoyclusterv3.Cluster{
+		Name:           gcpMetadataServerClusterName,
+		ConnectTimeout: &durationpb.Duration{Seconds: 5},
+		ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{
+			Type: envoyclusterv3.Cluster_STRICT_DNS,
+		},
+	}
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, gcpMetadataServerHost, gcpMetadataServerPort)
+	return out
+}
@@ -0,0 +1,111 @@
+package backend
+
+import (
+	"fmt"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_upstream_codec "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/upstream_codec/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	eiutils "github.com/kgateway-dev/kgateway/v2/internal/envoyinit/pkg/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	translatorutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+)
+
+// httpsPort is the port of the hosts of the serverless backends.
+const httpsPort = 443
+
+// processHttpsHost configures the cluster to send requests to the given host using TLS, validating
+// the certificate of the host with the system CAs. The given upstream HTTP filters, if any, run
+// on each request sent to the host.
+func processHttpsHost(host string, upstreamFilters []*envoy_hcm.HttpFilter, out *envoyclusterv3.Cluster) error {
+	out.ClusterDiscoveryType = &envoyclusterv3.Cluster_Type{
+		Type: envoyclusterv3.Cluster_LOGICAL_DNS,
+	}
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, host, httpsPort)
+	// set the hostname of the endpoint so that the host header can be rewritten to it
+	out.GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().Hostname = host
+
+	tlsContext := &envoytlsv3.UpstreamTlsContext{
+		Sni: host,
+		CommonTlsContext: &envoytlsv3.CommonTlsContext{
+			ValidationContextType: &envoytlsv3.CommonTlsContext_CombinedValidationContext{
+				CombinedValidationContext: &envoytlsv3.CommonTlsContext_CombinedCertificateValidationContext{
+					DefaultValidationContext: &envoytlsv3.CertificateValidationContext{
+						MatchTypedSubjectAltNames: []*envoytlsv3.SubjectAltNameMatcher{{
+							SanType: envoytlsv3.SubjectAltNameMatcher_DNS,
+							Matcher: &envoymatcher.StringMatcher{
+								MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: host},
+							},
+						}},
+					},
+					ValidationContextSdsSecretConfig: &envoytlsv3.SdsSecretConfig{
+						Name: eiutils.SystemCaSecretName,
+					},
+				},
+			},
+		},
+	}
+	typedConfig, err := utils.MessageToAny(tlsContext)
+	if err != nil {
+		return fmt.Errorf("failed to create tls context: %v", err)
+	}
+	out.TransportSocket = &envoycorev3.TransportSocket{
+		Name: envoywellknown.TransportSocketTls,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}
+
+	if len(upstreamFilters) == 0 {
+		return nil
+	}
+	codecConfigAny, err := utils.MessageToAny(&envoy_upstream_codec.UpstreamCodec{})
+	if err != nil {
+		return fmt.Errorf("failed to create upstream codec config: %v", err)
+	}
+	if err := translatorutils.MutateHttpOptions(out, func(opts *envoy_upstreams_v3.HttpProtocolOptions) {
+		if opts.GetUpstreamProtocolOptions() == nil {
+			// upstream HTTP filters require the protocol options to be set
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+				ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+					ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{},
+				},
+			}
+		}
+		opts.HttpFilters = append(opts.GetHttpFilters(), upstreamFilters...)
+		opts.HttpFilters = append(opts.GetHttpFilters(), &envoy_hcm.HttpFilter{
+			Name: upstreamCodecFilterName,
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: codecConfigAny,
+			},
+		})
+	}); err != nil {
+		return fmt.Errorf("failed to mutate http options: %v", err)
+	}
+	return nil
+}
+
+// rewriteHostToBackend rewrites the host header of the requests to the host of the backend,
+// unless the route rewrites it already, as serverless platforms route requests on the host header.
+func rewriteHostToBackend(out *envoyroutev3.Route) {
+	if out.GetRoute() == nil {
+		// initialize route action if not set
+		out.Action = &envoyroutev3.Route_Route{
+			Route: &envoyroutev3.RouteAction{},
+		}
+	}
+	if out.GetRoute().GetHostRewriteSpecifier() == nil {
+		out.GetRoute().HostRewriteSpecifier = &envoyroutev3.RouteAction_AutoHostRewrite{
+			AutoHostRewrite: wrapperspb.Bool(true),
+		}
+	}
+}
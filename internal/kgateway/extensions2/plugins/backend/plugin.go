@@ -44,9 +44,11 @@ const (
 // BackendIr is the internal representation of a backend.
 // TODO: unexport
 type BackendIr struct {
-	AwsIr  *AwsIr
-	AIIr   *ai.IR
-	Errors []error
+	AwsIr   *AwsIr
+	AIIr    *ai.IR
+	GcpIr   *GcpIr
+	AzureIr *AzureIr
+	Errors  []error
 }
 
 func (u *BackendIr) Equals(other any) bool {
@@ -62,6 +64,14 @@ func (u *BackendIr) Equals(other any) bool {
 	if !u.AwsIr.Equals(otherBackend.AwsIr) {
 		return false
 	}
+	// GCP
+	if !u.GcpIr.Equals(otherBackend.GcpIr) {
+		return false
+	}
+	// Azure
+	if !u.AzureIr.Equals(otherBackend.AzureIr) {
+		return false
+	}
 	return true
 }
 
@@ -189,7 +199,7 @@ func buildTranslateFunc(
 			}
 
 			lambdaFilters, err := buildLambdaFilters(
-				lambdaArn, region, secret, invokeMode, i.Spec.Aws.Lambda.PayloadTransformMode)
+				lambdaArn, region, i.Spec.Aws.Auth, secret, invokeMode, i.Spec.Aws.Lambda.PayloadTransformMode)
 			if err != nil {
 				backendIr.Errors = append(backendIr.Errors, err)
 			}
@@ -199,6 +209,34 @@ func buildTranslateFunc(
 				lambdaTransportSocket: lambdaTransportSocket,
 				lambdaFilters:         lambdaFilters,
 			}
+		case v1alpha1.BackendTypeGCP:
+			var secret *ir.Secret
+			if i.Spec.Gcp.Auth != nil && i.Spec.Gcp.Auth.SecretRef != nil {
+				var err error
+				secret, err = pluginutils.GetSecretIr(secrets, krtctx, i.Spec.Gcp.Auth.SecretRef.Name, i.GetNamespace())
+				if err != nil {
+					backendIr.Errors = append(backendIr.Errors, err)
+				}
+			}
+			gcpIr, err := buildGcpIr(i.Spec.Gcp, secret)
+			if err != nil {
+				backendIr.Errors = append(backendIr.Errors, err)
+			}
+			backendIr.GcpIr = gcpIr
+		case v1alpha1.BackendTypeAzure:
+			var secret *ir.Secret
+			if i.Spec.Azure.Auth != nil && i.Spec.Azure.Auth.SecretRef != nil {
+				var err error
+				secret, err = pluginutils.GetSecretIr(secrets, krtctx, i.Spec.Azure.Auth.SecretRef.Name, i.GetNamespace())
+				if err != nil {
+					backendIr.Errors = append(backendIr.Errors, err)
+				}
+			}
+			azureIr, err := buildAzureIr(i.Spec.Azure, secret)
+			if err != nil {
+				backendIr.Errors = append(backendIr.Errors, err)
+			}
+			backendIr.AzureIr = azureIr
 		case v1alpha1.BackendTypeAI:
 			backendIr.AIIr = &ai.IR{}
 			err := ai.PreprocessAIBackend(ctx, i.Spec.AI, backendIr.AIIr)
@@ -303,6 +341,16 @@ func processBackendForEnvoy(ctx context.Context, in ir.BackendObjectIR, out *env
 			logger.Error("failed to process dynamic forward proxy backend", "error", err)
 			backendIr.Errors = append(backendIr.Errors, err)
 		}
+	case v1alpha1.BackendTypeGCP:
+		if err := processGcp(backendIr.GcpIr, out); err != nil {
+			logger.Error("failed to process gcp backend", "error", err)
+			backendIr.Errors = append(backendIr.Errors, err)
+		}
+	case v1alpha1.BackendTypeAzure:
+		if err := processAzure(backendIr.AzureIr, out); err != nil {
+			logger.Error("failed to process azure backend", "error", err)
+			backendIr.Errors = append(backendIr.Errors, err)
+		}
 	}
 	return nil
 }
@@ -344,6 +392,12 @@ type backendPlugin struct {
 	ir.UnimplementedProxyTranslationPass
 	aiGatewayEnabled map[string]bool
 	needsDfpFilter   map[string]bool
+	// needsGcpMetadataServer is set when a route uses a GCP backend, whose ID tokens
+	// are fetched from the metadata server.
+	needsGcpMetadataServer bool
+	// needsAzureEntraID is set when a route uses an Azure backend, whose access tokens
+	// are fetched from Microsoft Entra ID.
+	needsAzureEntraID bool
 }
 
 var _ ir.ProxyTranslationPass = &backendPlugin{}
@@ -387,6 +441,19 @@ func (p *backendPlugin) ApplyForBackend(pCtx *ir.RouteBackendContext, in ir.Http
 		p.needsDfpFilter[pCtx.FilterChainName] = true
 	}
 
+	switch backend.Spec.Type {
+	case v1alpha1.BackendTypeGCP:
+		if backendIr.GcpIr.usesMetadataServer() {
+			p.needsGcpMetadataServer = true
+		}
+		rewriteHostToBackend(out)
+	case v1alpha1.BackendTypeAzure:
+		if backendIr.AzureIr != nil && backendIr.AzureIr.usesEntraID {
+			p.needsAzureEntraID = true
+		}
+		rewriteHostToBackend(out)
+	}
+
 	return nil
 }
 
@@ -422,6 +489,17 @@ func (p *backendPlugin) ResourcesToAdd() ir.Resources {
 		}
 		additionalClusters = append(additionalClusters, aiClusters...)
 	}
+	if p.needsGcpMetadataServer {
+		additionalClusters = append(additionalClusters, gcpMetadataServerCluster())
+	}
+	if p.needsAzureEntraID {
+		entraIDCluster, err := azureEntraIDCluster()
+		if err != nil {
+			logger.Error("failed to create azure entra id cluster", "error", err)
+		} else {
+			additionalClusters = append(additionalClusters, entraIDCluster)
+		}
+	}
 	return ir.Resources{
 		Clusters: additionalClusters,
 	}
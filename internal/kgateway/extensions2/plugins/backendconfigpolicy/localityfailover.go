@@ -0,0 +1,112 @@
+package backendconfigpolicy
+
+import (
+	"context"
+	"hash/fnv"
+	"slices"
+	"strconv"
+
+	"istio.io/istio/pkg/kube/krt"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/endpoints"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+)
+
+type LocalityFailoverIR struct {
+	failoverPriority       []string
+	overprovisioningFactor *uint32
+	minEndpoints           int
+}
+
+func (l *LocalityFailoverIR) Equals(other *LocalityFailoverIR) bool {
+	return slices.Equal(l.failoverPriority, other.failoverPriority) &&
+		cmputils.PointerValsEqual(l.overprovisioningFactor, other.overprovisioningFactor) &&
+		l.minEndpoints == other.minEndpoints
+}
+
+func translateLocalityFailover(config *v1alpha1.LocalityFailover) *LocalityFailoverIR {
+	out := &LocalityFailoverIR{
+		failoverPriority: config.FailoverPriority,
+	}
+	if config.OverprovisioningFactor != nil {
+		factor := uint32(*config.OverprovisioningFactor) //nolint:gosec // G115: kubebuilder validation ensures 100 <= value <= 1000, safe for uint32
+		out.overprovisioningFactor = &factor
+	}
+	if config.MinEndpoints != nil {
+		out.minEndpoints = int(*config.MinEndpoints)
+	}
+	return out
+}
+
+func (l *LocalityFailoverIR) priorityInfo() *endpoints.PriorityInfo {
+	return &endpoints.PriorityInfo{
+		FailoverPriority:       endpoints.NewPriorities(l.failoverPriority),
+		OverprovisioningFactor: l.overprovisioningFactor,
+		MinEndpoints:           l.minEndpoints,
+	}
+}
+
+func (l *LocalityFailoverIR) hash() uint64 {
+	hasher := fnv.New64()
+	for _, label := range l.failoverPriority {
+		hasher.Write([]byte(label))
+		hasher.Write([]byte{0})
+	}
+	if l.overprovisioningFactor != nil {
+		hasher.Write([]byte(strconv.FormatUint(uint64(*l.overprovisioningFactor), 10)))
+	}
+	hasher.Write([]byte{0})
+	hasher.Write([]byte(strconv.Itoa(l.minEndpoints)))
+	return hasher.Sum64()
+}
+
+// processEndpointsFn returns the endpoint plugin applying the locality failover of the BackendConfigPolicy
+// attached to the backend of the endpoints. It overrides the priorities set from a DestinationRule or from
+// the traffic distribution of the Service.
+func processEndpointsFn(commoncol *collections.CommonCollections) sdk.EndpointPlugin {
+	return func(kctx krt.HandlerContext, _ context.Context, _ ir.UniqlyConnectedClient, out *endpoints.EndpointsInputs) uint64 {
+		// the backend index is initialized after the plugins
+		if commoncol.BackendIndex == nil {
+			return 0
+		}
+		var backend *ir.BackendObjectIR
+		for _, col := range commoncol.BackendIndex.BackendsWithPolicy() {
+			if b := krt.FetchOne(kctx, col, krt.FilterKey(out.EndpointsForBackend.UpstreamResourceName)); b != nil {
+				backend = *b
+				break
+			}
+		}
+		if backend == nil {
+			return 0
+		}
+
+		localityFailover := attachedLocalityFailover(backend)
+		if localityFailover == nil {
+			return 0
+		}
+		out.PriorityInfo = localityFailover.priorityInfo()
+		return localityFailover.hash()
+	}
+}
+
+// attachedLocalityFailover returns the locality failover of the policies attached to the backend.
+// As for the other settings applied by processBackend, the last policy with a locality failover wins.
+func attachedLocalityFailover(backend *ir.BackendObjectIR) *LocalityFailoverIR {
+	var localityFailover *LocalityFailoverIR
+	for _, polAtt := range backend.AttachedPolicies.Policies[wellknown.BackendConfigPolicyGVK.GroupKind()] {
+		if len(polAtt.Errors) > 0 {
+			continue
+		}
+		pol, ok := polAtt.PolicyIr.(*BackendConfigPolicyIR)
+		if !ok || pol.localityFailover == nil {
+			continue
+		}
+		localityFailover = pol.localityFailover
+	}
+	return localityFailover
+}
@@ -0,0 +1,104 @@
+package backendconfigpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/endpoints"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+func TestLocalityFailover(t *testing.T) {
+	backend := ir.BackendObjectIR{
+		ObjectSource: ir.ObjectSource{Kind: "Service", Namespace: "default", Name: "reviews"},
+		Port:         8080,
+	}
+	eps := ir.NewEndpointsForBackend(backend)
+	addEndpoint := func(addr, region, zone string) {
+		labels := map[string]string{
+			corev1.LabelTopologyRegion: region,
+			corev1.LabelTopologyZone:   zone,
+		}
+		eps.Add(ir.PodLocality{Region: region, Zone: zone}, ir.EndpointWithMd{
+			LbEndpoint: krtcollections.CreateLBEndpoint(addr, 8080, labels, false),
+			EndpointMd: ir.EndpointMetadata{Labels: labels},
+		})
+	}
+	addEndpoint("10.0.0.1", "region1", "zone1")
+	addEndpoint("10.0.0.2", "region1", "zone2")
+	addEndpoint("10.0.0.3", "region2", "zone3")
+
+	ucc := ir.NewUniqlyConnectedClient("gw", "default", map[string]string{
+		corev1.LabelTopologyRegion: "region1",
+		corev1.LabelTopologyZone:   "zone1",
+	}, ir.PodLocality{Region: "region1", Zone: "zone1"})
+
+	tests := []struct {
+		name                       string
+		localityFailover           *v1alpha1.LocalityFailover
+		wantPriorities             map[string]uint32
+		wantOverprovisioningFactor uint32
+	}{
+		{
+			name: "zone and region priorities",
+			localityFailover: &v1alpha1.LocalityFailover{
+				FailoverPriority:       []string{corev1.LabelTopologyRegion, corev1.LabelTopologyZone},
+				OverprovisioningFactor: ptr.To(int32(200)),
+			},
+			wantPriorities:             map[string]uint32{"10.0.0.1": 0, "10.0.0.2": 1, "10.0.0.3": 2},
+			wantOverprovisioningFactor: 200,
+		},
+		{
+			name: "label value override",
+			localityFailover: &v1alpha1.LocalityFailover{
+				FailoverPriority: []string{corev1.LabelTopologyRegion + "=region2"},
+			},
+			wantPriorities: map[string]uint32{"10.0.0.1": 1, "10.0.0.2": 1, "10.0.0.3": 0},
+		},
+		{
+			name: "fewer endpoints than the minimum",
+			localityFailover: &v1alpha1.LocalityFailover{
+				FailoverPriority: []string{corev1.LabelTopologyRegion, corev1.LabelTopologyZone},
+				MinEndpoints:     ptr.To(int32(4)),
+			},
+			wantPriorities: map[string]uint32{"10.0.0.1": 0, "10.0.0.2": 0, "10.0.0.3": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policyBackend := backend
+			policyBackend.AttachedPolicies = ir.AttachedPolicies{
+				Policies: map[schema.GroupKind][]ir.PolicyAtt{
+					wellknown.BackendConfigPolicyGVK.GroupKind(): {
+						{PolicyIr: &BackendConfigPolicyIR{}},
+						{PolicyIr: &BackendConfigPolicyIR{localityFailover: translateLocalityFailover(tt.localityFailover)}},
+					},
+				},
+			}
+			localityFailover := attachedLocalityFailover(&policyBackend)
+			require.NotNil(t, localityFailover)
+
+			cla := endpoints.PrioritizeEndpoints(nil, ucc, endpoints.EndpointsInputs{
+				EndpointsForBackend: *eps,
+				PriorityInfo:        localityFailover.priorityInfo(),
+			})
+
+			priorities := map[string]uint32{}
+			for _, localityEps := range cla.GetEndpoints() {
+				for _, lbEp := range localityEps.GetLbEndpoints() {
+					priorities[lbEp.GetEndpoint().GetAddress().GetSocketAddress().GetAddress()] = localityEps.GetPriority()
+				}
+			}
+			assert.Equal(t, tt.wantPriorities, priorities)
+			assert.Equal(t, tt.wantOverprovisioningFactor, cla.GetPolicy().GetOverprovisioningFactor().GetValue())
+		})
+	}
+}
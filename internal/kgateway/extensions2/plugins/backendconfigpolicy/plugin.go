@@ -2,6 +2,7 @@ package backendconfigpolicy
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
@@ -44,6 +45,7 @@ type BackendConfigPolicyIR struct {
 	http2ProtocolOptions          *envoycorev3.Http2ProtocolOptions
 	tlsConfig                     *envoytlsv3.UpstreamTlsContext
 	loadBalancerConfig            *LoadBalancerConfigIR
+	localityFailover              *LocalityFailoverIR
 	healthCheck                   *envoycorev3.HealthCheck
 	outlierDetection              *envoyclusterv3.OutlierDetection
 }
@@ -100,6 +102,12 @@ func (d *BackendConfigPolicyIR) Equals(other any) bool {
 		return false
 	}
 
+	if !cmputils.CompareWithNils(d.localityFailover, d2.localityFailover, func(a, b *LocalityFailoverIR) bool {
+		return a.Equals(b)
+	}) {
+		return false
+	}
+
 	if !proto.Equal(d.healthCheck, d2.healthCheck) {
 		return false
 	}
@@ -152,11 +160,12 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, v
 	return sdk.Plugin{
 		ContributesPolicies: map[schema.GroupKind]sdk.PolicyPlugin{
 			wellknown.BackendConfigPolicyGVK.GroupKind(): {
-				Name:              "BackendConfigPolicy",
-				Policies:          backendConfigPolicyCol,
-				ProcessBackend:    processBackend,
-				GetPolicyStatus:   getPolicyStatusFn(commoncol.CrudClient),
-				PatchPolicyStatus: patchPolicyStatusFn(commoncol.CrudClient),
+				Name:                      "BackendConfigPolicy",
+				Policies:                  backendConfigPolicyCol,
+				ProcessBackend:            processBackend,
+				PerClientProcessEndpoints: processEndpointsFn(commoncol),
+				GetPolicyStatus:           getPolicyStatusFn(commoncol.CrudClient),
+				PatchPolicyStatus:         patchPolicyStatusFn(commoncol.CrudClient),
 			},
 		},
 	}
@@ -245,6 +254,10 @@ func translate(
 	}
 
 	if pol.Spec.TLS != nil {
+		// the default SDS cluster is only defined in the bootstrap of the proxy when the Istio integration is enabled
+		if sds := pol.Spec.TLS.SDS; sds != nil && sds.ClusterName == nil && !commoncol.Settings.EnableIstioIntegration {
+			errs = append(errs, errors.New("tls.sds.clusterName is required when the Istio integration is disabled"))
+		}
 		tlsConfig, err := translateTLSConfig(NewDefaultSecretGetter(commoncol.Secrets, krtctx), pol.Spec.TLS, pol.Namespace)
 		if err != nil {
 			errs = append(errs, err)
@@ -260,6 +273,10 @@ func translate(
 		ir.loadBalancerConfig = loadBalancerConfig
 	}
 
+	if pol.Spec.LocalityFailover != nil {
+		ir.localityFailover = translateLocalityFailover(pol.Spec.LocalityFailover)
+	}
+
 	if pol.Spec.HealthCheck != nil {
 		ir.healthCheck = translateHealthCheck(pol.Spec.HealthCheck)
 	}
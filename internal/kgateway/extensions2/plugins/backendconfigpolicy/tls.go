@@ -19,6 +19,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 // SecretGetter defines the interface for retrieving secrets
@@ -44,6 +45,10 @@ func (g *DefaultSecretGetter) GetSecret(name, namespace string) (*ir.Secret, err
 }
 
 func buildTLSContext(tlsConfig *v1alpha1.TLS, secretGetter SecretGetter, namespace string, tlsContext *envoytlsv3.CommonTlsContext) error {
+	if tlsConfig.SDS != nil {
+		return buildSDSContext(tlsConfig, tlsContext)
+	}
+
 	// Extract TLS data from config
 	tlsData, err := extractTLSData(tlsConfig, secretGetter, namespace)
 	if err != nil {
@@ -63,6 +68,67 @@ func buildTLSContext(tlsConfig *v1alpha1.TLS, secretGetter SecretGetter, namespa
 	return buildValidationContext(tlsData, tlsConfig, tlsContext)
 }
 
+// buildSDSContext configures the client certificate and the validation context to be fetched from an SDS server
+// instead of being inlined in the cluster config.
+func buildSDSContext(tlsConfig *v1alpha1.TLS, tlsContext *envoytlsv3.CommonTlsContext) error {
+	sds := tlsConfig.SDS
+	sdsConfig := sdsConfigSource(ptr.Deref(sds.ClusterName, wellknown.SdsClusterName))
+
+	if !ptr.Deref(tlsConfig.SimpleTLS, false) {
+		tlsContext.TlsCertificateSdsSecretConfigs = []*envoytlsv3.SdsSecretConfig{
+			{
+				Name:      sds.CertificateSecretName,
+				SdsConfig: sdsConfig,
+			},
+		}
+	}
+
+	// the backend's certificate is always validated, insecureSkipVerify is the only way to skip it
+	if sds.ValidationContextSecretName == "" {
+		return errors.New("sds.validationContextSecretName is required to validate the backend's certificate")
+	}
+
+	validationSdsConfig := &envoytlsv3.SdsSecretConfig{
+		Name:      sds.ValidationContextSecretName,
+		SdsConfig: sdsConfig,
+	}
+	sanMatchers := verifySanListToTypedMatchSanList(tlsConfig.VerifySubjectAltNames)
+	if len(sanMatchers) == 0 {
+		tlsContext.ValidationContextType = &envoytlsv3.CommonTlsContext_ValidationContextSdsSecretConfig{
+			ValidationContextSdsSecretConfig: validationSdsConfig,
+		}
+		return nil
+	}
+	tlsContext.ValidationContextType = &envoytlsv3.CommonTlsContext_CombinedValidationContext{
+		CombinedValidationContext: &envoytlsv3.CommonTlsContext_CombinedCertificateValidationContext{
+			DefaultValidationContext: &envoytlsv3.CertificateValidationContext{
+				MatchTypedSubjectAltNames: sanMatchers,
+			},
+			ValidationContextSdsSecretConfig: validationSdsConfig,
+		},
+	}
+	return nil
+}
+
+func sdsConfigSource(clusterName string) *envoycorev3.ConfigSource {
+	return &envoycorev3.ConfigSource{
+		ResourceApiVersion: envoycorev3.ApiVersion_V3,
+		ConfigSourceSpecifier: &envoycorev3.ConfigSource_ApiConfigSource{
+			ApiConfigSource: &envoycorev3.ApiConfigSource{
+				ApiType:             envoycorev3.ApiConfigSource_GRPC,
+				TransportApiVersion: envoycorev3.ApiVersion_V3,
+				GrpcServices: []*envoycorev3.GrpcService{
+					{
+						TargetSpecifier: &envoycorev3.GrpcService_EnvoyGrpc_{
+							EnvoyGrpc: &envoycorev3.GrpcService_EnvoyGrpc{ClusterName: clusterName},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 type tlsData struct {
 	certChain        string
 	privateKey       string
@@ -458,6 +458,68 @@ func TestTranslateTLSConfig(t *testing.T) {
 				Sni: "test.example.com",
 			},
 		},
+		{
+			name: "TLS config with sds client certificate and validation context",
+			tlsConfig: &v1alpha1.TLS{
+				SDS: &v1alpha1.TLSSDS{
+					CertificateSecretName:       "client-cert",
+					ValidationContextSecretName: "root-ca",
+					ClusterName:                 ptr.To("custom_sds"),
+				},
+			},
+			expected: &envoytlsv3.UpstreamTlsContext{
+				CommonTlsContext: &envoytlsv3.CommonTlsContext{
+					TlsCertificateSdsSecretConfigs: []*envoytlsv3.SdsSecretConfig{{
+						Name:      "client-cert",
+						SdsConfig: expectedSdsConfig("custom_sds"),
+					}},
+					ValidationContextType: &envoytlsv3.CommonTlsContext_ValidationContextSdsSecretConfig{
+						ValidationContextSdsSecretConfig: &envoytlsv3.SdsSecretConfig{
+							Name:      "root-ca",
+							SdsConfig: expectedSdsConfig("custom_sds"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TLS config with sds validation context and san",
+			tlsConfig: &v1alpha1.TLS{
+				SDS: &v1alpha1.TLSSDS{
+					CertificateSecretName:       "client-cert",
+					ValidationContextSecretName: "root-ca",
+				},
+				SimpleTLS:             ptr.To(true),
+				VerifySubjectAltNames: []string{"test.example.com"},
+			},
+			expected: &envoytlsv3.UpstreamTlsContext{
+				CommonTlsContext: &envoytlsv3.CommonTlsContext{
+					ValidationContextType: &envoytlsv3.CommonTlsContext_CombinedValidationContext{
+						CombinedValidationContext: &envoytlsv3.CommonTlsContext_CombinedCertificateValidationContext{
+							DefaultValidationContext: &envoytlsv3.CertificateValidationContext{
+								MatchTypedSubjectAltNames: []*envoytlsv3.SubjectAltNameMatcher{
+									{SanType: envoytlsv3.SubjectAltNameMatcher_DNS, Matcher: &envoymatcher.StringMatcher{MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: "test.example.com"}}},
+								},
+							},
+							ValidationContextSdsSecretConfig: &envoytlsv3.SdsSecretConfig{
+								Name:      "root-ca",
+								SdsConfig: expectedSdsConfig("gateway_proxy_sds"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "should error with sds and no validation context",
+			tlsConfig: &v1alpha1.TLS{
+				SDS: &v1alpha1.TLSSDS{
+					CertificateSecretName: "client-cert",
+				},
+				VerifySubjectAltNames: []string{"test.example.com"},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -486,6 +548,23 @@ func TestTranslateTLSConfig(t *testing.T) {
 	}
 }
 
+func expectedSdsConfig(clusterName string) *envoycorev3.ConfigSource {
+	return &envoycorev3.ConfigSource{
+		ResourceApiVersion: envoycorev3.ApiVersion_V3,
+		ConfigSourceSpecifier: &envoycorev3.ConfigSource_ApiConfigSource{
+			ApiConfigSource: &envoycorev3.ApiConfigSource{
+				ApiType:             envoycorev3.ApiConfigSource_GRPC,
+				TransportApiVersion: envoycorev3.ApiVersion_V3,
+				GrpcServices: []*envoycorev3.GrpcService{{
+					TargetSpecifier: &envoycorev3.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &envoycorev3.GrpcService_EnvoyGrpc{ClusterName: clusterName},
+					},
+				}},
+			},
+		},
+	}
+}
+
 func TestVerifySanListToTypedMatchSanList(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,184 @@
+package backendtrafficpolicy
+
+import (
+	"context"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	stateful_sessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
+	"google.golang.org/protobuf/proto"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/kubetypes"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
+)
+
+var backendTrafficPolicyGroupKind = wellknown.XBackendTrafficPolicyGVK.GroupKind()
+
+// backendTrafficPolicy is the IR of the Gateway API XBackendTrafficPolicy.
+// The retry budget is applied to the clusters of the targeted backends, while session persistence
+// is applied to the routes to the targeted backends as it is implemented by an HTTP filter.
+//
+// Precedence:
+//   - BackendConfigPolicy configures neither retry budgets nor session persistence, so both policies can target
+//     the same backend. The load balancer configured by a BackendConfigPolicy picks the host of the first request,
+//     and session persistence then pins the requests of the session to that host while it is healthy.
+//   - The session persistence of an HTTPRoute rule takes precedence over the one of a policy on its backend.
+//   - When several XBackendTrafficPolicies target the same backend, the oldest one wins.
+//   - Agentgateway supports neither feature and reports the policy as not accepted.
+type backendTrafficPolicy struct {
+	ct                 time.Time
+	retryBudget        *envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget
+	sessionPersistence *stateful_sessionv3.StatefulSessionPerRoute
+}
+
+var _ ir.PolicyIR = &backendTrafficPolicy{}
+
+func (d *backendTrafficPolicy) CreationTime() time.Time {
+	return d.ct
+}
+
+func (d *backendTrafficPolicy) Equals(in any) bool {
+	d2, ok := in.(*backendTrafficPolicy)
+	if !ok {
+		return false
+	}
+	if !d.ct.Equal(d2.ct) {
+		return false
+	}
+	if !proto.Equal(d.retryBudget, d2.retryBudget) {
+		return false
+	}
+	return proto.Equal(d.sessionPersistence, d2.sessionPersistence)
+}
+
+func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sdk.Plugin {
+	inf := kclient.NewDelayedInformer[*gwxv1a1.XBackendTrafficPolicy](
+		commoncol.Client, wellknown.XBackendTrafficPolicyGVR, kubetypes.StandardInformer,
+		kclient.Filter{ObjectFilter: commoncol.Client.ObjectFilter()},
+	)
+	col := krt.WrapClient(inf, commoncol.KrtOpts.ToOptions("XBackendTrafficPolicy")...)
+	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, i *gwxv1a1.XBackendTrafficPolicy) *ir.PolicyWrapper {
+		return &ir.PolicyWrapper{
+			ObjectSource: ir.ObjectSource{
+				Group:     backendTrafficPolicyGroupKind.Group,
+				Kind:      backendTrafficPolicyGroupKind.Kind,
+				Namespace: i.Namespace,
+				Name:      i.Name,
+			},
+			Policy:     i,
+			PolicyIR:   translate(i),
+			TargetRefs: pluginsdkutils.TargetRefsToPolicyRefsV1(i.Spec.TargetRefs),
+		}
+	}, commoncol.KrtOpts.ToOptions("XBackendTrafficPolicyIRs")...)
+
+	return sdk.Plugin{
+		ContributesPolicies: map[schema.GroupKind]sdk.PolicyPlugin{
+			backendTrafficPolicyGroupKind: {
+				Name:                         "XBackendTrafficPolicy",
+				Policies:                     policyCol,
+				ProcessBackend:               processBackend,
+				NewGatewayTranslationPass:    NewGatewayTranslationPass,
+				ApplyBackendPoliciesToRoutes: true,
+				GetPolicyStatus:              getPolicyStatusFn(commoncol.CrudClient),
+				PatchPolicyStatus:            patchPolicyStatusFn(commoncol.CrudClient),
+			},
+		},
+	}
+}
+
+func translate(pol *gwxv1a1.XBackendTrafficPolicy) *backendTrafficPolicy {
+	return &backendTrafficPolicy{
+		ct:                 pol.CreationTimestamp.Time,
+		retryBudget:        translateRetryConstraint(pol.Spec.RetryConstraint),
+		sessionPersistence: krtcollections.ConvertSessionPersistence(pol.Spec.SessionPersistence),
+	}
+}
+
+// processBackend applies the retry budget to the default priority circuit breaker thresholds of the cluster.
+// Policies are processed from the oldest to the newest, and the oldest policy wins on conflict as per
+// the Gateway API conflict resolution rules, so an existing retry budget is never overwritten.
+func processBackend(_ context.Context, polir ir.PolicyIR, _ ir.BackendObjectIR, out *envoyclusterv3.Cluster) {
+	pol, ok := polir.(*backendTrafficPolicy)
+	if !ok || pol.retryBudget == nil {
+		return
+	}
+
+	if out.GetCircuitBreakers() == nil {
+		out.CircuitBreakers = &envoyclusterv3.CircuitBreakers{}
+	}
+	for _, thresholds := range out.GetCircuitBreakers().GetThresholds() {
+		if thresholds.GetPriority() != envoycorev3.RoutingPriority_DEFAULT {
+			continue
+		}
+		if thresholds.GetRetryBudget() == nil {
+			thresholds.RetryBudget = proto.Clone(pol.retryBudget).(*envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget)
+		}
+		return
+	}
+	out.CircuitBreakers.Thresholds = append(out.GetCircuitBreakers().GetThresholds(), &envoyclusterv3.CircuitBreakers_Thresholds{
+		RetryBudget: proto.Clone(pol.retryBudget).(*envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget),
+	})
+}
+
+type backendTrafficPolicyGwPass struct {
+	ir.UnimplementedProxyTranslationPass
+	reporter            reporter.Reporter
+	needStatefulSession map[string]bool
+}
+
+var _ ir.ProxyTranslationPass = &backendTrafficPolicyGwPass{}
+
+func NewGatewayTranslationPass(tctx ir.GwTranslationCtx, reporter reporter.Reporter) ir.ProxyTranslationPass {
+	return &backendTrafficPolicyGwPass{
+		reporter:            reporter,
+		needStatefulSession: map[string]bool{},
+	}
+}
+
+func (p *backendTrafficPolicyGwPass) Name() string {
+	return "xbackendtrafficpolicies"
+}
+
+// ApplyForRouteBackend applies the session persistence of a policy attached to the backend object of the route backend.
+// The session persistence configured on the route rule takes precedence, and so does the one of an older policy
+// attached to the same backend.
+func (p *backendTrafficPolicyGwPass) ApplyForRouteBackend(policy ir.PolicyIR, pCtx *ir.RouteBackendContext) error {
+	pol, ok := policy.(*backendTrafficPolicy)
+	if !ok || pol.sessionPersistence == nil {
+		return nil
+	}
+	// the session persistence of the rule is set on the route, which a weighted cluster would override
+	if krtcollections.RuleHasSessionPersistence(pCtx.In.AttachedPolicies) ||
+		pCtx.TypedFilterConfig.GetTypedConfig(krtcollections.StatefulSessionFilterName) != nil {
+		return nil
+	}
+	pCtx.TypedFilterConfig.AddTypedConfig(krtcollections.StatefulSessionFilterName, pol.sessionPersistence)
+	p.needStatefulSession[pCtx.FilterChainName] = true
+	return nil
+}
+
+// HttpFilters adds the same disabled stateful session filter as the one added for route rules with
+// session persistence, so the two are deduplicated when both are needed on the filter chain.
+func (p *backendTrafficPolicyGwPass) HttpFilters(fcc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	if !p.needStatefulSession[fcc.FilterChainName] {
+		return nil, nil
+	}
+	stagedFilter, err := plugins.NewStagedFilter(krtcollections.StatefulSessionFilterName, &stateful_sessionv3.StatefulSession{}, plugins.DuringStage(plugins.AcceptedStage))
+	if err != nil {
+		return nil, err
+	}
+	stagedFilter.Filter.Disabled = true
+	return []plugins.StagedHttpFilter{stagedFilter}, nil
+}
@@ -0,0 +1,40 @@
+package backendtrafficpolicy
+
+import (
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+)
+
+const (
+	// defaultBudgetPercent is the default percent of the active requests that may be retries, as per GEP-3388
+	defaultBudgetPercent = 20
+	// defaultMinRetryRateCount is the default number of retries that are always allowed, as per GEP-3388
+	defaultMinRetryRateCount = 10
+)
+
+// translateRetryConstraint translates the retry constraint to an Envoy retry budget.
+// Envoy budgets retries against the number of active requests rather than over a time window, so:
+//   - budget.percent is the percent of the active requests that may be retries, and budget.interval is ignored.
+//   - minRetryRate.count is the number of concurrent retries that are always allowed, and minRetryRate.interval is ignored.
+func translateRetryConstraint(in *gwxv1a1.RetryConstraint) *envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget {
+	if in == nil {
+		return nil
+	}
+
+	percent := defaultBudgetPercent
+	if in.Budget != nil {
+		percent = ptr.Deref(in.Budget.Percent, defaultBudgetPercent)
+	}
+	minRetries := defaultMinRetryRateCount
+	if in.MinRetryRate != nil {
+		minRetries = ptr.Deref(in.MinRetryRate.Count, defaultMinRetryRateCount)
+	}
+
+	return &envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget{
+		BudgetPercent:       &typev3.Percent{Value: float64(percent)},
+		MinRetryConcurrency: wrapperspb.UInt32(uint32(minRetries)), //nolint:gosec // G115: CRD validation ensures 1 <= count <= 1000000
+	}
+}
@@ -0,0 +1,87 @@
+package backendtrafficpolicy
+
+import (
+	"context"
+	"testing"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestTranslateRetryConstraint(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *gwxv1a1.RetryConstraint
+		want *envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget
+	}{
+		{
+			name: "nil retry constraint",
+		},
+		{
+			name: "defaults",
+			in:   &gwxv1a1.RetryConstraint{},
+			want: &envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget{
+				BudgetPercent:       &typev3.Percent{Value: 20},
+				MinRetryConcurrency: wrapperspb.UInt32(10),
+			},
+		},
+		{
+			name: "budget and min retry rate",
+			in: &gwxv1a1.RetryConstraint{
+				Budget: &gwxv1a1.BudgetDetails{
+					Percent:  ptr.To(30),
+					Interval: ptr.To(gwv1.Duration("10s")),
+				},
+				MinRetryRate: &gwxv1a1.RequestRate{
+					Count:    ptr.To(5),
+					Interval: ptr.To(gwv1.Duration("1s")),
+				},
+			},
+			want: &envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget{
+				BudgetPercent:       &typev3.Percent{Value: 30},
+				MinRetryConcurrency: wrapperspb.UInt32(5),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translateRetryConstraint(tt.in)
+			assert.True(t, proto.Equal(tt.want, got), "got %v, want %v", got, tt.want)
+		})
+	}
+}
+
+func TestProcessBackendKeepsOldestRetryBudget(t *testing.T) {
+	older := &backendTrafficPolicy{retryBudget: translateRetryConstraint(&gwxv1a1.RetryConstraint{
+		Budget: &gwxv1a1.BudgetDetails{Percent: ptr.To(30)},
+	})}
+	newer := &backendTrafficPolicy{retryBudget: translateRetryConstraint(&gwxv1a1.RetryConstraint{
+		Budget: &gwxv1a1.BudgetDetails{Percent: ptr.To(50)},
+	})}
+
+	out := &envoyclusterv3.Cluster{
+		CircuitBreakers: &envoyclusterv3.CircuitBreakers{
+			Thresholds: []*envoyclusterv3.CircuitBreakers_Thresholds{
+				{Priority: envoycorev3.RoutingPriority_HIGH},
+				{MaxConnections: wrapperspb.UInt32(100)},
+			},
+		},
+	}
+	processBackend(context.Background(), older, ir.BackendObjectIR{}, out)
+	processBackend(context.Background(), newer, ir.BackendObjectIR{}, out)
+
+	thresholds := out.GetCircuitBreakers().GetThresholds()
+	assert.Len(t, thresholds, 2)
+	assert.Nil(t, thresholds[0].GetRetryBudget())
+	assert.Equal(t, uint32(100), thresholds[1].GetMaxConnections().GetValue())
+	assert.True(t, proto.Equal(older.retryBudget, thresholds[1].GetRetryBudget()))
+}
@@ -0,0 +1,44 @@
+package backendtrafficpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+)
+
+func getPolicyStatusFn(
+	cl client.Client,
+) sdk.GetPolicyStatusFn {
+	return func(ctx context.Context, nn types.NamespacedName) (gwv1.PolicyStatus, error) {
+		res := gwxv1a1.XBackendTrafficPolicy{}
+		err := cl.Get(ctx, nn, &res)
+		if err != nil {
+			return gwv1.PolicyStatus{}, err
+		}
+		return res.Status, nil
+	}
+}
+
+func patchPolicyStatusFn(
+	cl client.Client,
+) sdk.PatchPolicyStatusFn {
+	return func(ctx context.Context, nn types.NamespacedName, policyStatus gwv1.PolicyStatus) error {
+		res := gwxv1a1.XBackendTrafficPolicy{}
+		err := cl.Get(ctx, nn, &res)
+		if err != nil {
+			return err
+		}
+
+		res.Status = policyStatus
+		if err := cl.Status().Patch(ctx, &res, client.Merge); err != nil {
+			return fmt.Errorf("error updating status for XBackendTrafficPolicy %s: %w", nn.String(), err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,292 @@
+package destrule
+
+import (
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoycommonv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/common/v3"
+	envoymaglevv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/maglev/v3"
+	envoyringhashv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/ring_hash/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/api/networking/v1alpha3"
+
+	translatorutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+)
+
+// applyConnectionLimits translates the DestinationRule connection pool limits to the default priority circuit breaker
+// thresholds of the cluster. Other settings of the thresholds, such as a retry budget, are kept.
+func applyConnectionLimits(connectionPool *v1alpha3.ConnectionPoolSettings, outCluster *envoyclusterv3.Cluster) {
+	var maxConnections, maxPendingRequests, maxRequests, maxRetries *wrapperspb.UInt32Value
+	if v := connectionPool.GetTcp().GetMaxConnections(); v > 0 {
+		maxConnections = &wrapperspb.UInt32Value{Value: uint32(v)} //nolint:gosec // G115: checked to be positive
+	}
+	if v := connectionPool.GetHttp().GetHttp1MaxPendingRequests(); v > 0 {
+		maxPendingRequests = &wrapperspb.UInt32Value{Value: uint32(v)} //nolint:gosec // G115: checked to be positive
+	}
+	if v := connectionPool.GetHttp().GetHttp2MaxRequests(); v > 0 {
+		maxRequests = &wrapperspb.UInt32Value{Value: uint32(v)} //nolint:gosec // G115: checked to be positive
+	}
+	if v := connectionPool.GetHttp().GetMaxRetries(); v > 0 {
+		maxRetries = &wrapperspb.UInt32Value{Value: uint32(v)} //nolint:gosec // G115: checked to be positive
+	}
+	if maxConnections == nil && maxPendingRequests == nil && maxRequests == nil && maxRetries == nil {
+		return
+	}
+
+	if outCluster.GetCircuitBreakers() == nil {
+		outCluster.CircuitBreakers = &envoyclusterv3.CircuitBreakers{}
+	}
+	var thresholds *envoyclusterv3.CircuitBreakers_Thresholds
+	for _, t := range outCluster.GetCircuitBreakers().GetThresholds() {
+		if t.GetPriority() == envoycorev3.RoutingPriority_DEFAULT {
+			thresholds = t
+			break
+		}
+	}
+	if thresholds == nil {
+		thresholds = &envoyclusterv3.CircuitBreakers_Thresholds{}
+		outCluster.CircuitBreakers.Thresholds = append(outCluster.GetCircuitBreakers().GetThresholds(), thresholds)
+	}
+	if maxConnections != nil {
+		thresholds.MaxConnections = maxConnections
+	}
+	if maxPendingRequests != nil {
+		thresholds.MaxPendingRequests = maxPendingRequests
+	}
+	if maxRequests != nil {
+		thresholds.MaxRequests = maxRequests
+	}
+	if maxRetries != nil {
+		thresholds.MaxRetries = maxRetries
+	}
+}
+
+// applyConnectionSettings translates the DestinationRule connection pool timeouts, TCP keepalive
+// and per connection limits to the cluster.
+func applyConnectionSettings(connectionPool *v1alpha3.ConnectionPoolSettings, outCluster *envoyclusterv3.Cluster) {
+	tcpSettings := connectionPool.GetTcp()
+	if connectTimeout := tcpSettings.GetConnectTimeout(); connectTimeout != nil {
+		outCluster.ConnectTimeout = durationpb.New(connectTimeout.AsDuration())
+	}
+
+	// Translate TCP keepalive settings
+	if tcpKeepalive := tcpSettings.GetTcpKeepalive(); tcpKeepalive != nil {
+		if outCluster.GetUpstreamConnectionOptions() == nil {
+			outCluster.UpstreamConnectionOptions = &envoyclusterv3.UpstreamConnectionOptions{}
+		}
+		if outCluster.GetUpstreamConnectionOptions().GetTcpKeepalive() == nil {
+			outCluster.GetUpstreamConnectionOptions().TcpKeepalive = &envoycorev3.TcpKeepalive{}
+		}
+		if tcpKeepalive.GetTime() != nil {
+			outCluster.GetUpstreamConnectionOptions().GetTcpKeepalive().KeepaliveTime = &wrapperspb.UInt32Value{Value: uint32(tcpKeepalive.GetTime().GetSeconds())} //nolint:gosec // G115: TCP keepalive time in seconds, reasonable range for uint32
+		}
+		if tcpKeepalive.GetInterval() != nil {
+			outCluster.GetUpstreamConnectionOptions().GetTcpKeepalive().KeepaliveInterval = &wrapperspb.UInt32Value{Value: uint32(tcpKeepalive.GetInterval().GetSeconds())} //nolint:gosec // G115: TCP keepalive interval in seconds, reasonable range for uint32
+		}
+		if tcpKeepalive.GetProbes() > 0 {
+			outCluster.GetUpstreamConnectionOptions().GetTcpKeepalive().KeepaliveProbes = &wrapperspb.UInt32Value{Value: uint32(tcpKeepalive.GetProbes())} //nolint:gosec // G115: TCP keepalive probe count, reasonable range for uint32
+		}
+	}
+
+	httpSettings := connectionPool.GetHttp()
+	commonHttpProtocolOptions := &envoycorev3.HttpProtocolOptions{}
+	if idleTimeout := httpSettings.GetIdleTimeout(); idleTimeout != nil {
+		commonHttpProtocolOptions.IdleTimeout = durationpb.New(idleTimeout.AsDuration())
+	}
+	if maxConnectionDuration := tcpSettings.GetMaxConnectionDuration(); maxConnectionDuration != nil {
+		commonHttpProtocolOptions.MaxConnectionDuration = durationpb.New(maxConnectionDuration.AsDuration())
+	}
+	if v := httpSettings.GetMaxRequestsPerConnection(); v > 0 {
+		commonHttpProtocolOptions.MaxRequestsPerConnection = &wrapperspb.UInt32Value{Value: uint32(v)} //nolint:gosec // G115: checked to be positive
+	}
+	if commonHttpProtocolOptions.GetIdleTimeout() == nil && commonHttpProtocolOptions.GetMaxConnectionDuration() == nil &&
+		commonHttpProtocolOptions.GetMaxRequestsPerConnection() == nil {
+		return
+	}
+	if err := translatorutils.MutateHttpOptions(outCluster, func(opts *envoy_upstreams_v3.HttpProtocolOptions) {
+		opts.CommonHttpProtocolOptions = commonHttpProtocolOptions
+		if opts.GetUpstreamProtocolOptions() == nil {
+			// Envoy requires UpstreamProtocolOptions if CommonHttpProtocolOptions is set.
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+				ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+					ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{},
+				},
+			}
+		}
+	}); err != nil {
+		logger.Error("failed to apply DestinationRule http protocol options", "cluster", outCluster.GetName(), "error", err)
+	}
+}
+
+// applyLoadBalancer translates the DestinationRule load balancer algorithm to the cluster.
+// The consistent hash key is configured on the cluster load balancing policy, so that it applies
+// to every route to the backend.
+func applyLoadBalancer(loadBalancer *v1alpha3.LoadBalancerSettings, outCluster *envoyclusterv3.Cluster) error {
+	if consistentHash := loadBalancer.GetConsistentHash(); consistentHash != nil {
+		policy, err := consistentHashLoadBalancingPolicy(consistentHash)
+		if err != nil {
+			return err
+		}
+		outCluster.LoadBalancingPolicy = policy
+		return nil
+	}
+
+	switch loadBalancer.GetSimple() {
+	case v1alpha3.LoadBalancerSettings_ROUND_ROBIN:
+		outCluster.LbPolicy = envoyclusterv3.Cluster_ROUND_ROBIN
+	// Istio translates LEAST_CONN to LEAST_REQUEST, as Envoy has no least connection load balancer.
+	case v1alpha3.LoadBalancerSettings_LEAST_REQUEST, v1alpha3.LoadBalancerSettings_LEAST_CONN:
+		outCluster.LbPolicy = envoyclusterv3.Cluster_LEAST_REQUEST
+	case v1alpha3.LoadBalancerSettings_RANDOM:
+		outCluster.LbPolicy = envoyclusterv3.Cluster_RANDOM
+	}
+	return nil
+}
+
+func consistentHashLoadBalancingPolicy(consistentHash *v1alpha3.LoadBalancerSettings_ConsistentHashLB) (*envoyclusterv3.LoadBalancingPolicy, error) {
+	hashingLBConfig := &envoycommonv3.ConsistentHashingLbConfig{}
+	if hashPolicy := consistentHashPolicy(consistentHash); hashPolicy != nil {
+		hashingLBConfig.HashPolicy = []*envoyroutev3.RouteAction_HashPolicy{hashPolicy}
+	}
+
+	name := "envoy.load_balancing_policies.ring_hash"
+	var typedConfig proto.Message
+	if maglev := consistentHash.GetMaglev(); maglev != nil {
+		name = "envoy.load_balancing_policies.maglev"
+		m := &envoymaglevv3.Maglev{ConsistentHashingLbConfig: hashingLBConfig}
+		if maglev.GetTableSize() > 0 {
+			m.TableSize = &wrapperspb.UInt64Value{Value: maglev.GetTableSize()}
+		}
+		typedConfig = m
+	} else {
+		ringHash := &envoyringhashv3.RingHash{ConsistentHashingLbConfig: hashingLBConfig}
+		minimumRingSize := consistentHash.GetRingHash().GetMinimumRingSize()
+		if minimumRingSize == 0 {
+			// fall back to the deprecated field
+			minimumRingSize = consistentHash.GetMinimumRingSize()
+		}
+		if minimumRingSize > 0 {
+			ringHash.MinimumRingSize = &wrapperspb.UInt64Value{Value: minimumRingSize}
+		}
+		typedConfig = ringHash
+	}
+
+	typedConfigAny, err := utils.MessageToAny(typedConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &envoyclusterv3.LoadBalancingPolicy{
+		Policies: []*envoyclusterv3.LoadBalancingPolicy_Policy{{
+			TypedExtensionConfig: &envoycorev3.TypedExtensionConfig{
+				Name:        name,
+				TypedConfig: typedConfigAny,
+			},
+		}},
+	}, nil
+}
+
+func consistentHashPolicy(consistentHash *v1alpha3.LoadBalancerSettings_ConsistentHashLB) *envoyroutev3.RouteAction_HashPolicy {
+	switch {
+	case consistentHash.GetHttpHeaderName() != "":
+		return &envoyroutev3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoyroutev3.RouteAction_HashPolicy_Header_{
+				Header: &envoyroutev3.RouteAction_HashPolicy_Header{
+					HeaderName: consistentHash.GetHttpHeaderName(),
+				},
+			},
+		}
+	case consistentHash.GetHttpCookie() != nil:
+		cookie := consistentHash.GetHttpCookie()
+		out := &envoyroutev3.RouteAction_HashPolicy_Cookie{
+			Name: cookie.GetName(),
+			Path: cookie.GetPath(),
+		}
+		if cookie.GetTtl() != nil {
+			out.Ttl = durationpb.New(cookie.GetTtl().AsDuration())
+		}
+		for _, attr := range cookie.GetAttributes() {
+			out.Attributes = append(out.Attributes, &envoyroutev3.RouteAction_HashPolicy_CookieAttribute{
+				Name:  attr.GetName(),
+				Value: attr.GetValue(),
+			})
+		}
+		return &envoyroutev3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoyroutev3.RouteAction_HashPolicy_Cookie_{Cookie: out},
+		}
+	case consistentHash.GetUseSourceIp():
+		return &envoyroutev3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoyroutev3.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &envoyroutev3.RouteAction_HashPolicy_ConnectionProperties{
+					SourceIp: true,
+				},
+			},
+		}
+	case consistentHash.GetHttpQueryParameterName() != "":
+		return &envoyroutev3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoyroutev3.RouteAction_HashPolicy_QueryParameter_{
+				QueryParameter: &envoyroutev3.RouteAction_HashPolicy_QueryParameter{
+					Name: consistentHash.GetHttpQueryParameterName(),
+				},
+			},
+		}
+	}
+	return nil
+}
+
+// applyOutlierDetection translates the DestinationRule outlier detection to the cluster.
+// As with Istio, locality load balancing is only enabled when outlier detection is configured.
+func applyOutlierDetection(trafficPolicy *v1alpha3.TrafficPolicy, outCluster *envoyclusterv3.Cluster) {
+	outlier := trafficPolicy.GetOutlierDetection()
+	if outlier == nil {
+		return
+	}
+
+	if getLocalityLbSetting(trafficPolicy) != nil {
+		if outCluster.GetCommonLbConfig() == nil {
+			outCluster.CommonLbConfig = &envoyclusterv3.Cluster_CommonLbConfig{}
+		}
+		outCluster.GetCommonLbConfig().LocalityConfigSpecifier = &envoyclusterv3.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
+			LocalityWeightedLbConfig: &envoyclusterv3.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
+		}
+	}
+
+	out := &envoyclusterv3.OutlierDetection{
+		Consecutive_5Xx:  outlier.GetConsecutive_5XxErrors(),
+		Interval:         outlier.GetInterval(),
+		BaseEjectionTime: outlier.GetBaseEjectionTime(),
+	}
+	if e := outlier.GetConsecutiveGatewayErrors(); e != nil {
+		v := e.GetValue()
+		out.ConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: v}
+		if v > 0 {
+			v = 100
+		}
+		out.EnforcingConsecutiveGatewayFailure = &wrapperspb.UInt32Value{Value: v}
+	}
+	if outlier.GetMaxEjectionPercent() > 0 {
+		out.MaxEjectionPercent = &wrapperspb.UInt32Value{Value: uint32(outlier.GetMaxEjectionPercent())} //nolint:gosec // G115: MaxEjectionPercent is a percentage value (0-100), safe for uint32
+	}
+	if outlier.GetSplitExternalLocalOriginErrors() {
+		out.SplitExternalLocalOriginErrors = true
+		if outlier.GetConsecutiveLocalOriginFailures().GetValue() > 0 {
+			out.ConsecutiveLocalOriginFailure = &wrapperspb.UInt32Value{Value: outlier.GetConsecutiveLocalOriginFailures().Value}
+			out.EnforcingConsecutiveLocalOriginFailure = &wrapperspb.UInt32Value{Value: 100}
+		}
+		// SuccessRate based outlier detection should be disabled.
+		out.EnforcingLocalOriginSuccessRate = &wrapperspb.UInt32Value{Value: 0}
+	}
+	minHealthPercent := outlier.GetMinHealthPercent()
+	if minHealthPercent >= 0 {
+		if outCluster.GetCommonLbConfig() == nil {
+			outCluster.CommonLbConfig = &envoyclusterv3.Cluster_CommonLbConfig{}
+		}
+		outCluster.GetCommonLbConfig().HealthyPanicThreshold = &envoy_type_v3.Percent{Value: float64(minHealthPercent)}
+	}
+
+	outCluster.OutlierDetection = out
+}
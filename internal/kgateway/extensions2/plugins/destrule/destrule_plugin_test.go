@@ -0,0 +1,273 @@
+package destrule
+
+import (
+	"testing"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoysubsetv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/subset/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/api/networking/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+
+	eiutils "github.com/kgateway-dev/kgateway/v2/internal/envoyinit/pkg/utils"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/sslutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+func TestApplyConnectionLimits(t *testing.T) {
+	cluster := &envoyclusterv3.Cluster{}
+	applyConnectionLimits(&v1alpha3.ConnectionPoolSettings{
+		Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{MaxConnections: 10},
+		Http: &v1alpha3.ConnectionPoolSettings_HTTPSettings{
+			Http1MaxPendingRequests: 20,
+			Http2MaxRequests:        30,
+			MaxRetries:              3,
+		},
+	}, cluster)
+
+	require.Len(t, cluster.GetCircuitBreakers().GetThresholds(), 1)
+	thresholds := cluster.GetCircuitBreakers().GetThresholds()[0]
+	assert.Equal(t, uint32(10), thresholds.GetMaxConnections().GetValue())
+	assert.Equal(t, uint32(20), thresholds.GetMaxPendingRequests().GetValue())
+	assert.Equal(t, uint32(30), thresholds.GetMaxRequests().GetValue())
+	assert.Equal(t, uint32(3), thresholds.GetMaxRetries().GetValue())
+
+	unset := &envoyclusterv3.Cluster{}
+	applyConnectionLimits(&v1alpha3.ConnectionPoolSettings{}, unset)
+	assert.Nil(t, unset.GetCircuitBreakers())
+
+	// the retry budget of a BackendTrafficPolicy is kept
+	withRetryBudget := &envoyclusterv3.Cluster{
+		CircuitBreakers: &envoyclusterv3.CircuitBreakers{
+			Thresholds: []*envoyclusterv3.CircuitBreakers_Thresholds{{
+				RetryBudget: &envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget{},
+			}},
+		},
+	}
+	applyConnectionLimits(&v1alpha3.ConnectionPoolSettings{
+		Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{MaxConnections: 10},
+	}, withRetryBudget)
+	require.Len(t, withRetryBudget.GetCircuitBreakers().GetThresholds(), 1)
+	assert.NotNil(t, withRetryBudget.GetCircuitBreakers().GetThresholds()[0].GetRetryBudget())
+	assert.Equal(t, uint32(10), withRetryBudget.GetCircuitBreakers().GetThresholds()[0].GetMaxConnections().GetValue())
+}
+
+func TestApplyConnectionSettings(t *testing.T) {
+	cluster := &envoyclusterv3.Cluster{}
+	applyConnectionSettings(&v1alpha3.ConnectionPoolSettings{
+		Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{
+			ConnectTimeout: durationpb.New(5e9),
+			TcpKeepalive: &v1alpha3.ConnectionPoolSettings_TCPSettings_TcpKeepalive{
+				Time:   durationpb.New(60e9),
+				Probes: 4,
+			},
+		},
+		Http: &v1alpha3.ConnectionPoolSettings_HTTPSettings{
+			IdleTimeout:              durationpb.New(30e9),
+			MaxRequestsPerConnection: 100,
+		},
+	}, cluster)
+
+	assert.Equal(t, int64(5), cluster.GetConnectTimeout().GetSeconds())
+	keepalive := cluster.GetUpstreamConnectionOptions().GetTcpKeepalive()
+	assert.Equal(t, uint32(60), keepalive.GetKeepaliveTime().GetValue())
+	assert.Equal(t, uint32(4), keepalive.GetKeepaliveProbes().GetValue())
+	assert.Nil(t, keepalive.GetKeepaliveInterval())
+	assert.Nil(t, cluster.GetOutlierDetection())
+	assert.Contains(t, cluster.GetTypedExtensionProtocolOptions(), "envoy.extensions.upstreams.http.v3.HttpProtocolOptions")
+}
+
+func TestApplyLoadBalancer(t *testing.T) {
+	tests := []struct {
+		name     string
+		simple   v1alpha3.LoadBalancerSettings_SimpleLB
+		expected envoyclusterv3.Cluster_LbPolicy
+	}{
+		{name: "round robin", simple: v1alpha3.LoadBalancerSettings_ROUND_ROBIN, expected: envoyclusterv3.Cluster_ROUND_ROBIN},
+		{name: "least request", simple: v1alpha3.LoadBalancerSettings_LEAST_REQUEST, expected: envoyclusterv3.Cluster_LEAST_REQUEST},
+		{name: "least conn", simple: v1alpha3.LoadBalancerSettings_LEAST_CONN, expected: envoyclusterv3.Cluster_LEAST_REQUEST},
+		{name: "random", simple: v1alpha3.LoadBalancerSettings_RANDOM, expected: envoyclusterv3.Cluster_RANDOM},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &envoyclusterv3.Cluster{}
+			err := applyLoadBalancer(&v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: tt.simple},
+			}, cluster)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cluster.GetLbPolicy())
+		})
+	}
+
+	t.Run("consistent hash", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{}
+		err := applyLoadBalancer(&v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{
+				ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+					HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HttpHeaderName{HttpHeaderName: "x-user"},
+				},
+			},
+		}, cluster)
+		require.NoError(t, err)
+		require.Len(t, cluster.GetLoadBalancingPolicy().GetPolicies(), 1)
+		assert.Equal(t, "envoy.load_balancing_policies.ring_hash", cluster.GetLoadBalancingPolicy().GetPolicies()[0].GetTypedExtensionConfig().GetName())
+	})
+}
+
+func TestApplyTLS(t *testing.T) {
+	getCredential := func(name string) (*ir.Secret, error) {
+		return &ir.Secret{
+			ObjectSource: ir.ObjectSource{Kind: "Secret", Namespace: "ns", Name: name},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:              []byte("cert"),
+				corev1.TLSPrivateKeyKey:        []byte("key"),
+				corev1.ServiceAccountRootCAKey: []byte("ca"),
+			},
+		}, nil
+	}
+
+	t.Run("mutual with credential name", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{
+			TransportSocketMatches: []*envoyclusterv3.Cluster_TransportSocketMatch{{Name: "tlsMode-istio"}},
+		}
+		err := applyTLS(&v1alpha3.ClientTLSSettings{
+			Mode:            v1alpha3.ClientTLSSettings_MUTUAL,
+			CredentialName:  "client-cert",
+			SubjectAltNames: []string{"backend.example.com"},
+			Sni:             "backend.example.com",
+		}, getCredential, cluster)
+		require.NoError(t, err)
+		assert.Nil(t, cluster.GetTransportSocketMatches())
+
+		tlsContext := &envoytlsv3.UpstreamTlsContext{}
+		require.NoError(t, cluster.GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext))
+		assert.Equal(t, "backend.example.com", tlsContext.GetSni())
+		// the secret is served over SDS rather than inlined in the cluster
+		assert.Empty(t, tlsContext.GetCommonTlsContext().GetTlsCertificates())
+		require.Len(t, tlsContext.GetCommonTlsContext().GetTlsCertificateSdsSecretConfigs(), 1)
+		certSds := tlsContext.GetCommonTlsContext().GetTlsCertificateSdsSecretConfigs()[0]
+		assert.Equal(t, "kubernetes://ns/client-cert", certSds.GetName())
+		assert.NotNil(t, certSds.GetSdsConfig().GetAds())
+		validationContext := tlsContext.GetCommonTlsContext().GetCombinedValidationContext()
+		assert.Equal(t, "kubernetes://ns/client-cert:cacert", validationContext.GetValidationContextSdsSecretConfig().GetName())
+		require.Len(t, validationContext.GetDefaultValidationContext().GetMatchTypedSubjectAltNames(), 1)
+		assert.Equal(t, []string{"kubernetes://ns/client-cert", "kubernetes://ns/client-cert:cacert"}, sslutils.UpstreamTLSSdsSecretNames(tlsContext))
+	})
+
+	t.Run("simple without ca verifies the server with the system ca", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{}
+		err := applyTLS(&v1alpha3.ClientTLSSettings{
+			Mode:            v1alpha3.ClientTLSSettings_SIMPLE,
+			SubjectAltNames: []string{"backend.example.com"},
+		}, getCredential, cluster)
+		require.NoError(t, err)
+
+		tlsContext := &envoytlsv3.UpstreamTlsContext{}
+		require.NoError(t, cluster.GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext))
+		validationContext := tlsContext.GetCommonTlsContext().GetCombinedValidationContext()
+		assert.Equal(t, eiutils.SystemCaSecretName, validationContext.GetValidationContextSdsSecretConfig().GetName())
+		require.Len(t, validationContext.GetDefaultValidationContext().GetMatchTypedSubjectAltNames(), 1)
+	})
+
+	t.Run("simple with insecure skip verify", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{}
+		err := applyTLS(&v1alpha3.ClientTLSSettings{
+			Mode:               v1alpha3.ClientTLSSettings_SIMPLE,
+			SubjectAltNames:    []string{"backend.example.com"},
+			InsecureSkipVerify: wrapperspb.Bool(true),
+		}, getCredential, cluster)
+		require.NoError(t, err)
+
+		tlsContext := &envoytlsv3.UpstreamTlsContext{}
+		require.NoError(t, cluster.GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext))
+		assert.Nil(t, tlsContext.GetCommonTlsContext().GetValidationContextType())
+	})
+
+	t.Run("mutual without client certificate", func(t *testing.T) {
+		err := applyTLS(&v1alpha3.ClientTLSSettings{
+			Mode: v1alpha3.ClientTLSSettings_MUTUAL,
+		}, getCredential, &envoyclusterv3.Cluster{})
+		require.Error(t, err)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{
+			TransportSocket:        &envoycorev3.TransportSocket{Name: "tls"},
+			TransportSocketMatches: []*envoyclusterv3.Cluster_TransportSocketMatch{{Name: "tlsMode-istio"}},
+		}
+		require.NoError(t, applyTLS(&v1alpha3.ClientTLSSettings{Mode: v1alpha3.ClientTLSSettings_DISABLE}, getCredential, cluster))
+		// an explicit plaintext transport socket, so that auto mtls is not configured either
+		assert.Equal(t, envoywellknown.TransportSocketRawBuffer, cluster.GetTransportSocket().GetName())
+		assert.Nil(t, cluster.GetTransportSocketMatches())
+	})
+
+	t.Run("istio mutual is left to the istio integration", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{
+			TransportSocketMatches: []*envoyclusterv3.Cluster_TransportSocketMatch{{Name: "tlsMode-istio"}},
+		}
+		require.NoError(t, applyTLS(&v1alpha3.ClientTLSSettings{Mode: v1alpha3.ClientTLSSettings_ISTIO_MUTUAL}, getCredential, cluster))
+		assert.Len(t, cluster.GetTransportSocketMatches(), 1)
+	})
+}
+
+func TestSubsets(t *testing.T) {
+	subsets := []*v1alpha3.Subset{
+		{Name: "v1", Labels: map[string]string{"version": "v1"}},
+		{Name: "v2", Labels: map[string]string{"version": "v2"}},
+		{Name: "all", Labels: map[string]string{"app": "reviews"}},
+	}
+
+	t.Run("endpoints", func(t *testing.T) {
+		v1Ep := ir.EndpointWithMd{
+			LbEndpoint: &envoyendpointv3.LbEndpoint{},
+			EndpointMd: ir.EndpointMetadata{Labels: map[string]string{"app": "reviews", "version": "v1"}},
+		}
+		otherEp := ir.EndpointWithMd{
+			LbEndpoint: &envoyendpointv3.LbEndpoint{},
+			EndpointMd: ir.EndpointMetadata{Labels: map[string]string{"app": "ratings"}},
+		}
+		in := ir.LocalityLbMap{
+			ir.PodLocality{Region: "r1"}: {v1Ep, otherEp},
+		}
+
+		out := subsetEndpoints(subsets, in)
+		eps := out[ir.PodLocality{Region: "r1"}]
+		require.Len(t, eps, 2)
+		names := eps[0].LbEndpoint.GetMetadata().GetFilterMetadata()[wellknown.EnvoyLbMetadataNamespace].GetFields()[wellknown.DestinationRuleSubsetMetadataKey]
+		assert.Equal(t, []any{"v1", "all"}, names.GetListValue().AsSlice())
+		assert.Nil(t, eps[1].LbEndpoint.GetMetadata())
+		// the input endpoints are shared and must not be modified
+		assert.Nil(t, v1Ep.LbEndpoint.GetMetadata())
+	})
+
+	t.Run("cluster without load balancing policy", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{}
+		require.NoError(t, applySubsets(subsets, cluster))
+		assert.Equal(t, envoyclusterv3.Cluster_LbSubsetConfig_NO_FALLBACK, cluster.GetLbSubsetConfig().GetFallbackPolicy())
+		assert.True(t, cluster.GetLbSubsetConfig().GetListAsAny())
+	})
+
+	t.Run("cluster with load balancing policy", func(t *testing.T) {
+		policy := &envoyclusterv3.LoadBalancingPolicy{
+			Policies: []*envoyclusterv3.LoadBalancingPolicy_Policy{{
+				TypedExtensionConfig: &envoycorev3.TypedExtensionConfig{Name: "envoy.load_balancing_policies.maglev"},
+			}},
+		}
+		cluster := &envoyclusterv3.Cluster{LoadBalancingPolicy: policy}
+		require.NoError(t, applySubsets(subsets, cluster))
+		assert.Nil(t, cluster.GetLbSubsetConfig())
+
+		subset := &envoysubsetv3.Subset{}
+		require.NoError(t, cluster.GetLoadBalancingPolicy().GetPolicies()[0].GetTypedExtensionConfig().GetTypedConfig().UnmarshalTo(subset))
+		assert.Equal(t, "envoy.load_balancing_policies.maglev", subset.GetSubsetLbPolicy().GetPolicies()[0].GetTypedExtensionConfig().GetName())
+	})
+}
@@ -0,0 +1,111 @@
+package destrule
+
+import (
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoysubsetv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/subset/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"istio.io/api/networking/v1alpha3"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+// applySubsets configures the cluster to load balance within the DestinationRule subset selected by the route.
+// Each endpoint lists the subsets it belongs to in its metadata (see subsetEndpoints), so that a single
+// subset selector is needed. Requests for a subset that is not defined are not load balanced to
+// any endpoint, as with Istio.
+func applySubsets(subsets []*v1alpha3.Subset, outCluster *envoyclusterv3.Cluster) error {
+	if len(subsets) == 0 {
+		return nil
+	}
+
+	// The legacy subset config is ignored when a load balancing policy is set,
+	// in which case the subset load balancing policy must wrap it instead.
+	if outCluster.GetLoadBalancingPolicy() == nil {
+		outCluster.LbSubsetConfig = &envoyclusterv3.Cluster_LbSubsetConfig{
+			SubsetSelectors: []*envoyclusterv3.Cluster_LbSubsetConfig_LbSubsetSelector{{
+				Keys: []string{wellknown.DestinationRuleSubsetMetadataKey},
+			}},
+			FallbackPolicy: envoyclusterv3.Cluster_LbSubsetConfig_NO_FALLBACK,
+			ListAsAny:      true,
+		}
+		return nil
+	}
+
+	subset := &envoysubsetv3.Subset{
+		SubsetSelectors: []*envoysubsetv3.Subset_LbSubsetSelector{{
+			Keys: []string{wellknown.DestinationRuleSubsetMetadataKey},
+		}},
+		FallbackPolicy: envoysubsetv3.Subset_NO_FALLBACK,
+		ListAsAny:      true,
+		SubsetLbPolicy: outCluster.GetLoadBalancingPolicy(),
+	}
+	subsetAny, err := utils.MessageToAny(subset)
+	if err != nil {
+		return err
+	}
+	outCluster.LoadBalancingPolicy = &envoyclusterv3.LoadBalancingPolicy{
+		Policies: []*envoyclusterv3.LoadBalancingPolicy_Policy{{
+			TypedExtensionConfig: &envoycorev3.TypedExtensionConfig{
+				Name:        "envoy.load_balancing_policies.subset",
+				TypedConfig: subsetAny,
+			},
+		}},
+	}
+	return nil
+}
+
+// subsetEndpoints returns a copy of the endpoints with the names of the DestinationRule subsets
+// each endpoint belongs to in its metadata. The endpoints are not modified as they are shared
+// with other clients.
+func subsetEndpoints(subsets []*v1alpha3.Subset, in ir.LocalityLbMap) ir.LocalityLbMap {
+	out := make(ir.LocalityLbMap, len(in))
+	for locality, eps := range in {
+		outEps := make([]ir.EndpointWithMd, 0, len(eps))
+		for _, ep := range eps {
+			var names []any
+			for _, subset := range subsets {
+				if labels.SelectorFromSet(subset.GetLabels()).Matches(labels.Set(ep.EndpointMd.Labels)) {
+					names = append(names, subset.GetName())
+				}
+			}
+			if len(names) > 0 {
+				ep = ir.EndpointWithMd{
+					LbEndpoint: withSubsetMetadata(ep.LbEndpoint, names),
+					EndpointMd: ep.EndpointMd,
+				}
+			}
+			outEps = append(outEps, ep)
+		}
+		out[locality] = outEps
+	}
+	return out
+}
+
+func withSubsetMetadata(in *envoyendpointv3.LbEndpoint, names []any) *envoyendpointv3.LbEndpoint {
+	value, err := structpb.NewList(names)
+	if err != nil {
+		// should never happen, as the names are strings
+		logger.Error("failed to build DestinationRule subset metadata", "error", err)
+		return in
+	}
+	out := proto.Clone(in).(*envoyendpointv3.LbEndpoint)
+	if out.GetMetadata() == nil {
+		out.Metadata = &envoycorev3.Metadata{}
+	}
+	if out.GetMetadata().GetFilterMetadata() == nil {
+		out.Metadata.FilterMetadata = map[string]*structpb.Struct{}
+	}
+	lbMetadata := out.GetMetadata().GetFilterMetadata()[wellknown.EnvoyLbMetadataNamespace]
+	if lbMetadata == nil {
+		lbMetadata = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+		out.Metadata.FilterMetadata[wellknown.EnvoyLbMetadataNamespace] = lbMetadata
+	}
+	lbMetadata.Fields[wellknown.DestinationRuleSubsetMetadataKey] = structpb.NewListValue(value)
+	return out
+}
@@ -0,0 +1,161 @@
+package destrule
+
+import (
+	"errors"
+	"fmt"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyrawbufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/raw_buffer/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"istio.io/api/networking/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+
+	eiutils "github.com/kgateway-dev/kgateway/v2/internal/envoyinit/pkg/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/sslutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+)
+
+// getCredential returns the secret referenced by the credentialName of a DestinationRule.
+type getCredential func(name string) (*ir.Secret, error)
+
+// applyTLS translates the DestinationRule client TLS settings to the cluster transport socket.
+// ISTIO_MUTUAL is left to the Istio integration, which configures auto mTLS. Any other mode
+// replaces auto mTLS, as with Istio.
+func applyTLS(tls *v1alpha3.ClientTLSSettings, getCredential getCredential, outCluster *envoyclusterv3.Cluster) error {
+	if tls == nil || tls.GetMode() == v1alpha3.ClientTLSSettings_ISTIO_MUTUAL {
+		return nil
+	}
+
+	if tls.GetMode() == v1alpha3.ClientTLSSettings_DISABLE {
+		// An explicit plaintext transport socket, rather than none, so that the Istio integration does not
+		// configure auto mTLS regardless of the order in which the plugins are applied to the cluster.
+		rawBuffer, err := utils.MessageToAny(&envoyrawbufferv3.RawBuffer{})
+		if err != nil {
+			return err
+		}
+		outCluster.TransportSocketMatches = nil
+		outCluster.TransportSocket = &envoycorev3.TransportSocket{
+			Name: envoywellknown.TransportSocketRawBuffer,
+			ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+				TypedConfig: rawBuffer,
+			},
+		}
+		return nil
+	}
+
+	tlsContext, err := translateClientTLSSettings(tls, getCredential)
+	if err != nil {
+		return err
+	}
+	typedConfig, err := utils.MessageToAny(tlsContext)
+	if err != nil {
+		return err
+	}
+	outCluster.TransportSocketMatches = nil
+	outCluster.TransportSocket = &envoycorev3.TransportSocket{
+		Name: envoywellknown.TransportSocketTls,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}
+	return nil
+}
+
+// translateClientTLSSettings translates the DestinationRule client TLS settings to an upstream TLS context.
+// The certificates of the secret referenced by the credentialName are served over SDS by the kgateway xDS server
+// rather than being inlined in the cluster. As with Istio, the server certificate is verified against the system
+// CA certificates when no CA certificates are provided, unless insecureSkipVerify is set.
+func translateClientTLSSettings(tls *v1alpha3.ClientTLSSettings, getCredential getCredential) (*envoytlsv3.UpstreamTlsContext, error) {
+	commonTlsContext := &envoytlsv3.CommonTlsContext{}
+	isMutual := tls.GetMode() == v1alpha3.ClientTLSSettings_MUTUAL
+
+	var (
+		rootCA      *envoycorev3.DataSource
+		caSdsConfig *envoytlsv3.SdsSecretConfig
+	)
+	if tls.GetCredentialName() != "" {
+		secret, err := getCredential(tls.GetCredentialName())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get credential %s: %w", tls.GetCredentialName(), err)
+		}
+		if isMutual {
+			if len(secret.Data[corev1.TLSCertKey]) == 0 || len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+				return nil, errors.New("a client certificate and private key are required for MUTUAL tls mode")
+			}
+			commonTlsContext.TlsCertificateSdsSecretConfigs = []*envoytlsv3.SdsSecretConfig{{
+				Name:      sslutils.KubernetesSdsSecretName(secret.Namespace, secret.Name),
+				SdsConfig: sslutils.AdsConfigSource(),
+			}}
+		}
+		if len(sslutils.SecretCACertificate(secret.Data)) > 0 {
+			caSdsConfig = &envoytlsv3.SdsSecretConfig{
+				Name:      sslutils.KubernetesCASdsSecretName(secret.Namespace, secret.Name),
+				SdsConfig: sslutils.AdsConfigSource(),
+			}
+		}
+	} else {
+		if isMutual {
+			certChain, privateKey := fileDataSource(tls.GetClientCertificate()), fileDataSource(tls.GetPrivateKey())
+			if certChain == nil || privateKey == nil {
+				return nil, errors.New("a client certificate and private key are required for MUTUAL tls mode")
+			}
+			commonTlsContext.TlsCertificates = []*envoytlsv3.TlsCertificate{{
+				CertificateChain: certChain,
+				PrivateKey:       privateKey,
+			}}
+		}
+		rootCA = fileDataSource(tls.GetCaCertificates())
+	}
+
+	if !tls.GetInsecureSkipVerify().GetValue() {
+		var sanMatchers []*envoytlsv3.SubjectAltNameMatcher
+		for _, san := range tls.GetSubjectAltNames() {
+			sanMatchers = append(sanMatchers, &envoytlsv3.SubjectAltNameMatcher{
+				SanType: envoytlsv3.SubjectAltNameMatcher_DNS,
+				Matcher: &envoymatcher.StringMatcher{
+					MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: san},
+				},
+			})
+		}
+		if rootCA != nil {
+			commonTlsContext.ValidationContextType = &envoytlsv3.CommonTlsContext_ValidationContext{
+				ValidationContext: &envoytlsv3.CertificateValidationContext{
+					TrustedCa:                 rootCA,
+					MatchTypedSubjectAltNames: sanMatchers,
+				},
+			}
+		} else {
+			if caSdsConfig == nil {
+				caSdsConfig = &envoytlsv3.SdsSecretConfig{Name: eiutils.SystemCaSecretName}
+			}
+			commonTlsContext.ValidationContextType = &envoytlsv3.CommonTlsContext_CombinedValidationContext{
+				CombinedValidationContext: &envoytlsv3.CommonTlsContext_CombinedCertificateValidationContext{
+					DefaultValidationContext: &envoytlsv3.CertificateValidationContext{
+						MatchTypedSubjectAltNames: sanMatchers,
+					},
+					ValidationContextSdsSecretConfig: caSdsConfig,
+				},
+			}
+		}
+	}
+
+	return &envoytlsv3.UpstreamTlsContext{
+		CommonTlsContext: commonTlsContext,
+		Sni:              tls.GetSni(),
+	}, nil
+}
+
+func fileDataSource(filename string) *envoycorev3.DataSource {
+	if filename == "" {
+		return nil
+	}
+	return &envoycorev3.DataSource{
+		Specifier: &envoycorev3.DataSource_Filename{
+			Filename: filename,
+		},
+	}
+}
@@ -45,6 +45,8 @@ import (
 
 var logger = logging.New("plugin/httplistenerpolicy")
 
+const defaultAltSvcMaxAge = 24 * time.Hour
+
 type httpListenerPolicy struct {
 	ct                         time.Time
 	upgradeConfigs             []*envoy_hcm.HttpConnectionManager_UpgradeConfig
@@ -69,12 +71,32 @@ type httpListenerPolicy struct {
 	tracingConfig        *envoy_hcm.HttpConnectionManager_Tracing
 	acceptHttp10         *bool
 	defaultHostForHttp10 *string
+	http3                *http3Config
+}
+
+// http3Config enables HTTP/3 on the HTTPS listeners of the Gateway.
+type http3Config struct {
+	// listeners restricts HTTP/3 to the named listeners; all HTTPS listeners if empty.
+	listeners []string
+	settings  pluginsdkir.Http3Settings
 }
 
+var _ pluginsdkir.Http3PolicyIR = &httpListenerPolicy{}
+
 func (d *httpListenerPolicy) CreationTime() time.Time {
 	return d.ct
 }
 
+func (d *httpListenerPolicy) Http3(listenerName string) (*pluginsdkir.Http3Settings, bool) {
+	if d.http3 == nil {
+		return nil, false
+	}
+	if len(d.http3.listeners) > 0 && !slices.Contains(d.http3.listeners, listenerName) {
+		return nil, true
+	}
+	return &d.http3.settings, true
+}
+
 func (d *httpListenerPolicy) Equals(in any) bool {
 	d2, ok := in.(*httpListenerPolicy)
 	if !ok {
@@ -161,6 +183,13 @@ func (d *httpListenerPolicy) Equals(in any) bool {
 		return false
 	}
 
+	if (d.http3 == nil) != (d2.http3 == nil) {
+		return false
+	}
+	if d.http3 != nil && (!slices.Equal(d.http3.listeners, d2.http3.listeners) || !d.http3.settings.Equals(&d2.http3.settings)) {
+		return false
+	}
+
 	return true
 }
 
@@ -231,6 +260,11 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 			idleTimeout = &duration
 		}
 
+		globalPolicyTier, err := pluginsdkutils.ParseGlobalPolicyTierAnnotation(i.Annotations, i.Namespace, commoncol.Settings.GlobalPolicyNamespace)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
 		healthCheckPolicy := convertHealthCheckPolicy(i)
 		var xffNumTrustedHops *uint32
 		if i.Spec.XffNumTrustedHops != nil {
@@ -256,9 +290,11 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 				preserveHttp1HeaderCase:    i.Spec.PreserveHttp1HeaderCase,
 				acceptHttp10:               i.Spec.AcceptHttp10,
 				defaultHostForHttp10:       i.Spec.DefaultHostForHttp10,
+				http3:                      convertHttp3(i.Spec.HTTP3),
 			},
-			TargetRefs: pluginsdkutils.TargetRefsToPolicyRefs(i.Spec.TargetRefs, i.Spec.TargetSelectors),
-			Errors:     errs,
+			TargetRefs:       pluginsdkutils.TargetRefsToPolicyRefs(i.Spec.TargetRefs, i.Spec.TargetSelectors),
+			Errors:           errs,
+			GlobalPolicyTier: globalPolicyTier,
 		}
 
 		return pol
@@ -424,6 +460,25 @@ func convertUpgradeConfig(policy *v1alpha1.HTTPListenerPolicy) []*envoy_hcm.Http
 	return configs
 }
 
+func convertHttp3(in *v1alpha1.HTTP3) *http3Config {
+	if in == nil {
+		return nil
+	}
+
+	out := &http3Config{
+		settings: pluginsdkir.Http3Settings{
+			AltSvcMaxAge: defaultAltSvcMaxAge,
+		},
+	}
+	for _, l := range in.Listeners {
+		out.listeners = append(out.listeners, string(l))
+	}
+	if in.AltSvcMaxAge != nil {
+		out.settings.AltSvcMaxAge = in.AltSvcMaxAge.Duration
+	}
+	return out
+}
+
 func convertServerHeaderTransformation(transformation *v1alpha1.ServerHeaderTransformation) *envoy_hcm.HttpConnectionManager_ServerHeaderTransformation {
 	if transformation == nil {
 		return nil
@@ -32,6 +32,7 @@ func mergePolicies(
 		mergePreserveHttp1HeaderCase,
 		mergeAcceptHttp10,
 		mergeDefaultHostForHttp10,
+		mergeHttp3,
 	}
 
 	for _, mergeFunc := range mergeFuncs {
@@ -227,3 +228,18 @@ func mergeHealthCheckPolicy(
 	p1.healthCheckPolicy = p2.healthCheckPolicy
 	mergeOrigins.SetOne("healthCheckPolicy", p2Ref, p2MergeOrigins)
 }
+
+func mergeHttp3(
+	p1, p2 *httpListenerPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.http3, p2.http3, opts) {
+		return
+	}
+
+	p1.http3 = p2.http3
+	mergeOrigins.SetOne("http3", p2Ref, p2MergeOrigins)
+}
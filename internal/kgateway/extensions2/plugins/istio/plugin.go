@@ -83,12 +83,12 @@ func isDisabledForUpstream(in ir.BackendObjectIR) bool {
 	return in.DisableIstioAutoMTLS
 }
 
-// we don't have a good way of know if we have ssl on the upstream, so check cluster instead
-// this could be a problem if the policy that adds ssl runs after this one.
-// so we need to think about how's best to handle this.
-func doesClusterHaveSslConfigPresent(_ *envoyclusterv3.Cluster) bool {
-	// TODO: implement this
-	return false
+// we don't have a good way of know if we have ssl on the upstream, so check cluster instead.
+// A transport socket configured by another policy, e.g. the TLS settings of a DestinationRule, takes
+// precedence over auto mtls. Policies that configure a transport socket after this one must clear the
+// transport socket matches added for auto mtls.
+func doesClusterHaveSslConfigPresent(out *envoyclusterv3.Cluster) bool {
+	return out.GetTransportSocket() != nil
 }
 
 func (p istioPlugin) processBackend(ctx context.Context, ir ir.PolicyIR, in ir.BackendObjectIR, out *envoyclusterv3.Cluster) {
@@ -0,0 +1,221 @@
+package serviceimport
+
+import (
+	"maps"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+	mcsv1a1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+	krtpkg "github.com/kgateway-dev/kgateway/v2/pkg/utils/krtutil"
+)
+
+// clusterAddress identifies an endpoint address in an exporting cluster.
+type clusterAddress struct {
+	cluster string
+	address string
+}
+
+// newServiceImportEndpoints returns the endpoints of the ServiceImport backends, built from the EndpointSlices
+// that the multi-cluster services controller labels with the name of the ServiceImport, for all the exporting clusters.
+func newServiceImportEndpoints(
+	krtOpts krtutil.KrtOptions,
+	backends krt.Collection[ir.BackendObjectIR],
+	endpointSlices krt.Collection[*discoveryv1.EndpointSlice],
+	pods krt.Collection[krtcollections.LocalityPod],
+	stngs apisettings.Settings,
+) krt.Collection[ir.EndpointsForBackend] {
+	endpointSlicesByServiceImport := krtpkg.UnnamedIndex(endpointSlices, func(es *discoveryv1.EndpointSlice) []types.NamespacedName {
+		name, ok := es.Labels[mcsv1a1.LabelServiceName]
+		if !ok {
+			return nil
+		}
+		return []types.NamespacedName{{
+			Namespace: es.Namespace,
+			Name:      name,
+		}}
+	})
+	failoverPriority := clusterFailoverPriority(stngs.ClusterName)
+
+	return krt.NewCollection(backends, func(kctx krt.HandlerContext, backend ir.BackendObjectIR) *ir.EndpointsForBackend {
+		si, ok := backend.Obj.(*mcsv1a1.ServiceImport)
+		if !ok {
+			return nil
+		}
+		key := types.NamespacedName{
+			Namespace: si.Namespace,
+			Name:      si.Name,
+		}
+		siLogger := logger.With("serviceimport", key)
+
+		siPort := findPortForServiceImport(si, backend.Port)
+		if siPort == nil {
+			siLogger.Debug("port not found for serviceimport", "port", backend.Port)
+			return nil
+		}
+		singlePort := len(si.Spec.Ports) == 1
+
+		siEndpointSlices := krt.Fetch(kctx, endpointSlices, krt.FilterIndex(endpointSlicesByServiceImport, key))
+		if len(siEndpointSlices) == 0 {
+			siLogger.Debug("no endpointslices found for serviceimport")
+			return nil
+		}
+
+		ret := ir.NewEndpointsForBackend(backend)
+		ret.FailoverPriority = failoverPriority
+
+		// the clusters of a ClusterSet may use overlapping pod networks, the same address can be
+		// a different endpoint in each exporting cluster
+		seenAddresses := make(map[clusterAddress]struct{})
+		// A ready endpoint takes precedence over a draining endpoint with the same address
+		readyAddresses := readyClusterAddresses(siEndpointSlices)
+		for _, endpointSlice := range siEndpointSlices {
+			port := findPortInEndpointSlice(endpointSlice, singlePort, siPort)
+			if port == 0 {
+				continue
+			}
+			sourceCluster := endpointSlice.Labels[mcsv1a1.LabelSourceCluster]
+			// pods can only be looked up for the endpoints of the local cluster
+			localCluster := stngs.ClusterName != "" && sourceCluster == stngs.ClusterName
+
+			for _, endpoint := range endpointSlice.Endpoints {
+				// Skip endpoints that are not ready, except the terminating endpoints that are still serving:
+				// they are kept as draining so that the in-flight requests complete, but get no new requests
+				draining := false
+				if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+					if !krtcollections.IsServingTerminating(endpoint.Conditions) {
+						continue
+					}
+					draining = true
+				}
+				for _, addr := range endpoint.Addresses {
+					// Deduplicate addresses within each cluster
+					seen := clusterAddress{cluster: sourceCluster, address: addr}
+					if _, exists := seenAddresses[seen]; exists {
+						continue
+					}
+					if _, ready := readyAddresses[seen]; draining && ready {
+						continue
+					}
+					seenAddresses[seen] = struct{}{}
+
+					var pod *krtcollections.LocalityPod
+					if localCluster && endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+						podNamespace := endpointSlice.Namespace
+						if endpoint.TargetRef.Namespace != "" {
+							podNamespace = endpoint.TargetRef.Namespace
+						}
+						pod = krt.FetchOne(kctx, pods, krt.FilterObjectName(types.NamespacedName{
+							Namespace: podNamespace,
+							Name:      endpoint.TargetRef.Name,
+						}))
+					}
+					locality, labels := endpointLocalityAndLabels(endpoint, sourceCluster, pod)
+
+					ep := krtcollections.CreateLBEndpoint(addr, port, labels, stngs.EnableIstioAutoMtls)
+					// the weight is only known for the pods of the local cluster
+					if pod != nil && pod.Weight > 0 {
+						ep.LoadBalancingWeight = wrapperspb.UInt32(pod.Weight)
+					}
+					if draining {
+						ep.HealthStatus = envoycorev3.HealthStatus_DRAINING
+					}
+
+					ret.Add(locality, ir.EndpointWithMd{
+						LbEndpoint: ep,
+						EndpointMd: ir.EndpointMetadata{
+							Labels: labels,
+						},
+					})
+				}
+			}
+		}
+
+		siLogger.Debug("created endpoints", "total_endpoints", len(ret.LbEps))
+
+		return ret
+	}, krtOpts.ToOptions("ServiceImportEndpoints")...)
+}
+
+// readyClusterAddresses returns the addresses of the ready endpoints of each exporting cluster.
+func readyClusterAddresses(endpointSlices []*discoveryv1.EndpointSlice) map[clusterAddress]struct{} {
+	addresses := make(map[clusterAddress]struct{})
+	for _, endpointSlice := range endpointSlices {
+		sourceCluster := endpointSlice.Labels[mcsv1a1.LabelSourceCluster]
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			for _, addr := range endpoint.Addresses {
+				addresses[clusterAddress{cluster: sourceCluster, address: addr}] = struct{}{}
+			}
+		}
+	}
+	return addresses
+}
+
+// clusterFailoverPriority returns the failover priority of the endpoints of ServiceImport backends:
+// the endpoints of the local cluster are preferred, and the ones in the same zone as the proxy first.
+// All the clusters have the same priority when the name of the local cluster is unknown.
+func clusterFailoverPriority(clusterName string) []string {
+	if clusterName == "" {
+		return nil
+	}
+	return []string{
+		mcsv1a1.LabelSourceCluster + "=" + clusterName,
+		corev1.LabelTopologyZone,
+	}
+}
+
+// endpointLocalityAndLabels returns the locality and labels of an endpoint, from its pod when it is known or
+// from the topology information of the EndpointSlice otherwise. The labels always include the source cluster.
+func endpointLocalityAndLabels(
+	endpoint discoveryv1.Endpoint,
+	sourceCluster string,
+	pod *krtcollections.LocalityPod,
+) (ir.PodLocality, map[string]string) {
+	var locality ir.PodLocality
+	labels := map[string]string{}
+	if pod != nil {
+		locality = pod.Locality
+		maps.Copy(labels, pod.AugmentedLabels)
+	} else if endpoint.Zone != nil {
+		locality.Zone = *endpoint.Zone
+		labels[corev1.LabelTopologyZone] = *endpoint.Zone
+	}
+	if sourceCluster != "" {
+		labels[mcsv1a1.LabelSourceCluster] = sourceCluster
+	}
+	return locality, labels
+}
+
+func findPortForServiceImport(si *mcsv1a1.ServiceImport, port int32) *mcsv1a1.ServicePort {
+	for i := range si.Spec.Ports {
+		if si.Spec.Ports[i].Port == port {
+			return &si.Spec.Ports[i]
+		}
+	}
+	return nil
+}
+
+func findPortInEndpointSlice(endpointSlice *discoveryv1.EndpointSlice, singlePort bool, siPort *mcsv1a1.ServicePort) uint32 {
+	for _, p := range endpointSlice.Ports {
+		if p.Port == nil {
+			continue
+		}
+		// If the endpoint port is not named, it implies that
+		// the ServiceImport only has a single unnamed port as well.
+		if singlePort || (p.Name != nil && *p.Name == siPort.Name) {
+			return uint32(*p.Port) //nolint:gosec // G115: endpoint port is always valid port range
+		}
+	}
+	return 0
+}
@@ -0,0 +1,323 @@
+package serviceimport
+
+import (
+	"context"
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	mcsv1a1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/endpoints"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+)
+
+func TestServiceImportEndpoints(t *testing.T) {
+	si := &mcsv1a1.ServiceImport{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "default"},
+		Spec: mcsv1a1.ServiceImportSpec{
+			Type: mcsv1a1.ClusterSetIP,
+			Ports: []mcsv1a1.ServicePort{
+				{Name: "http", Port: 8080},
+				{Name: "grpc", Port: 9090},
+			},
+		},
+	}
+	backend := BuildServiceImportBackendObjectIR(si, 8080, "http")
+
+	endpointSlice := func(name, cluster string, eps ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					mcsv1a1.LabelServiceName:   "reviews",
+					mcsv1a1.LabelSourceCluster: cluster,
+				},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Ports: []discoveryv1.EndpointPort{
+				{Name: ptr.To("grpc"), Port: ptr.To[int32](9000)},
+				{Name: ptr.To("http"), Port: ptr.To[int32](8000)},
+			},
+			Endpoints: eps,
+		}
+	}
+	inputs := []any{
+		backend,
+		endpointSlice("reviews-cluster-a", "cluster-a",
+			discoveryv1.Endpoint{
+				Addresses: []string{"10.0.0.1"},
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "reviews-a"},
+			},
+			discoveryv1.Endpoint{
+				Addresses:  []string{"10.0.0.2"},
+				Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)},
+			},
+		),
+		endpointSlice("reviews-cluster-b", "cluster-b",
+			discoveryv1.Endpoint{
+				Addresses: []string{"10.1.0.1"},
+				Zone:      ptr.To("zone1"),
+				// a pod with the same name in the local cluster must not be used for a remote endpoint
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "reviews-a"},
+			},
+		),
+		// not part of the ServiceImport
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "reviews-local",
+				Namespace: "default",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "reviews"},
+			},
+			Ports:     []discoveryv1.EndpointPort{{Name: ptr.To("http"), Port: ptr.To[int32](8000)}},
+			Endpoints: []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.3"}}},
+		},
+		krtcollections.LocalityPod{
+			Named:    krt.Named{Name: "reviews-a", Namespace: "default"},
+			Locality: ir.PodLocality{Region: "region1", Zone: "zone2"},
+			AugmentedLabels: map[string]string{
+				corev1.LabelTopologyRegion: "region1",
+				corev1.LabelTopologyZone:   "zone2",
+				"app":                      "reviews",
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		clusterName    string
+		wantPriorities map[string]uint32
+		wantLabels     map[string]map[string]string
+	}{
+		{
+			name:           "no local cluster name",
+			wantPriorities: map[string]uint32{"10.0.0.1": 0, "10.1.0.1": 0},
+			wantLabels: map[string]map[string]string{
+				"10.0.0.1": {mcsv1a1.LabelSourceCluster: "cluster-a"},
+				"10.1.0.1": {mcsv1a1.LabelSourceCluster: "cluster-b", corev1.LabelTopologyZone: "zone1"},
+			},
+		},
+		{
+			name:        "local cluster preferred",
+			clusterName: "cluster-a",
+			// the local endpoint is in another zone than the proxy, the remote endpoint has the lowest priority
+			wantPriorities: map[string]uint32{"10.0.0.1": 1, "10.1.0.1": 2},
+			wantLabels: map[string]map[string]string{
+				"10.0.0.1": {
+					mcsv1a1.LabelSourceCluster: "cluster-a",
+					corev1.LabelTopologyRegion: "region1",
+					corev1.LabelTopologyZone:   "zone2",
+					"app":                      "reviews",
+				},
+				"10.1.0.1": {mcsv1a1.LabelSourceCluster: "cluster-b", corev1.LabelTopologyZone: "zone1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := krttest.NewMock(t, inputs)
+			col := newServiceImportEndpoints(
+				krtutil.KrtOptions{},
+				krttest.GetMockCollection[ir.BackendObjectIR](mock),
+				krttest.GetMockCollection[*discoveryv1.EndpointSlice](mock),
+				krttest.GetMockCollection[krtcollections.LocalityPod](mock),
+				apisettings.Settings{ClusterName: tt.clusterName},
+			)
+			col.WaitUntilSynced(context.Background().Done())
+
+			eps := col.GetKey(backend.ResourceName())
+			require.NotNil(t, eps)
+
+			labels := map[string]map[string]string{}
+			for _, lbEps := range eps.LbEps {
+				for _, ep := range lbEps {
+					labels[ep.GetEndpoint().GetAddress().GetSocketAddress().GetAddress()] = ep.EndpointMd.Labels
+				}
+			}
+			assert.Equal(t, tt.wantLabels, labels)
+
+			ucc := ir.NewUniqlyConnectedClient("gw", "default", map[string]string{
+				corev1.LabelTopologyRegion: "region1",
+				corev1.LabelTopologyZone:   "zone1",
+			}, ir.PodLocality{Region: "region1", Zone: "zone1"})
+			cla := endpoints.PrioritizeEndpoints(nil, ucc, endpoints.EndpointsInputs{EndpointsForBackend: *eps})
+
+			priorities := map[string]uint32{}
+			for _, localityEps := range cla.GetEndpoints() {
+				for _, lbEp := range localityEps.GetLbEndpoints() {
+					sock := lbEp.GetEndpoint().GetAddress().GetSocketAddress()
+					assert.Equal(t, uint32(8000), sock.GetPortValue())
+					priorities[sock.GetAddress()] = localityEps.GetPriority()
+				}
+			}
+			assert.Equal(t, tt.wantPriorities, priorities)
+		})
+	}
+}
+
+func TestServiceImportEndpointsDeduplicatedPerCluster(t *testing.T) {
+	si := &mcsv1a1.ServiceImport{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "default"},
+		Spec: mcsv1a1.ServiceImportSpec{
+			Type:  mcsv1a1.ClusterSetIP,
+			Ports: []mcsv1a1.ServicePort{{Port: 8080}},
+		},
+	}
+	backend := BuildServiceImportBackendObjectIR(si, 8080, "")
+
+	endpointSlice := func(name, cluster string, addrs ...string) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					mcsv1a1.LabelServiceName:   "reviews",
+					mcsv1a1.LabelSourceCluster: cluster,
+				},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Ports:       []discoveryv1.EndpointPort{{Port: ptr.To[int32](8000)}},
+			Endpoints:   []discoveryv1.Endpoint{{Addresses: addrs}},
+		}
+	}
+	mock := krttest.NewMock(t, []any{
+		backend,
+		// the address is repeated in two slices of cluster-a, and reused by cluster-b
+		endpointSlice("reviews-cluster-a-1", "cluster-a", "10.0.0.1"),
+		endpointSlice("reviews-cluster-a-2", "cluster-a", "10.0.0.1"),
+		endpointSlice("reviews-cluster-b", "cluster-b", "10.0.0.1"),
+	})
+	col := newServiceImportEndpoints(
+		krtutil.KrtOptions{},
+		krttest.GetMockCollection[ir.BackendObjectIR](mock),
+		krttest.GetMockCollection[*discoveryv1.EndpointSlice](mock),
+		krttest.GetMockCollection[krtcollections.LocalityPod](mock),
+		apisettings.Settings{},
+	)
+	col.WaitUntilSynced(context.Background().Done())
+
+	eps := col.GetKey(backend.ResourceName())
+	require.NotNil(t, eps)
+
+	clusters := map[string]int{}
+	for _, lbEps := range eps.LbEps {
+		for _, ep := range lbEps {
+			assert.Equal(t, "10.0.0.1", ep.GetEndpoint().GetAddress().GetSocketAddress().GetAddress())
+			clusters[ep.EndpointMd.Labels[mcsv1a1.LabelSourceCluster]]++
+		}
+	}
+	assert.Equal(t, map[string]int{"cluster-a": 1, "cluster-b": 1}, clusters)
+}
+
+func TestServiceImportEndpointsWeightAndDraining(t *testing.T) {
+	si := &mcsv1a1.ServiceImport{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "default"},
+		Spec: mcsv1a1.ServiceImportSpec{
+			Type:  mcsv1a1.ClusterSetIP,
+			Ports: []mcsv1a1.ServicePort{{Port: 8080}},
+		},
+	}
+	backend := BuildServiceImportBackendObjectIR(si, 8080, "")
+
+	servingTerminating := discoveryv1.EndpointConditions{
+		Ready:       ptr.To(false),
+		Serving:     ptr.To(true),
+		Terminating: ptr.To(true),
+	}
+	endpointSlice := func(name, cluster string, eps ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					mcsv1a1.LabelServiceName:   "reviews",
+					mcsv1a1.LabelSourceCluster: cluster,
+				},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Ports:       []discoveryv1.EndpointPort{{Port: ptr.To[int32](8000)}},
+			Endpoints:   eps,
+		}
+	}
+	mock := krttest.NewMock(t, []any{
+		backend,
+		endpointSlice("reviews-cluster-a", "cluster-a",
+			discoveryv1.Endpoint{
+				Addresses: []string{"10.0.0.1"},
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "reviews-a"},
+			},
+			discoveryv1.Endpoint{
+				Addresses:  []string{"10.0.0.2"},
+				Conditions: servingTerminating,
+			},
+			// terminating and no longer serving
+			discoveryv1.Endpoint{
+				Addresses: []string{"10.0.0.3"},
+				Conditions: discoveryv1.EndpointConditions{
+					Ready:       ptr.To(false),
+					Serving:     ptr.To(false),
+					Terminating: ptr.To(true),
+				},
+			},
+		),
+		endpointSlice("reviews-cluster-b", "cluster-b",
+			discoveryv1.Endpoint{
+				Addresses: []string{"10.1.0.1"},
+				// the weight of a pod with the same name in the local cluster must not be used
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "reviews-a"},
+			},
+			// draining in cluster-b while the address is ready in cluster-a
+			discoveryv1.Endpoint{
+				Addresses:  []string{"10.0.0.1"},
+				Conditions: servingTerminating,
+			},
+		),
+		krtcollections.LocalityPod{
+			Named:  krt.Named{Name: "reviews-a", Namespace: "default"},
+			Weight: 3,
+		},
+	})
+	col := newServiceImportEndpoints(
+		krtutil.KrtOptions{},
+		krttest.GetMockCollection[ir.BackendObjectIR](mock),
+		krttest.GetMockCollection[*discoveryv1.EndpointSlice](mock),
+		krttest.GetMockCollection[krtcollections.LocalityPod](mock),
+		apisettings.Settings{ClusterName: "cluster-a"},
+	)
+	col.WaitUntilSynced(context.Background().Done())
+
+	eps := col.GetKey(backend.ResourceName())
+	require.NotNil(t, eps)
+
+	type endpointState struct {
+		weight   uint32
+		draining bool
+	}
+	states := map[string]endpointState{}
+	for _, lbEps := range eps.LbEps {
+		for _, ep := range lbEps {
+			key := ep.EndpointMd.Labels[mcsv1a1.LabelSourceCluster] + "/" + ep.GetEndpoint().GetAddress().GetSocketAddress().GetAddress()
+			states[key] = endpointState{
+				weight:   ep.GetLoadBalancingWeight().GetValue(),
+				draining: ep.GetHealthStatus() == envoycorev3.HealthStatus_DRAINING,
+			}
+		}
+	}
+	assert.Equal(t, map[string]endpointState{
+		"cluster-a/10.0.0.1": {weight: 3},
+		"cluster-a/10.0.0.2": {weight: 1, draining: true},
+		"cluster-b/10.1.0.1": {weight: 1},
+		"cluster-b/10.0.0.1": {weight: 1, draining: true},
+	}, states)
+}
@@ -0,0 +1,138 @@
+package serviceimport
+
+import (
+	"context"
+	"fmt"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	skubeclient "istio.io/istio/pkg/config/schema/kubeclient"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/kubetypes"
+	"istio.io/istio/pkg/ptr"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	mcsv1a1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+	mcsversioned "sigs.k8s.io/mcs-api/pkg/client/clientset/versioned"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+)
+
+const (
+	BackendClusterPrefix = "mcs"
+
+	// clusterSetDomain is the domain of the ClusterSet, as per the Multi-Cluster Services API DNS specification
+	clusterSetDomain = "clusterset.local"
+)
+
+var logger = logging.New("plugin/serviceimport")
+
+func registerTypes(cli mcsversioned.Interface) {
+	skubeclient.Register[*mcsv1a1.ServiceImport](
+		wellknown.ServiceImportGVR,
+		wellknown.ServiceImportGVK,
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (runtime.Object, error) {
+			return cli.MulticlusterV1alpha1().ServiceImports(namespace).List(context.Background(), o)
+		},
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (watch.Interface, error) {
+			return cli.MulticlusterV1alpha1().ServiceImports(namespace).Watch(context.Background(), o)
+		},
+	)
+}
+
+func NewPlugin(ctx context.Context, commonCol *collections.CommonCollections) sdk.Plugin {
+	cli, err := mcsversioned.NewForConfig(commonCol.Client.RESTConfig())
+	if err != nil {
+		logger.Error("failed to create multi-cluster services client", "error", err)
+		return sdk.Plugin{}
+	}
+	registerTypes(cli)
+
+	// the ServiceImport CRD is only installed along with a multi-cluster services controller
+	serviceImports := krt.WrapClient(kclient.NewDelayedInformer[*mcsv1a1.ServiceImport](
+		commonCol.Client, wellknown.ServiceImportGVR, kubetypes.StandardInformer,
+		kclient.Filter{ObjectFilter: commonCol.Client.ObjectFilter()},
+	), commonCol.KrtOpts.ToOptions("ServiceImports")...)
+	endpointSlices := krt.WrapClient(kclient.NewFiltered[*discoveryv1.EndpointSlice](
+		commonCol.Client,
+		kclient.Filter{ObjectFilter: commonCol.Client.ObjectFilter()},
+	), commonCol.KrtOpts.ToOptions("ServiceImportEndpointSlices")...)
+	return NewPluginFromCollections(ctx, commonCol.KrtOpts, commonCol.LocalityPods, serviceImports, endpointSlices, commonCol.Settings)
+}
+
+func NewPluginFromCollections(
+	ctx context.Context,
+	krtOpts krtutil.KrtOptions,
+	pods krt.Collection[krtcollections.LocalityPod],
+	serviceImports krt.Collection[*mcsv1a1.ServiceImport],
+	endpointSlices krt.Collection[*discoveryv1.EndpointSlice],
+	stngs apisettings.Settings,
+) sdk.Plugin {
+	backends := krt.NewManyCollection(serviceImports, func(kctx krt.HandlerContext, si *mcsv1a1.ServiceImport) []ir.BackendObjectIR {
+		uss := []ir.BackendObjectIR{}
+		for _, port := range si.Spec.Ports {
+			uss = append(uss, BuildServiceImportBackendObjectIR(si, port.Port, ptr.OrDefault(port.AppProtocol, port.Name)))
+		}
+		return uss
+	}, krtOpts.ToOptions("ServiceImportBackends")...)
+
+	endpoints := newServiceImportEndpoints(krtOpts, backends, endpointSlices, pods, stngs)
+
+	return sdk.Plugin{
+		ContributesBackends: map[schema.GroupKind]sdk.BackendPlugin{
+			wellknown.ServiceImportGVK.GroupKind(): {
+				BackendInit: ir.BackendInit{
+					InitEnvoyBackend: processBackend,
+				},
+				Endpoints: endpoints,
+				Backends:  backends,
+			},
+		},
+	}
+}
+
+func BuildServiceImportBackendObjectIR(si *mcsv1a1.ServiceImport, port int32, protocol string) ir.BackendObjectIR {
+	objSrc := ir.ObjectSource{
+		Kind:      wellknown.ServiceImportGVK.Kind,
+		Group:     wellknown.ServiceImportGVK.Group,
+		Namespace: si.Namespace,
+		Name:      si.Name,
+	}
+	backend := ir.NewBackendObjectIR(objSrc, port, "")
+	backend.Obj = si
+	backend.AppProtocol = ir.ParseAppProtocol(&protocol)
+	backend.GvPrefix = BackendClusterPrefix
+	backend.CanonicalHostname = fmt.Sprintf("%s.%s.svc.%s", si.Name, si.Namespace, clusterSetDomain)
+
+	// Parse common annotations
+	ir.ParseObjectAnnotations(&backend, si)
+
+	return backend
+}
+
+func processBackend(ctx context.Context, in ir.BackendObjectIR, out *envoyclusterv3.Cluster) *ir.EndpointsForBackend {
+	out.ClusterDiscoveryType = &envoyclusterv3.Cluster_Type{
+		Type: envoyclusterv3.Cluster_EDS,
+	}
+	out.EdsClusterConfig = &envoyclusterv3.Cluster_EdsClusterConfig{
+		EdsConfig: &envoycorev3.ConfigSource{
+			ResourceApiVersion: envoycorev3.ApiVersion_V3,
+			ConfigSourceSpecifier: &envoycorev3.ConfigSource_Ads{
+				Ads: &envoycorev3.AggregatedConfigSource{},
+			},
+		},
+	}
+	out.IgnoreHealthOnHostRemoval = true
+	return nil
+}
@@ -0,0 +1,90 @@
+package tcppolicy
+
+import (
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/policy"
+)
+
+func mergePolicies(
+	p1, p2 *tcpPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	mergeOpts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ string, // no merge settings
+) {
+	if p1 == nil || p2 == nil {
+		return
+	}
+
+	mergeFuncs := []func(*tcpPolicy, *tcpPolicy, *ir.AttachedPolicyRef, ir.MergeOrigins, policy.MergeOptions, ir.MergeOrigins){
+		mergeRBAC,
+		mergeConnectionRateLimit,
+		mergeIdleTimeout,
+		mergeMaxConnectionDuration,
+	}
+
+	for _, mergeFunc := range mergeFuncs {
+		mergeFunc(p1, p2, p2Ref, p2MergeOrigins, mergeOpts, mergeOrigins)
+	}
+}
+
+func mergeRBAC(
+	p1, p2 *tcpPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.rbac, p2.rbac, opts) {
+		return
+	}
+
+	p1.rbac = p2.rbac
+	mergeOrigins.SetOne("rbac", p2Ref, p2MergeOrigins)
+}
+
+func mergeConnectionRateLimit(
+	p1, p2 *tcpPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.connectionRateLimit, p2.connectionRateLimit, opts) {
+		return
+	}
+
+	p1.connectionRateLimit = p2.connectionRateLimit
+	mergeOrigins.SetOne("connectionRateLimit", p2Ref, p2MergeOrigins)
+}
+
+func mergeIdleTimeout(
+	p1, p2 *tcpPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.idleTimeout, p2.idleTimeout, opts) {
+		return
+	}
+
+	p1.idleTimeout = p2.idleTimeout
+	mergeOrigins.SetOne("idleTimeout", p2Ref, p2MergeOrigins)
+}
+
+func mergeMaxConnectionDuration(
+	p1, p2 *tcpPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.maxConnectionDuration, p2.maxConnectionDuration, opts) {
+		return
+	}
+
+	p1.maxConnectionDuration = p2.maxConnectionDuration
+	mergeOrigins.SetOne("maxConnectionDuration", p2Ref, p2MergeOrigins)
+}
@@ -0,0 +1,153 @@
+package tcppolicy
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	rbacconfigv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	rbacnetworkv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+const rbacStatPrefix = "tcp_policy."
+
+// translateRBAC converts the TCPPolicy RBAC into the config of the network RBAC filter.
+// Each rule becomes an RBAC policy, so a connection matches if it matches any rule. Within a rule,
+// the SNI conditions are expressed as permissions and the source and principal conditions as principals,
+// both of which must match for the policy to match.
+func translateRBAC(in *v1alpha1.TCPRBAC) (*rbacnetworkv3.RBAC, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	action := rbacconfigv3.RBAC_ALLOW
+	if in.Action == v1alpha1.AuthorizationPolicyActionDeny {
+		action = rbacconfigv3.RBAC_DENY
+	}
+
+	policies := make(map[string]*rbacconfigv3.Policy, len(in.Rules))
+	for i, rule := range in.Rules {
+		principals, err := translatePrincipals(rule)
+		if err != nil {
+			return nil, fmt.Errorf("rbac rule %d: %w", i, err)
+		}
+		policies[fmt.Sprintf("rule-%d", i)] = &rbacconfigv3.Policy{
+			Permissions: []*rbacconfigv3.Permission{translatePermission(rule)},
+			Principals:  []*rbacconfigv3.Principal{principals},
+		}
+	}
+
+	return &rbacnetworkv3.RBAC{
+		StatPrefix: rbacStatPrefix,
+		Rules: &rbacconfigv3.RBAC{
+			Action:   action,
+			Policies: policies,
+		},
+	}, nil
+}
+
+func translatePermission(rule v1alpha1.TCPRBACRule) *rbacconfigv3.Permission {
+	if len(rule.SNIs) == 0 {
+		return &rbacconfigv3.Permission{Rule: &rbacconfigv3.Permission_Any{Any: true}}
+	}
+
+	rules := make([]*rbacconfigv3.Permission, 0, len(rule.SNIs))
+	for _, sni := range rule.SNIs {
+		rules = append(rules, &rbacconfigv3.Permission{
+			Rule: &rbacconfigv3.Permission_RequestedServerName{
+				RequestedServerName: sniMatcher(sni),
+			},
+		})
+	}
+	return &rbacconfigv3.Permission{
+		Rule: &rbacconfigv3.Permission_OrRules{
+			OrRules: &rbacconfigv3.Permission_Set{Rules: rules},
+		},
+	}
+}
+
+func translatePrincipals(rule v1alpha1.TCPRBACRule) (*rbacconfigv3.Principal, error) {
+	var ids []*rbacconfigv3.Principal
+
+	if len(rule.SourceCIDRs) > 0 {
+		sources := make([]*rbacconfigv3.Principal, 0, len(rule.SourceCIDRs))
+		for _, cidr := range rule.SourceCIDRs {
+			cidrRange, err := toCidrRange(cidr)
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, &rbacconfigv3.Principal{
+				Identifier: &rbacconfigv3.Principal_DirectRemoteIp{DirectRemoteIp: cidrRange},
+			})
+		}
+		ids = append(ids, orIds(sources))
+	}
+
+	if len(rule.Principals) > 0 {
+		authenticated := make([]*rbacconfigv3.Principal, 0, len(rule.Principals))
+		for _, name := range rule.Principals {
+			authenticated = append(authenticated, &rbacconfigv3.Principal{
+				Identifier: &rbacconfigv3.Principal_Authenticated_{
+					Authenticated: &rbacconfigv3.Principal_Authenticated{
+						PrincipalName: &envoymatcherv3.StringMatcher{
+							MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: name},
+						},
+					},
+				},
+			})
+		}
+		ids = append(ids, orIds(authenticated))
+	}
+
+	switch len(ids) {
+	case 0:
+		return &rbacconfigv3.Principal{Identifier: &rbacconfigv3.Principal_Any{Any: true}}, nil
+	case 1:
+		return ids[0], nil
+	default:
+		return &rbacconfigv3.Principal{
+			Identifier: &rbacconfigv3.Principal_AndIds{
+				AndIds: &rbacconfigv3.Principal_Set{Ids: ids},
+			},
+		}, nil
+	}
+}
+
+func orIds(ids []*rbacconfigv3.Principal) *rbacconfigv3.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &rbacconfigv3.Principal{
+		Identifier: &rbacconfigv3.Principal_OrIds{
+			OrIds: &rbacconfigv3.Principal_Set{Ids: ids},
+		},
+	}
+}
+
+// sniMatcher matches a server name exactly, or any subdomain for a wildcard server name such as "*.example.com".
+func sniMatcher(sni string) *envoymatcherv3.StringMatcher {
+	if suffix, ok := strings.CutPrefix(sni, "*"); ok {
+		return &envoymatcherv3.StringMatcher{
+			MatchPattern: &envoymatcherv3.StringMatcher_Suffix{Suffix: suffix},
+		}
+	}
+	return &envoymatcherv3.StringMatcher{
+		MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: sni},
+	}
+}
+
+func toCidrRange(cidr string) (*envoycorev3.CidrRange, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source CIDR %q: %w", cidr, err)
+	}
+	return &envoycorev3.CidrRange{
+		AddressPrefix: prefix.Addr().String(),
+		PrefixLen:     wrapperspb.UInt32(uint32(prefix.Bits())), // nolint:gosec // G115: prefix length is at most 128
+	}, nil
+}
@@ -0,0 +1,130 @@
+package tcppolicy
+
+import (
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	rbacconfigv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func TestTranslateRBAC(t *testing.T) {
+	tests := []struct {
+		name    string
+		rbac    *v1alpha1.TCPRBAC
+		want    *rbacconfigv3.RBAC
+		wantErr string
+	}{
+		{
+			name: "nil rbac",
+		},
+		{
+			name: "deny by sni with wildcard",
+			rbac: &v1alpha1.TCPRBAC{
+				Action: v1alpha1.AuthorizationPolicyActionDeny,
+				Rules: []v1alpha1.TCPRBACRule{{
+					SNIs: []string{"db.example.com", "*.internal.example.com"},
+				}},
+			},
+			want: &rbacconfigv3.RBAC{
+				Action: rbacconfigv3.RBAC_DENY,
+				Policies: map[string]*rbacconfigv3.Policy{
+					"rule-0": {
+						Permissions: []*rbacconfigv3.Permission{{
+							Rule: &rbacconfigv3.Permission_OrRules{
+								OrRules: &rbacconfigv3.Permission_Set{Rules: []*rbacconfigv3.Permission{
+									{Rule: &rbacconfigv3.Permission_RequestedServerName{
+										RequestedServerName: &envoymatcherv3.StringMatcher{
+											MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: "db.example.com"},
+										},
+									}},
+									{Rule: &rbacconfigv3.Permission_RequestedServerName{
+										RequestedServerName: &envoymatcherv3.StringMatcher{
+											MatchPattern: &envoymatcherv3.StringMatcher_Suffix{Suffix: ".internal.example.com"},
+										},
+									}},
+								}},
+							},
+						}},
+						Principals: []*rbacconfigv3.Principal{{
+							Identifier: &rbacconfigv3.Principal_Any{Any: true},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "allow by source and principal",
+			rbac: &v1alpha1.TCPRBAC{
+				Action: v1alpha1.AuthorizationPolicyActionAllow,
+				Rules: []v1alpha1.TCPRBACRule{{
+					SourceCIDRs: []string{"10.0.0.0/8"},
+					Principals:  []string{"spiffe://cluster.local/ns/default/sa/client"},
+				}},
+			},
+			want: &rbacconfigv3.RBAC{
+				Action: rbacconfigv3.RBAC_ALLOW,
+				Policies: map[string]*rbacconfigv3.Policy{
+					"rule-0": {
+						Permissions: []*rbacconfigv3.Permission{{
+							Rule: &rbacconfigv3.Permission_Any{Any: true},
+						}},
+						Principals: []*rbacconfigv3.Principal{{
+							Identifier: &rbacconfigv3.Principal_AndIds{
+								AndIds: &rbacconfigv3.Principal_Set{Ids: []*rbacconfigv3.Principal{
+									{Identifier: &rbacconfigv3.Principal_DirectRemoteIp{
+										DirectRemoteIp: &envoycorev3.CidrRange{
+											AddressPrefix: "10.0.0.0",
+											PrefixLen:     wrapperspb.UInt32(8),
+										},
+									}},
+									{Identifier: &rbacconfigv3.Principal_Authenticated_{
+										Authenticated: &rbacconfigv3.Principal_Authenticated{
+											PrincipalName: &envoymatcherv3.StringMatcher{
+												MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: "spiffe://cluster.local/ns/default/sa/client"},
+											},
+										},
+									}},
+								}},
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid source cidr",
+			rbac: &v1alpha1.TCPRBAC{
+				Rules: []v1alpha1.TCPRBACRule{{
+					SourceCIDRs: []string{"10.0.0.0"},
+				}},
+			},
+			wantErr: `rbac rule 0: invalid source CIDR "10.0.0.0"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := translateRBAC(tt.rbac)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, rbacStatPrefix, got.GetStatPrefix())
+			assert.True(t, proto.Equal(tt.want, got.GetRules()), "got %v, want %v", got.GetRules(), tt.want)
+			assert.NoError(t, got.ValidateAll())
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package tcppolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	localratelimitnetworkv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	rbacnetworkv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	skubeclient "istio.io/istio/pkg/config/schema/kubeclient"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/client/clientset/versioned"
+	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	pluginsdkir "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/policy"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+)
+
+const (
+	rbacNetworkFilterName           = "envoy.filters.network.rbac"
+	localRateLimitNetworkFilterName = "envoy.filters.network.local_ratelimit"
+)
+
+var logger = logging.New("plugin/tcppolicy")
+
+type tcpPolicy struct {
+	ct                    time.Time
+	rbac                  *rbacnetworkv3.RBAC
+	connectionRateLimit   *localratelimitnetworkv3.LocalRateLimit
+	idleTimeout           *time.Duration
+	maxConnectionDuration *time.Duration
+}
+
+var _ ir.PolicyIR = &tcpPolicy{}
+
+func (d *tcpPolicy) CreationTime() time.Time {
+	return d.ct
+}
+
+func (d *tcpPolicy) Equals(in any) bool {
+	d2, ok := in.(*tcpPolicy)
+	if !ok {
+		return false
+	}
+
+	if !proto.Equal(d.rbac, d2.rbac) {
+		return false
+	}
+
+	if !proto.Equal(d.connectionRateLimit, d2.connectionRateLimit) {
+		return false
+	}
+
+	if !cmputils.PointerValsEqual(d.idleTimeout, d2.idleTimeout) {
+		return false
+	}
+
+	if !cmputils.PointerValsEqual(d.maxConnectionDuration, d2.maxConnectionDuration) {
+		return false
+	}
+
+	return true
+}
+
+type tcpPolicyPluginGwPass struct {
+	ir.UnimplementedProxyTranslationPass
+	reporter reporter.Reporter
+}
+
+var (
+	_ ir.ProxyTranslationPass             = &tcpPolicyPluginGwPass{}
+	_ pluginsdkir.TcpRouteTranslationPass = &tcpPolicyPluginGwPass{}
+)
+
+func registerTypes(ourCli versioned.Interface) {
+	skubeclient.Register[*v1alpha1.TCPPolicy](
+		wellknown.TCPPolicyGVR,
+		wellknown.TCPPolicyGVK,
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (runtime.Object, error) {
+			return ourCli.GatewayV1alpha1().TCPPolicies(namespace).List(context.Background(), o)
+		},
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (watch.Interface, error) {
+			return ourCli.GatewayV1alpha1().TCPPolicies(namespace).Watch(context.Background(), o)
+		},
+	)
+}
+
+func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sdk.Plugin {
+	registerTypes(commoncol.OurClient)
+
+	col := krt.WrapClient(kclient.NewFiltered[*v1alpha1.TCPPolicy](
+		commoncol.Client,
+		kclient.Filter{ObjectFilter: commoncol.Client.ObjectFilter()},
+	), commoncol.KrtOpts.ToOptions("TCPPolicy")...)
+	gk := wellknown.TCPPolicyGVK.GroupKind()
+	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, i *v1alpha1.TCPPolicy) *ir.PolicyWrapper {
+		objSrc := ir.ObjectSource{
+			Group:     gk.Group,
+			Kind:      gk.Kind,
+			Namespace: i.Namespace,
+			Name:      i.Name,
+		}
+
+		errs := []error{}
+		rbac, err := translateRBAC(i.Spec.RBAC)
+		if err != nil {
+			logger.Error("error translating rbac", "error", err)
+			errs = append(errs, err)
+		}
+
+		var idleTimeout *time.Duration
+		if i.Spec.IdleTimeout != nil {
+			duration := i.Spec.IdleTimeout.Duration
+			idleTimeout = &duration
+		}
+
+		var maxConnectionDuration *time.Duration
+		if i.Spec.MaxConnectionDuration != nil {
+			duration := i.Spec.MaxConnectionDuration.Duration
+			maxConnectionDuration = &duration
+		}
+
+		globalPolicyTier, err := pluginsdkutils.ParseGlobalPolicyTierAnnotation(i.Annotations, i.Namespace, commoncol.Settings.GlobalPolicyNamespace)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		pol := &ir.PolicyWrapper{
+			ObjectSource: objSrc,
+			Policy:       i,
+			PolicyIR: &tcpPolicy{
+				ct:                    i.CreationTimestamp.Time,
+				rbac:                  rbac,
+				connectionRateLimit:   translateConnectionRateLimit(i.Spec.ConnectionRateLimit),
+				idleTimeout:           idleTimeout,
+				maxConnectionDuration: maxConnectionDuration,
+			},
+			TargetRefs:       pluginsdkutils.TargetRefsToPolicyRefs(i.Spec.TargetRefs, i.Spec.TargetSelectors),
+			Errors:           errs,
+			GlobalPolicyTier: globalPolicyTier,
+		}
+
+		return pol
+	})
+
+	return sdk.Plugin{
+		ContributesPolicies: map[schema.GroupKind]sdk.PolicyPlugin{
+			wellknown.TCPPolicyGVK.GroupKind(): {
+				NewGatewayTranslationPass: NewGatewayTranslationPass,
+				Policies:                  policyCol,
+				GetPolicyStatus:           getPolicyStatusFn(commoncol.CrudClient),
+				PatchPolicyStatus:         patchPolicyStatusFn(commoncol.CrudClient),
+				MergePolicies: func(pols []ir.PolicyAtt) ir.PolicyAtt {
+					return policy.MergePolicies(pols, mergePolicies, "" /*no merge settings*/)
+				},
+			},
+		},
+	}
+}
+
+func NewGatewayTranslationPass(tctx ir.GwTranslationCtx, reporter reporter.Reporter) ir.ProxyTranslationPass {
+	return &tcpPolicyPluginGwPass{
+		reporter: reporter,
+	}
+}
+
+func (p *tcpPolicyPluginGwPass) Name() string {
+	return "tcppolicies"
+}
+
+func (p *tcpPolicyPluginGwPass) ApplyForTcpRoute(
+	pCtx *pluginsdkir.TcpRouteContext,
+	out *envoytcp.TcpProxy,
+) ([]plugins.StagedNetworkFilter, error) {
+	policy, ok := pCtx.Policy.(*tcpPolicy)
+	if !ok {
+		return nil, fmt.Errorf("internal error: expected tcp policy, got %T", pCtx.Policy)
+	}
+
+	if policy.idleTimeout != nil {
+		out.IdleTimeout = durationpb.New(*policy.idleTimeout)
+	}
+	if policy.maxConnectionDuration != nil {
+		out.MaxDownstreamConnectionDuration = durationpb.New(*policy.maxConnectionDuration)
+	}
+
+	var filters []plugins.StagedNetworkFilter
+	if policy.rbac != nil {
+		filter, err := newStagedNetworkFilter(rbacNetworkFilterName, policy.rbac, plugins.DuringStage(plugins.AuthZStage))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if policy.connectionRateLimit != nil {
+		// the stats of each filter chain are reported separately
+		rateLimit := proto.Clone(policy.connectionRateLimit).(*localratelimitnetworkv3.LocalRateLimit)
+		rateLimit.StatPrefix = pCtx.FilterChainName
+		filter, err := newStagedNetworkFilter(localRateLimitNetworkFilterName, rateLimit, plugins.DuringStage(plugins.RateLimitStage))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+func translateConnectionRateLimit(in *v1alpha1.TokenBucket) *localratelimitnetworkv3.LocalRateLimit {
+	if in == nil {
+		return nil
+	}
+
+	tokenBucket := &typev3.TokenBucket{
+		MaxTokens:    uint32(in.MaxTokens), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		FillInterval: durationpb.New(in.FillInterval.Duration),
+	}
+	if in.TokensPerFill != nil {
+		tokenBucket.TokensPerFill = wrapperspb.UInt32(uint32(*in.TokensPerFill)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	return &localratelimitnetworkv3.LocalRateLimit{
+		// the stat prefix is set to the filter chain name during translation
+		StatPrefix:  "tcp_connection_rate_limit",
+		TokenBucket: tokenBucket,
+	}
+}
+
+func newStagedNetworkFilter(
+	name string,
+	config proto.Message,
+	stage plugins.HTTPOrNetworkFilterStage,
+) (plugins.StagedNetworkFilter, error) {
+	typedConfig, err := utils.MessageToAny(config)
+	if err != nil {
+		return plugins.StagedNetworkFilter{}, err
+	}
+	return plugins.StagedNetworkFilter{
+		Filter: &envoylistenerv3.Filter{
+			Name: name,
+			ConfigType: &envoylistenerv3.Filter_TypedConfig{
+				TypedConfig: typedConfig,
+			},
+		},
+		Stage: stage,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// TODO: envoy-based AI gateway is deprecated in v2.1 and will be removed in v2.2. This file (and any associated tests) can be removed in v2.2.
+
+const (
+	// modelRoutingConfigHeader is the ext-proc metadata carrying the model routing config
+	// that the AI extension applies to the request body.
+	modelRoutingConfigHeader = "x-model-routing-config"
+	// modelRoutingConfigHashHeader is used by the AI extension to cache the parsed model routing config.
+	modelRoutingConfigHashHeader = "x-model-routing-config-hash"
+)
+
+// applyModelRouting configures the AI extension to route requests based on their model. The extension
+// selects the provider by setting the envoy.lb dynamic metadata matched by the subset load balancer of
+// the AI backend cluster, so the request body is buffered to hold the request until the provider is known.
+func applyModelRouting(mr *v1alpha1.AIModelRouting, extProcRouteSettings *envoy_ext_proc_v3.ExtProcPerRoute) error {
+	if mr == nil {
+		return nil
+	}
+
+	// Config needs to be defined in python ai extensions in the same format
+	bin, err := json.Marshal(mr)
+	if err != nil {
+		return err
+	}
+	configHash, err := hashUnique(mr, nil)
+	if err != nil {
+		return err
+	}
+	extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(),
+		&envoycorev3.HeaderValue{
+			Key:   modelRoutingConfigHeader,
+			Value: string(bin),
+		},
+		&envoycorev3.HeaderValue{
+			Key:   modelRoutingConfigHashHeader,
+			Value: fmt.Sprint(configHash),
+		},
+	)
+	extProcRouteSettings.GetOverrides().ProcessingMode = bufferedRequestProcessingMode()
+	return nil
+}
+
+// bufferedRequestProcessingMode returns the processing mode of the AI extension filter, with the request body
+// buffered so that the request headers are held until the extension has processed the body.
+func bufferedRequestProcessingMode() *envoy_ext_proc_v3.ProcessingMode {
+	return &envoy_ext_proc_v3.ProcessingMode{
+		RequestHeaderMode:   envoy_ext_proc_v3.ProcessingMode_SEND,
+		RequestBodyMode:     envoy_ext_proc_v3.ProcessingMode_BUFFERED,
+		RequestTrailerMode:  envoy_ext_proc_v3.ProcessingMode_SKIP,
+		ResponseHeaderMode:  envoy_ext_proc_v3.ProcessingMode_SEND,
+		ResponseBodyMode:    envoy_ext_proc_v3.ProcessingMode_STREAMED,
+		ResponseTrailerMode: envoy_ext_proc_v3.ProcessingMode_SKIP,
+	}
+}
@@ -0,0 +1,81 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+func TestApplyModelRouting(t *testing.T) {
+	modelRouting := &v1alpha1.AIModelRouting{
+		Routes: []v1alpha1.AIModelRoute{{
+			Models:   []string{"gpt-4o*"},
+			Provider: ptr.To[gwv1.SectionName]("azure"),
+			Model:    ptr.To("gpt-4o"),
+		}},
+		UnknownModelResponse: &v1alpha1.AIUnknownModelResponse{
+			StatusCode: ptr.To[int32](400),
+		},
+	}
+
+	t.Run("no model routing", func(t *testing.T) {
+		settings := &envoy_ext_proc_v3.ExtProcPerRoute{
+			Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+				Overrides: &envoy_ext_proc_v3.ExtProcOverrides{},
+			},
+		}
+		require.NoError(t, applyModelRouting(nil, settings))
+		assert.Empty(t, settings.GetOverrides().GetGrpcInitialMetadata())
+		assert.Nil(t, settings.GetOverrides().GetProcessingMode())
+	})
+
+	t.Run("sends the config to the AI extension and buffers the request body", func(t *testing.T) {
+		settings := &envoy_ext_proc_v3.ExtProcPerRoute{
+			Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+				Overrides: &envoy_ext_proc_v3.ExtProcOverrides{},
+			},
+		}
+		require.NoError(t, applyModelRouting(modelRouting, settings))
+
+		metadata := map[string]string{}
+		for _, h := range settings.GetOverrides().GetGrpcInitialMetadata() {
+			metadata[h.GetKey()] = h.GetValue()
+		}
+		assert.JSONEq(t,
+			`{"routes":[{"models":["gpt-4o*"],"provider":"azure","model":"gpt-4o"}],"unknownModelResponse":{"statusCode":400}}`,
+			metadata[modelRoutingConfigHeader])
+		assert.NotEmpty(t, metadata[modelRoutingConfigHashHeader])
+		assert.Equal(t, envoy_ext_proc_v3.ProcessingMode_BUFFERED, settings.GetOverrides().GetProcessingMode().GetRequestBodyMode())
+	})
+
+	t.Run("keeps the processing mode when merged with the backend config", func(t *testing.T) {
+		aiIR := &aiPolicyIR{}
+		require.NoError(t, preProcessAITrafficPolicy(&v1alpha1.AIPolicy{ModelRouting: modelRouting}, aiIR, testPolicy))
+
+		typedFilterConfig := ir.TypedFilterConfigMap(map[string]proto.Message{
+			wellknown.AIExtProcFilterName: &envoy_ext_proc_v3.ExtProcPerRoute{
+				Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+					Overrides: &envoy_ext_proc_v3.ExtProcOverrides{
+						GrpcInitialMetadata: []*envoycorev3.HeaderValue{{Key: "x-llm-provider", Value: "openai"}},
+					},
+				},
+			},
+		})
+		plugin := &trafficPolicyPluginGwPass{}
+		plugin.processAITrafficPolicy(&typedFilterConfig, aiIR, "", nil)
+
+		merged := typedFilterConfig.GetTypedConfig(wellknown.AIExtProcFilterName).(*envoy_ext_proc_v3.ExtProcPerRoute)
+		assert.Equal(t, envoy_ext_proc_v3.ProcessingMode_BUFFERED, merged.GetOverrides().GetProcessingMode().GetRequestBodyMode())
+		assert.Len(t, merged.GetOverrides().GetGrpcInitialMetadata(), 3)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -49,6 +50,8 @@ type aiPolicyIR struct {
 	Extproc *envoy_ext_proc_v3.ExtProcPerRoute
 	// Transformations coming from the AI policy
 	Transformation *envoytransformation.RouteTransformations
+	// GlobalTokenRateLimit is the token rate limit enforced by the rate limit service
+	GlobalTokenRateLimit *globalRateLimitIR
 }
 
 var _ PolicySubIR = &aiPolicyIR{}
@@ -82,6 +85,10 @@ func (a *aiPolicyIR) Equals(in PolicySubIR) bool {
 	if !proto.Equal(a.Transformation, inAI.Transformation) {
 		return false
 	}
+	// Check GlobalTokenRateLimit equality
+	if !a.GlobalTokenRateLimit.Equals(inAI.GlobalTokenRateLimit) {
+		return false
+	}
 
 	return true
 }
@@ -101,6 +108,9 @@ func (a *aiPolicyIR) Validate() error {
 			return err
 		}
 	}
+	if err := a.GlobalTokenRateLimit.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -109,6 +119,8 @@ func constructAI(
 	krtctx krt.HandlerContext,
 	policyCR *v1alpha1.TrafficPolicy,
 	secrets *krtcollections.SecretIndex,
+	backends *krtcollections.BackendIndex,
+	fetchGatewayExtension FetchGatewayExtensionFunc,
 	out *trafficPolicySpecIr,
 ) error {
 	if policyCR.Spec.AI == nil {
@@ -123,16 +135,32 @@ func constructAI(
 	}
 	ir.AISecret = secret
 	// Preprocess the AI backend
-	if err := preProcessAITrafficPolicy(policyCR.Spec.AI, ir); err != nil {
+	if err := preProcessAITrafficPolicy(policyCR.Spec.AI, ir, types.NamespacedName{Namespace: policyCR.Namespace, Name: policyCR.Name}); err != nil {
 		return fmt.Errorf("ai: %w", err)
 	}
+	semanticCache, err := constructSemanticCache(krtctx, policyCR, secrets, backends)
+	if err != nil {
+		return fmt.Errorf("ai: %w", err)
+	}
+	if err := applySemanticCache(semanticCache, ir.Extproc); err != nil {
+		return fmt.Errorf("ai: %w", err)
+	}
+	globalTokenRateLimit, err := constructGlobalTokenRateLimit(krtctx, policyCR, fetchGatewayExtension)
+	if err != nil {
+		return fmt.Errorf("ai: %w", err)
+	}
+	ir.GlobalTokenRateLimit = globalTokenRateLimit
 	out.ai = ir
 	return nil
 }
 
+// processAITrafficPolicy applies the AI policy to the route, or to the route backend, identified by route
+// and backends.
 func (p *trafficPolicyPluginGwPass) processAITrafficPolicy(
 	configMap *ir.TypedFilterConfigMap,
 	inIr *aiPolicyIR,
+	route string,
+	backends []string,
 ) {
 	if inIr.Transformation != nil {
 		configMap.AddTypedConfig(wellknown.AIPolicyTransformationFilterName, inIr.Transformation)
@@ -148,7 +176,11 @@ func (p *trafficPolicyPluginGwPass) processAITrafficPolicy(
 			grpcInitMd := clonedExtProcFromIR.GetOverrides().GetGrpcInitialMetadata()
 			grpcInitMd = append(grpcInitMd, inIr.Extproc.GetOverrides().GetGrpcInitialMetadata()...)
 			clonedExtProcFromIR.GetOverrides().GrpcInitialMetadata = grpcInitMd
+			if mode := inIr.Extproc.GetOverrides().GetProcessingMode(); mode != nil {
+				clonedExtProcFromIR.GetOverrides().ProcessingMode = proto.Clone(mode).(*envoy_ext_proc_v3.ProcessingMode)
+			}
 		}
+		setSemanticCachePartition(clonedExtProcFromIR, route, backends)
 		configMap.AddTypedConfig(wellknown.AIExtProcFilterName, clonedExtProcFromIR)
 	}
 }
@@ -156,6 +188,7 @@ func (p *trafficPolicyPluginGwPass) processAITrafficPolicy(
 func preProcessAITrafficPolicy(
 	aiConfig *v1alpha1.AIPolicy,
 	ir *aiPolicyIR,
+	policy types.NamespacedName,
 ) error {
 	// Setup initial transformation template and extproc settings. The extproc is configured by the route policy and backend.
 	transformationTemplate := initTransformationTemplate()
@@ -165,20 +198,21 @@ func preProcessAITrafficPolicy(
 		},
 	}
 
-	// If the route options specify this as a chat streaming route, add a header to the ext-proc request
-	if aiConfig.RouteType != nil && *aiConfig.RouteType == v1alpha1.CHAT_STREAMING {
-		// append streaming header if it's a streaming route
-		extprocSettings.GetOverrides().GrpcInitialMetadata = append(extprocSettings.GetOverrides().GetGrpcInitialMetadata(), &envoycorev3.HeaderValue{
-			Key:   "x-chat-streaming",
-			Value: "true",
-		})
-		transformationTemplate.DynamicMetadataValues = append(transformationTemplate.GetDynamicMetadataValues(), &envoytransformation.TransformationTemplate_DynamicMetadataValue{
-			Key:   "route_type",
-			Value: &envoytransformation.InjaTemplate{Text: "CHAT_STREAMING"},
-		})
+	if err := applyRouteType(ptr.Deref(aiConfig.RouteType, v1alpha1.CHAT), extprocSettings, transformationTemplate); err != nil {
+		return err
+	}
+
+	if aiConfig.PromptEnrichment != nil && aiConfig.RouteType != nil &&
+		*aiConfig.RouteType != v1alpha1.CHAT && *aiConfig.RouteType != v1alpha1.CHAT_STREAMING {
+		return fmt.Errorf("prompt enrichment is only supported for the %s and %s route types", v1alpha1.CHAT, v1alpha1.CHAT_STREAMING)
 	}
 
-	err := handleAITrafficPolicy(aiConfig, extprocSettings, transformationTemplate, ir.AISecret)
+	if aiConfig.SemanticCache != nil && aiConfig.SemanticCache.Disable == nil && aiConfig.RouteType != nil &&
+		*aiConfig.RouteType != v1alpha1.CHAT && *aiConfig.RouteType != v1alpha1.AUTO {
+		return fmt.Errorf("semantic cache is only supported for the %s and %s route types", v1alpha1.CHAT, v1alpha1.AUTO)
+	}
+
+	err := handleAITrafficPolicy(policy, aiConfig, extprocSettings, transformationTemplate, ir.AISecret)
 	if err != nil {
 		return err
 	}
@@ -206,6 +240,46 @@ func preProcessAITrafficPolicy(
 	return nil
 }
 
+// applyRouteType passes the route type to the ext-proc server, which parses the requests and responses of the route type,
+// and to the backend transformation, which rewrites the path to the API of the route type.
+func applyRouteType(
+	routeType v1alpha1.RouteType,
+	extProcRouteSettings *envoy_ext_proc_v3.ExtProcPerRoute,
+	transformation *envoytransformation.TransformationTemplate,
+) error {
+	routeTypeTemplate := string(routeType)
+	switch routeType {
+	case v1alpha1.CHAT:
+		// chat is the default route type of the ext-proc server and backend
+		return nil
+	case v1alpha1.CHAT_STREAMING:
+		// append streaming header if it's a streaming route
+		extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(), &envoycorev3.HeaderValue{
+			Key:   "x-chat-streaming",
+			Value: "true",
+		})
+	case v1alpha1.COMPLETIONS, v1alpha1.EMBEDDINGS, v1alpha1.RESPONSES, v1alpha1.IMAGES:
+		extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(), &envoycorev3.HeaderValue{
+			Key:   "x-route-type",
+			Value: string(routeType),
+		})
+	case v1alpha1.AUTO:
+		extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(), &envoycorev3.HeaderValue{
+			Key:   "x-route-type",
+			Value: string(routeType),
+		})
+		// the ext-proc server detects the route type from the request path
+		routeTypeTemplate = `{{dynamic_metadata("route_type","ai.kgateway.io")}}`
+	default:
+		return fmt.Errorf("unsupported route type %s", routeType)
+	}
+	transformation.DynamicMetadataValues = append(transformation.GetDynamicMetadataValues(), &envoytransformation.TransformationTemplate_DynamicMetadataValue{
+		Key:   "route_type",
+		Value: &envoytransformation.InjaTemplate{Text: routeTypeTemplate},
+	})
+	return nil
+}
+
 func initTransformationTemplate() *envoytransformation.TransformationTemplate {
 	transformationTemplate := &envoytransformation.TransformationTemplate{
 		// We will add the auth token later
@@ -220,6 +294,7 @@ func initTransformationTemplate() *envoytransformation.TransformationTemplate {
 }
 
 func handleAITrafficPolicy(
+	policy types.NamespacedName,
 	aiConfig *v1alpha1.AIPolicy,
 	extProcRouteSettings *envoy_ext_proc_v3.ExtProcPerRoute,
 	transformation *envoytransformation.TransformationTemplate,
@@ -237,6 +312,14 @@ func handleAITrafficPolicy(
 		return err
 	}
 
+	if err := applyLocalTokenRateLimit(policy, aiConfig.TokenRateLimit, extProcRouteSettings); err != nil {
+		return err
+	}
+
+	if err := applyModelRouting(aiConfig.ModelRouting, extProcRouteSettings); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -194,9 +194,9 @@ func TestProcessAITrafficPolicy(t *testing.T) {
 		}
 
 		// Execute
-		err := preProcessAITrafficPolicy(aiConfig, aiIR)
+		err := preProcessAITrafficPolicy(aiConfig, aiIR, testPolicy)
 		require.NoError(t, err)
-		plugin.processAITrafficPolicy(&typedFilterConfig, aiIR)
+		plugin.processAITrafficPolicy(&typedFilterConfig, aiIR, "", nil)
 
 		// Verify streaming header was added
 		extprocSettingsPostPlugin := typedFilterConfig.GetTypedConfig(wellknown.AIExtProcFilterName).(*envoy_ext_proc_v3.ExtProcPerRoute)
@@ -231,10 +231,10 @@ func TestProcessAITrafficPolicy(t *testing.T) {
 		defer os.Setenv(AiDebugTransformations, oldEnv)
 
 		// Execute
-		err := preProcessAITrafficPolicy(aiConfig, aiIR)
+		err := preProcessAITrafficPolicy(aiConfig, aiIR, testPolicy)
 		require.NoError(t, err)
 
-		plugin.processAITrafficPolicy(&typedFilterConfig, aiIR)
+		plugin.processAITrafficPolicy(&typedFilterConfig, aiIR, "", nil)
 
 		// Verify
 		require.NoError(t, err)
@@ -269,10 +269,10 @@ func TestProcessAITrafficPolicy(t *testing.T) {
 			AISecret: aiSecret,
 		}
 		// Execute
-		err := preProcessAITrafficPolicy(aiConfig, aiIR)
+		err := preProcessAITrafficPolicy(aiConfig, aiIR, testPolicy)
 		require.NoError(t, err)
 
-		plugin.processAITrafficPolicy(&typedFilterConfig, aiIR)
+		plugin.processAITrafficPolicy(&typedFilterConfig, aiIR, "", nil)
 
 		routeTransformations, ok := typedFilterConfig.GetTypedConfig(wellknown.AIPolicyTransformationFilterName).(*envoytransformation.RouteTransformations)
 		assert.True(t, ok)
@@ -319,10 +319,10 @@ func TestProcessAITrafficPolicy(t *testing.T) {
 		}
 
 		// Execute
-		err := preProcessAITrafficPolicy(aiConfig, aiIR)
+		err := preProcessAITrafficPolicy(aiConfig, aiIR, testPolicy)
 		require.NoError(t, err)
 
-		plugin.processAITrafficPolicy(&typedFilterConfig, aiIR)
+		plugin.processAITrafficPolicy(&typedFilterConfig, aiIR, "", nil)
 
 		// Check that the guardrails config headers were added
 		foundReqConfig := false
@@ -380,7 +380,7 @@ func TestProcessAITrafficPolicy(t *testing.T) {
 		}
 
 		// Execute
-		err := preProcessAITrafficPolicy(aiConfig, aiIR)
+		err := preProcessAITrafficPolicy(aiConfig, aiIR, testPolicy)
 
 		// Verify
 		require.Error(t, err)
@@ -516,13 +516,13 @@ func TestDefault(t *testing.T) {
 				AISecret: aiSecret,
 			}
 			// Execute
-			err := preProcessAITrafficPolicy(tt.aiConfig, aiIR)
+			err := preProcessAITrafficPolicy(tt.aiConfig, aiIR, testPolicy)
 			if tt.err != nil {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
 
-				plugin.processAITrafficPolicy(&typedFilterConfig, aiIR)
+				plugin.processAITrafficPolicy(&typedFilterConfig, aiIR, "", nil)
 
 				routeTransformations, ok := typedFilterConfig.GetTypedConfig(wellknown.AIPolicyTransformationFilterName).(*envoytransformation.RouteTransformations)
 				assert.True(t, ok)
@@ -542,6 +542,71 @@ func TestDefault(t *testing.T) {
 	}
 }
 
+func TestApplyRouteType(t *testing.T) {
+	tests := []struct {
+		name              string
+		routeType         v1alpha1.RouteType
+		expectedMetadata  []*envoycorev3.HeaderValue
+		expectedRouteType string
+	}{
+		{
+			name:      "chat is the default route type",
+			routeType: v1alpha1.CHAT,
+		},
+		{
+			name:              "chat streaming",
+			routeType:         v1alpha1.CHAT_STREAMING,
+			expectedMetadata:  []*envoycorev3.HeaderValue{{Key: "x-chat-streaming", Value: "true"}},
+			expectedRouteType: "CHAT_STREAMING",
+		},
+		{
+			name:              "embeddings",
+			routeType:         v1alpha1.EMBEDDINGS,
+			expectedMetadata:  []*envoycorev3.HeaderValue{{Key: "x-route-type", Value: "EMBEDDINGS"}},
+			expectedRouteType: "EMBEDDINGS",
+		},
+		{
+			name:              "auto uses the route type detected by the ext-proc server",
+			routeType:         v1alpha1.AUTO,
+			expectedMetadata:  []*envoycorev3.HeaderValue{{Key: "x-route-type", Value: "AUTO"}},
+			expectedRouteType: `{{dynamic_metadata("route_type","ai.kgateway.io")}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extprocSettings := &envoy_ext_proc_v3.ExtProcPerRoute{
+				Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+					Overrides: &envoy_ext_proc_v3.ExtProcOverrides{},
+				},
+			}
+			transformationTemplate := initTransformationTemplate()
+
+			require.NoError(t, applyRouteType(tt.routeType, extprocSettings, transformationTemplate))
+
+			assert.Equal(t, tt.expectedMetadata, extprocSettings.GetOverrides().GetGrpcInitialMetadata())
+			if tt.expectedRouteType == "" {
+				assert.Empty(t, transformationTemplate.GetDynamicMetadataValues())
+				return
+			}
+			require.Len(t, transformationTemplate.GetDynamicMetadataValues(), 1)
+			assert.Equal(t, "route_type", transformationTemplate.GetDynamicMetadataValues()[0].GetKey())
+			assert.Equal(t, tt.expectedRouteType, transformationTemplate.GetDynamicMetadataValues()[0].GetValue().GetText())
+		})
+	}
+
+	t.Run("rejects prompt enrichment for non chat route types", func(t *testing.T) {
+		aiConfig := &v1alpha1.AIPolicy{
+			RouteType: ptr.To(v1alpha1.EMBEDDINGS),
+			PromptEnrichment: &v1alpha1.AIPromptEnrichment{
+				Prepend: []v1alpha1.Message{{Role: "system", Content: "be nice"}},
+			},
+		}
+		err := preProcessAITrafficPolicy(aiConfig, &aiPolicyIR{}, testPolicy)
+		assert.ErrorContains(t, err, "prompt enrichment is only supported")
+	})
+}
+
 // Mock implementation of RouteBackendContext for testing
 func (ir *RouteBackendContext) NewRouteBackendContext() *RouteBackendContext {
 	return &RouteBackendContext{
@@ -0,0 +1,268 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+// TODO: envoy-based AI gateway is deprecated in v2.1 and will be removed in v2.2. This file (and any associated tests) can be removed in v2.2.
+
+const (
+	// semanticCacheConfigHeader is the ext-proc metadata carrying the semantic cache config
+	// that the AI extension applies to the requests and responses.
+	semanticCacheConfigHeader = "x-semantic-cache-config"
+	// semanticCacheConfigHashHeader is used by the AI extension to reuse the clients of the semantic cache.
+	semanticCacheConfigHashHeader = "x-semantic-cache-config-hash"
+	// semanticCachePartitionHeader is the ext-proc metadata carrying the policy, route and backends
+	// that the responses are cached for.
+	semanticCachePartitionHeader = "x-semantic-cache-partition"
+	// semanticCachePartitionKeyHeader is the ext-proc metadata carrying the value of the request
+	// that additionally partitions the cached responses.
+	semanticCachePartitionKeyHeader = "x-semantic-cache-partition-key"
+
+	defaultSemanticCacheIndexPrefix         = "kgateway-semantic-cache"
+	defaultSemanticCacheEmbeddingModel      = "text-embedding-3-small"
+	defaultSemanticCacheSimilarityThreshold = 95
+	defaultSemanticCacheTTL                 = time.Hour
+)
+
+// semanticCacheConfig is the semantic cache config sent to the AI extension, with the references
+// to the vector store and to the secrets resolved. It must be defined in the python AI extension
+// in the same format.
+type semanticCacheConfig struct {
+	Redis               *redisVectorStoreConfig      `json:"redis,omitempty"`
+	Embedding           semanticCacheEmbeddingConfig `json:"embedding"`
+	SimilarityThreshold float64                      `json:"similarityThreshold"`
+	TTLSeconds          int64                        `json:"ttlSeconds"`
+	// PartitionByKey is set if the cached responses are partitioned by a value of the request,
+	// in which case the requests without this value are not cached.
+	PartitionByKey bool `json:"partitionByKey,omitempty"`
+
+	// policy is the policy the semantic cache is configured by, which partitions the cached responses.
+	policy string
+	// partitionKey is the Envoy command operator rendering the value of the request that partitions
+	// the cached responses, if any.
+	partitionKey string
+}
+
+type redisVectorStoreConfig struct {
+	Host     string `json:"host"`
+	Port     int32  `json:"port"`
+	Index    string `json:"index"`
+	Password string `json:"password,omitempty"`
+}
+
+type semanticCacheEmbeddingConfig struct {
+	OpenAI *openAIEmbeddingConfig `json:"openai,omitempty"`
+}
+
+type openAIEmbeddingConfig struct {
+	AuthToken string `json:"authToken"`
+	Model     string `json:"model"`
+}
+
+// constructSemanticCache resolves the vector store and the secrets referenced by the semantic cache
+// of the AI policy. It returns nil if the semantic cache is not configured or is disabled.
+func constructSemanticCache(
+	krtctx krt.HandlerContext,
+	policyCR *v1alpha1.TrafficPolicy,
+	secrets *krtcollections.SecretIndex,
+	backends *krtcollections.BackendIndex,
+) (*semanticCacheConfig, error) {
+	sc := policyCR.Spec.AI.SemanticCache
+	if sc == nil || sc.Disable != nil {
+		return nil, nil
+	}
+	if sc.Store == nil || sc.Embedding == nil {
+		return nil, errors.New("semantic cache store and embedding must be set")
+	}
+
+	config := &semanticCacheConfig{
+		SimilarityThreshold: float64(ptr.Deref(sc.SimilarityThreshold, defaultSemanticCacheSimilarityThreshold)) / 100,
+		TTLSeconds:          int64(defaultSemanticCacheTTL.Seconds()),
+		policy:              fmt.Sprintf("%s/%s", policyCR.GetNamespace(), policyCR.GetName()),
+	}
+	if sc.TTL != nil {
+		config.TTLSeconds = int64(sc.TTL.Seconds())
+	}
+	if key := sc.PartitionKey; key != nil {
+		switch {
+		case key.Header != nil:
+			config.partitionKey = fmt.Sprintf("%%REQ(%s)%%", *key.Header)
+		case key.JWTClaim != nil:
+			config.partitionKey = fmt.Sprintf("%%DYNAMIC_METADATA(%s:%s:%s)%%", jwtAuthnFilterName, jwtPayloadMetadataKey, *key.JWTClaim)
+		default:
+			return nil, errors.New("semantic cache partition key must set a header or a JWT claim")
+		}
+		config.PartitionByKey = true
+	}
+
+	redis := sc.Store.Redis
+	if redis == nil {
+		return nil, errors.New("redis vector store must be set for semantic cache")
+	}
+	gk := wellknown.TrafficPolicyGVK.GroupKind()
+	objectSource := ir.ObjectSource{
+		Group:     gk.Group,
+		Kind:      gk.Kind,
+		Namespace: policyCR.GetNamespace(),
+		Name:      policyCR.GetName(),
+	}
+	backend, err := backends.GetBackendFromRef(krtctx, objectSource, redis.BackendRef)
+	if err != nil {
+		return nil, fmt.Errorf("semantic cache store: %w", err)
+	}
+	host, port, err := semanticCacheStoreAddress(backend)
+	if err != nil {
+		return nil, fmt.Errorf("semantic cache store: %w", err)
+	}
+	config.Redis = &redisVectorStoreConfig{
+		Host:  host,
+		Port:  port,
+		// each policy has its own index by default, as the namespaces cannot contain dots
+		Index: ptr.Deref(redis.Index, fmt.Sprintf("%s.%s.%s", defaultSemanticCacheIndexPrefix, policyCR.GetNamespace(), policyCR.GetName())),
+	}
+	if redis.AuthToken != nil {
+		password, err := resolveAuthToken(krtctx, secrets, *redis.AuthToken, policyCR.GetNamespace())
+		if err != nil {
+			return nil, fmt.Errorf("semantic cache store: %w", err)
+		}
+		config.Redis.Password = password
+	}
+
+	openAI := sc.Embedding.OpenAI
+	if openAI == nil {
+		return nil, errors.New("OpenAI embedding config must be set for semantic cache")
+	}
+	token, err := resolveAuthToken(krtctx, secrets, openAI.AuthToken, policyCR.GetNamespace())
+	if err != nil {
+		return nil, fmt.Errorf("semantic cache embedding: %w", err)
+	}
+	config.Embedding.OpenAI = &openAIEmbeddingConfig{
+		AuthToken: token,
+		Model:     ptr.Deref(openAI.Model, defaultSemanticCacheEmbeddingModel),
+	}
+	return config, nil
+}
+
+// semanticCacheStoreAddress returns the address that the AI extension connects to the vector store on.
+// The AI extension runs next to Envoy and connects to the store directly, so the backend must
+// have a single address, such as a Kubernetes Service or a static Backend with one host.
+func semanticCacheStoreAddress(backend *ir.BackendObjectIR) (string, int32, error) {
+	if be, ok := backend.Obj.(*v1alpha1.Backend); ok {
+		if be.Spec.Type != v1alpha1.BackendTypeStatic || be.Spec.Static == nil || len(be.Spec.Static.Hosts) != 1 {
+			return "", 0, fmt.Errorf("backend %s must be a static backend with a single host", backend.ResourceName())
+		}
+		h := be.Spec.Static.Hosts[0]
+		return h.Host, int32(h.Port), nil
+	}
+	if backend.CanonicalHostname == "" || backend.Port == 0 {
+		return "", 0, fmt.Errorf("backend %s does not have a hostname and a port", backend.ResourceName())
+	}
+	return backend.CanonicalHostname, backend.Port, nil
+}
+
+// resolveAuthToken returns the value of the token, which is read from the secret in the namespace
+// of the policy if it references one. The cached responses are shared by the clients of the route,
+// so the tokens of the clients cannot be passed through.
+func resolveAuthToken(
+	krtctx krt.HandlerContext,
+	secrets *krtcollections.SecretIndex,
+	token v1alpha1.SingleAuthToken,
+	ns string,
+) (string, error) {
+	if token.Kind == v1alpha1.Passthrough {
+		return "", errors.New("passthrough auth tokens are not supported")
+	}
+	var secret *ir.Secret
+	if token.Kind == v1alpha1.SecretRef && token.SecretRef != nil {
+		var err error
+		secret, err = pluginutils.GetSecretIr(secrets, krtctx, token.SecretRef.Name, ns)
+		if err != nil {
+			return "", err
+		}
+	}
+	return pluginutils.GetAuthToken(token, secret)
+}
+
+// applySemanticCache configures the AI extension to serve the cached responses of similar prompts, and
+// to cache the responses of the LLM provider. The request body is buffered so that a cached response
+// is returned before the request is sent to the LLM provider.
+func applySemanticCache(config *semanticCacheConfig, extProcRouteSettings *envoy_ext_proc_v3.ExtProcPerRoute) error {
+	if config == nil {
+		return nil
+	}
+
+	bin, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	configHash, err := hashUnique(config, nil)
+	if err != nil {
+		return err
+	}
+	extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(),
+		&envoycorev3.HeaderValue{
+			Key:   semanticCacheConfigHeader,
+			Value: string(bin),
+		},
+		&envoycorev3.HeaderValue{
+			Key:   semanticCacheConfigHashHeader,
+			Value: fmt.Sprint(configHash),
+		},
+		// completed with the route and the backends by setSemanticCachePartition
+		&envoycorev3.HeaderValue{
+			Key:   semanticCachePartitionHeader,
+			Value: config.policy,
+		},
+	)
+	if config.partitionKey != "" {
+		extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(),
+			&envoycorev3.HeaderValue{
+				Key:   semanticCachePartitionKeyHeader,
+				Value: config.partitionKey,
+			},
+		)
+	}
+	extProcRouteSettings.GetOverrides().ProcessingMode = bufferedRequestProcessingMode()
+	return nil
+}
+
+// setSemanticCachePartition completes the semantic cache partition of the policy with the route and the
+// backends that the policy is applied to, so that the cached responses are only served to the requests
+// of the same route and backends.
+func setSemanticCachePartition(extProcRouteSettings *envoy_ext_proc_v3.ExtProcPerRoute, route string, backends []string) {
+	metadata := extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata()
+	for i, header := range metadata {
+		if header.GetKey() != semanticCachePartitionHeader {
+			continue
+		}
+		// the header may be shared with the policy IR, so it is replaced rather than updated
+		metadata[i] = &envoycorev3.HeaderValue{
+			Key:   semanticCachePartitionHeader,
+			Value: strings.Join(append([]string{header.GetValue(), route}, backends...), ";"),
+		}
+	}
+}
+
+// routeResourceName returns the name of the route of the route rule, which partitions the cached responses.
+func routeResourceName(in ir.HttpRouteRuleMatchIR) string {
+	if in.Parent == nil {
+		return ""
+	}
+	return in.Parent.ResourceName()
+}
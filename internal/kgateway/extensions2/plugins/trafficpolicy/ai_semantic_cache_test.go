@@ -0,0 +1,194 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestApplySemanticCache(t *testing.T) {
+	t.Run("no semantic cache", func(t *testing.T) {
+		settings := &envoy_ext_proc_v3.ExtProcPerRoute{
+			Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+				Overrides: &envoy_ext_proc_v3.ExtProcOverrides{},
+			},
+		}
+		require.NoError(t, applySemanticCache(nil, settings))
+		assert.Empty(t, settings.GetOverrides().GetGrpcInitialMetadata())
+		assert.Nil(t, settings.GetOverrides().GetProcessingMode())
+	})
+
+	t.Run("sends the config to the AI extension and buffers the request body", func(t *testing.T) {
+		settings := &envoy_ext_proc_v3.ExtProcPerRoute{
+			Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+				Overrides: &envoy_ext_proc_v3.ExtProcOverrides{},
+			},
+		}
+		config := &semanticCacheConfig{
+			Redis: &redisVectorStoreConfig{
+				Host:  "redis.default.svc.cluster.local",
+				Port:  6379,
+				Index: "kgateway-semantic-cache.default.policy",
+			},
+			Embedding: semanticCacheEmbeddingConfig{
+				OpenAI: &openAIEmbeddingConfig{
+					AuthToken: "token",
+					Model:     defaultSemanticCacheEmbeddingModel,
+				},
+			},
+			SimilarityThreshold: 0.9,
+			TTLSeconds:          600,
+			policy:              "default/policy",
+		}
+		require.NoError(t, applySemanticCache(config, settings))
+		setSemanticCachePartition(settings, "gateway.networking.k8s.io/HTTPRoute/default/route", []string{"backend"})
+
+		metadata := map[string]string{}
+		for _, h := range settings.GetOverrides().GetGrpcInitialMetadata() {
+			metadata[h.GetKey()] = h.GetValue()
+		}
+		assert.JSONEq(t,
+			`{
+				"redis":{"host":"redis.default.svc.cluster.local","port":6379,"index":"kgateway-semantic-cache.default.policy"},
+				"embedding":{"openai":{"authToken":"token","model":"text-embedding-3-small"}},
+				"similarityThreshold":0.9,
+				"ttlSeconds":600
+			}`,
+			metadata[semanticCacheConfigHeader])
+		assert.NotEmpty(t, metadata[semanticCacheConfigHashHeader])
+		assert.Equal(t, "default/policy;gateway.networking.k8s.io/HTTPRoute/default/route;backend", metadata[semanticCachePartitionHeader])
+		assert.NotContains(t, metadata, semanticCachePartitionKeyHeader)
+		assert.Equal(t, envoy_ext_proc_v3.ProcessingMode_BUFFERED, settings.GetOverrides().GetProcessingMode().GetRequestBodyMode())
+	})
+
+	t.Run("partitions the cache by a value of the request", func(t *testing.T) {
+		for _, tt := range []struct {
+			name         string
+			partitionKey string
+		}{
+			{name: "header", partitionKey: "%REQ(x-tenant)%"},
+			{name: "jwt claim", partitionKey: "%DYNAMIC_METADATA(envoy.filters.http.jwt_authn:payload:sub)%"},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				settings := &envoy_ext_proc_v3.ExtProcPerRoute{
+					Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+						Overrides: &envoy_ext_proc_v3.ExtProcOverrides{},
+					},
+				}
+				config := &semanticCacheConfig{
+					PartitionByKey: true,
+					policy:         "default/policy",
+					partitionKey:   tt.partitionKey,
+				}
+				require.NoError(t, applySemanticCache(config, settings))
+
+				metadata := map[string]string{}
+				for _, h := range settings.GetOverrides().GetGrpcInitialMetadata() {
+					metadata[h.GetKey()] = h.GetValue()
+				}
+				assert.Contains(t, metadata[semanticCacheConfigHeader], `"partitionByKey":true`)
+				assert.Equal(t, tt.partitionKey, metadata[semanticCachePartitionKeyHeader])
+			})
+		}
+	})
+}
+
+func TestSemanticCacheStoreAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		backend  ir.BackendObjectIR
+		wantHost string
+		wantPort int32
+		wantErr  string
+	}{
+		{
+			name: "service",
+			backend: func() ir.BackendObjectIR {
+				b := ir.NewBackendObjectIR(ir.ObjectSource{Kind: "Service", Namespace: "default", Name: "redis"}, 6379, "")
+				b.CanonicalHostname = "redis.default.svc.cluster.local"
+				return b
+			}(),
+			wantHost: "redis.default.svc.cluster.local",
+			wantPort: 6379,
+		},
+		{
+			name: "static backend",
+			backend: func() ir.BackendObjectIR {
+				b := ir.NewBackendObjectIR(ir.ObjectSource{Kind: "Backend", Namespace: "default", Name: "redis"}, 0, "")
+				b.Obj = &v1alpha1.Backend{
+					Spec: v1alpha1.BackendSpec{
+						Type: v1alpha1.BackendTypeStatic,
+						Static: &v1alpha1.StaticBackend{
+							Hosts: []v1alpha1.Host{{Host: "redis.example.com", Port: 6380}},
+						},
+					},
+				}
+				return b
+			}(),
+			wantHost: "redis.example.com",
+			wantPort: 6380,
+		},
+		{
+			name: "static backend with several hosts",
+			backend: func() ir.BackendObjectIR {
+				b := ir.NewBackendObjectIR(ir.ObjectSource{Kind: "Backend", Namespace: "default", Name: "redis"}, 0, "")
+				b.Obj = &v1alpha1.Backend{
+					Spec: v1alpha1.BackendSpec{
+						Type: v1alpha1.BackendTypeStatic,
+						Static: &v1alpha1.StaticBackend{
+							Hosts: []v1alpha1.Host{{Host: "redis-0", Port: 6379}, {Host: "redis-1", Port: 6379}},
+						},
+					},
+				}
+				return b
+			}(),
+			wantErr: "must be a static backend with a single host",
+		},
+		{
+			name:    "no hostname",
+			backend: ir.NewBackendObjectIR(ir.ObjectSource{Kind: "Service", Namespace: "default", Name: "redis"}, 6379, ""),
+			wantErr: "does not have a hostname and a port",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, port, err := semanticCacheStoreAddress(&tt.backend)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHost, host)
+			assert.Equal(t, tt.wantPort, port)
+		})
+	}
+}
+
+func TestSemanticCacheRouteType(t *testing.T) {
+	semanticCache := &v1alpha1.AISemanticCache{
+		Store:     &v1alpha1.AIVectorStore{Redis: &v1alpha1.RedisVectorStore{}},
+		Embedding: &v1alpha1.AIEmbedding{OpenAI: &v1alpha1.OpenAIConfig{}},
+	}
+
+	err := preProcessAITrafficPolicy(&v1alpha1.AIPolicy{
+		SemanticCache: semanticCache,
+		RouteType:     ptr.To(v1alpha1.EMBEDDINGS),
+	}, &aiPolicyIR{}, testPolicy)
+	require.ErrorContains(t, err, "semantic cache is only supported for the CHAT and AUTO route types")
+
+	require.NoError(t, preProcessAITrafficPolicy(&v1alpha1.AIPolicy{
+		SemanticCache: semanticCache,
+		RouteType:     ptr.To(v1alpha1.AUTO),
+	}, &aiPolicyIR{}, testPolicy))
+
+	require.NoError(t, preProcessAITrafficPolicy(&v1alpha1.AIPolicy{
+		SemanticCache: &v1alpha1.AISemanticCache{Disable: &v1alpha1.PolicyDisable{}},
+		RouteType:     ptr.To(v1alpha1.EMBEDDINGS),
+	}, &aiPolicyIR{}, testPolicy))
+}
@@ -0,0 +1,162 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	"google.golang.org/protobuf/proto"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+// TODO: envoy-based AI gateway is deprecated in v2.1 and will be removed in v2.2. This file (and any associated tests) can be removed in v2.2.
+
+const (
+	// aiTotalTokensHitsAddend charges a request for the total tokens that the AI extension
+	// parses from the response and sets in the dynamic metadata.
+	aiTotalTokensHitsAddend = "%DYNAMIC_METADATA(ai.kgateway.io:total_tokens)%"
+
+	// tokenRateLimitConfigHeader is the ext-proc metadata carrying the local token bucket config
+	// that the AI extension enforces.
+	tokenRateLimitConfigHeader = "x-token-ratelimit-config"
+	// tokenRateLimitConfigHashHeader is used by the AI extension to key the token bucket of the policy.
+	tokenRateLimitConfigHashHeader = "x-token-ratelimit-config-hash"
+)
+
+// tokenBucketKey identifies the token bucket of a policy in the AI extension. The policy is part of
+// the key so that policies with the same limits do not share a bucket.
+type tokenBucketKey struct {
+	Policy types.NamespacedName
+	Config tokenBucketConfig
+}
+
+// tokenBucketConfig is the local token bucket config sent to the AI extension.
+// It must be defined in the python AI extension in the same format.
+type tokenBucketConfig struct {
+	MaxTokens      int32 `json:"maxTokens"`
+	TokensPerFill  int32 `json:"tokensPerFill"`
+	FillIntervalMs int64 `json:"fillIntervalMs"`
+}
+
+// applyLocalTokenRateLimit configures the AI extension to enforce the local token rate limit, as the
+// token counts are only known to the extension once it parses the response of the LLM provider.
+// The token bucket is shared by all the routes the policy applies to.
+func applyLocalTokenRateLimit(policy types.NamespacedName, trl *v1alpha1.RateLimit, extProcRouteSettings *envoy_ext_proc_v3.ExtProcPerRoute) error {
+	if trl == nil || trl.Local == nil || trl.Local.TokenBucket == nil {
+		return nil
+	}
+
+	config := tokenBucketConfig{
+		MaxTokens:      trl.Local.TokenBucket.MaxTokens,
+		TokensPerFill:  ptr.Deref(trl.Local.TokenBucket.TokensPerFill, 1),
+		FillIntervalMs: trl.Local.TokenBucket.FillInterval.Milliseconds(),
+	}
+	bin, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	configHash, err := hashUnique(tokenBucketKey{Policy: policy, Config: config}, nil)
+	if err != nil {
+		return err
+	}
+	extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(),
+		&envoycorev3.HeaderValue{
+			Key:   tokenRateLimitConfigHeader,
+			Value: string(bin),
+		},
+		&envoycorev3.HeaderValue{
+			Key:   tokenRateLimitConfigHashHeader,
+			Value: fmt.Sprint(configHash),
+		},
+	)
+	return nil
+}
+
+// constructGlobalTokenRateLimit constructs the global token rate limit of the AI policy.
+// The budget is checked when the request starts, and the tokens of the request are charged once
+// the stream completes, when the AI extension has counted them. The rate limit service counts at
+// least one hit per descriptor, so the check also charges the request one token.
+func constructGlobalTokenRateLimit(
+	krtctx krt.HandlerContext,
+	policyCR *v1alpha1.TrafficPolicy,
+	fetchGatewayExtension FetchGatewayExtensionFunc,
+) (*globalRateLimitIR, error) {
+	trl := policyCR.Spec.AI.TokenRateLimit
+	if trl == nil || trl.Global == nil {
+		return nil, nil
+	}
+
+	actions, err := createRateLimitActions(trl.Global.Descriptors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token rate limit actions: %w", err)
+	}
+	gwExtIR, err := fetchGatewayExtension(krtctx, trl.Global.ExtensionRef, policyCR.GetNamespace())
+	if err != nil {
+		return nil, fmt.Errorf("token ratelimit: %w", err)
+	}
+	if gwExtIR.ExtType != v1alpha1.GatewayExtensionTypeRateLimit || gwExtIR.RateLimit == nil {
+		return nil, pluginutils.ErrInvalidExtensionType(v1alpha1.GatewayExtensionTypeRateLimit, gwExtIR.ExtType)
+	}
+
+	return &globalRateLimitIR{
+		provider: gwExtIR,
+		rateLimitActions: []*envoyroutev3.RateLimit{
+			{
+				Actions: actions,
+			},
+			{
+				Actions: actions,
+				HitsAddend: &envoyroutev3.RateLimit_HitsAddend{
+					Format: aiTotalTokensHitsAddend,
+				},
+				ApplyOnStreamDone: true,
+			},
+		},
+	}, nil
+}
+
+// handleAITokenRateLimit adds the global token rate limit of the AI policy to the route
+func (p *trafficPolicyPluginGwPass) handleAITokenRateLimit(fcn string, typedFilterConfig *ir.TypedFilterConfigMap, inIr *aiPolicyIR) {
+	if inIr.GlobalTokenRateLimit == nil {
+		return
+	}
+	p.addRateLimitsPerRoute(fcn, typedFilterConfig, inIr.GlobalTokenRateLimit)
+}
+
+// addRateLimitsPerRoute enables the rate limit filter of the provider on the route and adds the rate limits
+// to the route config of the filter. The request and token rate limits of a policy may share the provider,
+// in which case both sets of rate limits are applied.
+func (p *trafficPolicyPluginGwPass) addRateLimitsPerRoute(fcn string, typedFilterConfig *ir.TypedFilterConfigMap, rateLimit *globalRateLimitIR) {
+	providerName := rateLimit.provider.ResourceName()
+	p.rateLimitPerProvider.Add(fcn, providerName, rateLimit.provider)
+
+	filterName := getRateLimitFilterName(providerName)
+	rateLimitPerRoute := &ratev3.RateLimitPerRoute{}
+	if existing, ok := typedFilterConfig.GetTypedConfig(filterName).(*ratev3.RateLimitPerRoute); ok {
+		rateLimitPerRoute.RateLimits = append(rateLimitPerRoute.GetRateLimits(), existing.GetRateLimits()...)
+	}
+	for _, rl := range rateLimit.rateLimitActions {
+		if !containsRateLimit(rateLimitPerRoute.GetRateLimits(), rl) {
+			rateLimitPerRoute.RateLimits = append(rateLimitPerRoute.GetRateLimits(), rl)
+		}
+	}
+	typedFilterConfig.AddTypedConfig(filterName, rateLimitPerRoute)
+}
+
+func containsRateLimit(rateLimits []*envoyroutev3.RateLimit, rl *envoyroutev3.RateLimit) bool {
+	for _, existing := range rateLimits {
+		if proto.Equal(existing, rl) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package trafficpolicy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+// testPolicy is the policy the AI policies of the tests are defined in.
+var testPolicy = types.NamespacedName{Namespace: "default", Name: "policy"}
+
+func TestApplyLocalTokenRateLimit(t *testing.T) {
+	newExtProcSettings := func() *envoy_ext_proc_v3.ExtProcPerRoute {
+		return &envoy_ext_proc_v3.ExtProcPerRoute{
+			Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+				Overrides: &envoy_ext_proc_v3.ExtProcOverrides{},
+			},
+		}
+	}
+	metadataOf := func(settings *envoy_ext_proc_v3.ExtProcPerRoute) map[string]string {
+		out := map[string]string{}
+		for _, h := range settings.GetOverrides().GetGrpcInitialMetadata() {
+			out[h.GetKey()] = h.GetValue()
+		}
+		return out
+	}
+
+	t.Run("no local rate limit", func(t *testing.T) {
+		settings := newExtProcSettings()
+		require.NoError(t, applyLocalTokenRateLimit(testPolicy, &v1alpha1.RateLimit{}, settings))
+		assert.Empty(t, settings.GetOverrides().GetGrpcInitialMetadata())
+	})
+
+	localRateLimit := &v1alpha1.RateLimit{
+		Local: &v1alpha1.LocalRateLimitPolicy{
+			TokenBucket: &v1alpha1.TokenBucket{
+				MaxTokens:     1000,
+				TokensPerFill: ptr.To[int32](100),
+				FillInterval:  metav1.Duration{Duration: time.Minute},
+			},
+		},
+	}
+
+	t.Run("sends the token bucket to the AI extension", func(t *testing.T) {
+		settings := newExtProcSettings()
+		require.NoError(t, applyLocalTokenRateLimit(testPolicy, localRateLimit, settings))
+
+		metadata := metadataOf(settings)
+		assert.JSONEq(t, `{"maxTokens":1000,"tokensPerFill":100,"fillIntervalMs":60000}`, metadata[tokenRateLimitConfigHeader])
+		assert.NotEmpty(t, metadata[tokenRateLimitConfigHashHeader])
+	})
+
+	t.Run("policies with the same limits do not share a token bucket", func(t *testing.T) {
+		settings := newExtProcSettings()
+		require.NoError(t, applyLocalTokenRateLimit(testPolicy, localRateLimit, settings))
+		sameSettings := newExtProcSettings()
+		require.NoError(t, applyLocalTokenRateLimit(testPolicy, localRateLimit, sameSettings))
+		otherSettings := newExtProcSettings()
+		require.NoError(t, applyLocalTokenRateLimit(types.NamespacedName{Namespace: "default", Name: "other-policy"}, localRateLimit, otherSettings))
+
+		assert.Equal(t, metadataOf(settings)[tokenRateLimitConfigHeader], metadataOf(otherSettings)[tokenRateLimitConfigHeader])
+		assert.Equal(t, metadataOf(settings)[tokenRateLimitConfigHashHeader], metadataOf(sameSettings)[tokenRateLimitConfigHashHeader])
+		assert.NotEqual(t, metadataOf(settings)[tokenRateLimitConfigHashHeader], metadataOf(otherSettings)[tokenRateLimitConfigHashHeader])
+	})
+}
+
+func TestConstructGlobalTokenRateLimit(t *testing.T) {
+	rateLimitExtension := &TrafficPolicyGatewayExtensionIR{
+		Name:      "ratelimit",
+		ExtType:   v1alpha1.GatewayExtensionTypeRateLimit,
+		RateLimit: &ratev3.RateLimit{Domain: "ai"},
+	}
+	policyWith := func(trl *v1alpha1.RateLimit) *v1alpha1.TrafficPolicy {
+		return &v1alpha1.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: v1alpha1.TrafficPolicySpec{
+				AI: &v1alpha1.AIPolicy{TokenRateLimit: trl},
+			},
+		}
+	}
+	global := &v1alpha1.RateLimit{
+		Global: &v1alpha1.RateLimitPolicy{
+			ExtensionRef: v1alpha1.NamespacedObjectReference{Name: "ratelimit"},
+			Descriptors: []v1alpha1.RateLimitDescriptor{{
+				Entries: []v1alpha1.RateLimitDescriptorEntry{{
+					Type:   v1alpha1.RateLimitDescriptorEntryTypeHeader,
+					Header: ptr.To("x-user-id"),
+				}},
+			}},
+		},
+	}
+
+	t.Run("no global rate limit", func(t *testing.T) {
+		out, err := constructGlobalTokenRateLimit(nil, policyWith(&v1alpha1.RateLimit{}), nil)
+		require.NoError(t, err)
+		assert.Nil(t, out)
+	})
+
+	t.Run("checks the budget on request and charges the tokens on stream done", func(t *testing.T) {
+		fetch := func(krt.HandlerContext, v1alpha1.NamespacedObjectReference, string) (*TrafficPolicyGatewayExtensionIR, error) {
+			return rateLimitExtension, nil
+		}
+		out, err := constructGlobalTokenRateLimit(nil, policyWith(global), fetch)
+		require.NoError(t, err)
+		require.NotNil(t, out)
+		assert.Equal(t, rateLimitExtension, out.provider)
+		require.Len(t, out.rateLimitActions, 2)
+
+		onRequest := out.rateLimitActions[0]
+		assert.Nil(t, onRequest.GetHitsAddend())
+		assert.False(t, onRequest.GetApplyOnStreamDone())
+
+		onStreamDone := out.rateLimitActions[1]
+		assert.Equal(t, aiTotalTokensHitsAddend, onStreamDone.GetHitsAddend().GetFormat())
+		assert.True(t, onStreamDone.GetApplyOnStreamDone())
+		assert.Equal(t, onRequest.GetActions(), onStreamDone.GetActions())
+	})
+
+	t.Run("wrong extension type", func(t *testing.T) {
+		fetch := func(krt.HandlerContext, v1alpha1.NamespacedObjectReference, string) (*TrafficPolicyGatewayExtensionIR, error) {
+			return &TrafficPolicyGatewayExtensionIR{ExtType: v1alpha1.GatewayExtensionTypeExtAuth}, nil
+		}
+		_, err := constructGlobalTokenRateLimit(nil, policyWith(global), fetch)
+		require.Error(t, err)
+	})
+
+	t.Run("extension not found", func(t *testing.T) {
+		fetch := func(krt.HandlerContext, v1alpha1.NamespacedObjectReference, string) (*TrafficPolicyGatewayExtensionIR, error) {
+			return nil, errors.New("not found")
+		}
+		_, err := constructGlobalTokenRateLimit(nil, policyWith(global), fetch)
+		require.ErrorContains(t, err, "not found")
+	})
+}
+
+func TestAddRateLimitsPerRoute(t *testing.T) {
+	provider := &TrafficPolicyGatewayExtensionIR{
+		Name:      "ratelimit",
+		ExtType:   v1alpha1.GatewayExtensionTypeRateLimit,
+		RateLimit: &ratev3.RateLimit{Domain: "ai"},
+	}
+	requestLimits := &globalRateLimitIR{
+		provider: provider,
+		rateLimitActions: []*envoyroutev3.RateLimit{{
+			Actions: []*envoyroutev3.RateLimit_Action{{
+				ActionSpecifier: &envoyroutev3.RateLimit_Action_RemoteAddress_{
+					RemoteAddress: &envoyroutev3.RateLimit_Action_RemoteAddress{},
+				},
+			}},
+		}},
+	}
+	tokenLimits := &globalRateLimitIR{
+		provider: provider,
+		rateLimitActions: []*envoyroutev3.RateLimit{{
+			Actions: requestLimits.rateLimitActions[0].GetActions(),
+			HitsAddend: &envoyroutev3.RateLimit_HitsAddend{
+				Format: aiTotalTokensHitsAddend,
+			},
+			ApplyOnStreamDone: true,
+		}},
+	}
+
+	p := &trafficPolicyPluginGwPass{}
+	typedFilterConfig := ir.TypedFilterConfigMap{}
+	p.addRateLimitsPerRoute("listener", &typedFilterConfig, requestLimits)
+	p.addRateLimitsPerRoute("listener", &typedFilterConfig, tokenLimits)
+	// adding the same rate limits again does not duplicate them
+	p.addRateLimitsPerRoute("listener", &typedFilterConfig, tokenLimits)
+
+	rateLimitPerRoute, ok := typedFilterConfig.GetTypedConfig(getRateLimitFilterName(provider.ResourceName())).(*ratev3.RateLimitPerRoute)
+	require.True(t, ok)
+	require.Len(t, rateLimitPerRoute.GetRateLimits(), 2)
+	assert.False(t, rateLimitPerRoute.GetRateLimits()[0].GetApplyOnStreamDone())
+	assert.True(t, rateLimitPerRoute.GetRateLimits()[1].GetApplyOnStreamDone())
+	assert.NotEmpty(t, p.rateLimitPerProvider.Providers["listener"])
+}
@@ -50,7 +50,7 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 
 	var errors []error
 	// Construct AI specific IR
-	if err := constructAI(krtctx, policyCR, c.commoncol.Secrets, &outSpec); err != nil {
+	if err := constructAI(krtctx, policyCR, c.commoncol.Secrets, c.commoncol.BackendIndex, c.FetchGatewayExtension, &outSpec); err != nil {
 		errors = append(errors, err)
 	}
 	// Construct transformation specific IR
@@ -102,6 +102,8 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	constructBuffer(policyCR.Spec, &outSpec)
 	// Construct timeout and retry specific IR
 	constructTimeoutRetry(policyCR.Spec, &outSpec)
+	// Construct destination rule subset specific IR
+	constructDestinationRuleSubset(policyCR.Spec, &outSpec)
 
 	// Construct rbac specific IR
 	if err := constructRBAC(policyCR, &outSpec); err != nil {
@@ -113,6 +115,17 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 		errors = append(errors, err)
 	}
 
+	// Construct waf specific IR
+	if err := constructWAF(krtctx, policyCR, c.commoncol.ConfigMaps, c.commoncol.Settings.WafWasmPath, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+
+	inheritedPolicyPriorities, err := parseInheritedPolicyPriorities(policyCR.Annotations)
+	if err != nil {
+		errors = append(errors, err)
+	}
+	policyIr.inheritedPolicyPriorities = inheritedPolicyPriorities
+
 	for _, err := range errors {
 		logger.Error("error translating traffic policy", "namespace", policyCR.GetNamespace(), "name", policyCR.GetName(), "error", err)
 	}
@@ -0,0 +1,68 @@
+package trafficpolicy
+
+import (
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+// destinationRuleSubsetIR is the internal representation of the selected DestinationRule subset.
+type destinationRuleSubsetIR struct {
+	name string
+}
+
+var _ PolicySubIR = &destinationRuleSubsetIR{}
+
+func (d *destinationRuleSubsetIR) Equals(other PolicySubIR) bool {
+	otherSubset, ok := other.(*destinationRuleSubsetIR)
+	if !ok {
+		return false
+	}
+	if d == nil || otherSubset == nil {
+		return d == nil && otherSubset == nil
+	}
+	return d.name == otherSubset.name
+}
+
+// Validate performs validation on the destination rule subset component. No validation is
+// needed as it's a single string field.
+func (d *destinationRuleSubsetIR) Validate() error { return nil }
+
+// constructDestinationRuleSubset constructs the destination rule subset policy IR from the policy specification.
+func constructDestinationRuleSubset(spec v1alpha1.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.DestinationRuleSubset == nil {
+		return
+	}
+	out.destinationRuleSubset = &destinationRuleSubsetIR{
+		name: *spec.DestinationRuleSubset,
+	}
+}
+
+// withDestinationRuleSubset returns the metadata match selecting the subset, merged with the given metadata match.
+// A subset already selected in the given metadata match, e.g. by a more specific policy, is preserved.
+func withDestinationRuleSubset(in *envoycorev3.Metadata, subset *destinationRuleSubsetIR) *envoycorev3.Metadata {
+	if subset == nil {
+		return in
+	}
+	if _, ok := in.GetFilterMetadata()[wellknown.EnvoyLbMetadataNamespace].GetFields()[wellknown.DestinationRuleSubsetMetadataKey]; ok {
+		return in
+	}
+
+	out := &envoycorev3.Metadata{}
+	if in != nil {
+		out = proto.Clone(in).(*envoycorev3.Metadata)
+	}
+	if out.GetFilterMetadata() == nil {
+		out.FilterMetadata = map[string]*structpb.Struct{}
+	}
+	lbMetadata := out.GetFilterMetadata()[wellknown.EnvoyLbMetadataNamespace]
+	if lbMetadata == nil {
+		lbMetadata = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+		out.FilterMetadata[wellknown.EnvoyLbMetadataNamespace] = lbMetadata
+	}
+	lbMetadata.Fields[wellknown.DestinationRuleSubsetMetadataKey] = structpb.NewStringValue(subset.name)
+	return out
+}
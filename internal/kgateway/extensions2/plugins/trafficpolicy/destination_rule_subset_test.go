@@ -0,0 +1,44 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+func TestWithDestinationRuleSubset(t *testing.T) {
+	subsetOf := func(md *envoycorev3.Metadata) string {
+		return md.GetFilterMetadata()[wellknown.EnvoyLbMetadataNamespace].GetFields()[wellknown.DestinationRuleSubsetMetadataKey].GetStringValue()
+	}
+
+	t.Run("no subset", func(t *testing.T) {
+		assert.Nil(t, withDestinationRuleSubset(nil, nil))
+	})
+
+	t.Run("adds subset", func(t *testing.T) {
+		out := withDestinationRuleSubset(nil, &destinationRuleSubsetIR{name: "v1"})
+		assert.Equal(t, "v1", subsetOf(out))
+	})
+
+	t.Run("preserves other metadata without modifying it", func(t *testing.T) {
+		in := &envoycorev3.Metadata{
+			FilterMetadata: map[string]*structpb.Struct{
+				wellknown.EnvoyLbMetadataNamespace: {Fields: map[string]*structpb.Value{"other": structpb.NewStringValue("x")}},
+			},
+		}
+		out := withDestinationRuleSubset(in, &destinationRuleSubsetIR{name: "v1"})
+		assert.Equal(t, "v1", subsetOf(out))
+		assert.Equal(t, "x", out.GetFilterMetadata()[wellknown.EnvoyLbMetadataNamespace].GetFields()["other"].GetStringValue())
+		assert.Empty(t, subsetOf(in))
+	})
+
+	t.Run("keeps the subset already selected", func(t *testing.T) {
+		in := withDestinationRuleSubset(nil, &destinationRuleSubsetIR{name: "v2"})
+		out := withDestinationRuleSubset(in, &destinationRuleSubsetIR{name: "v1"})
+		assert.Equal(t, "v2", subsetOf(out))
+	})
+}
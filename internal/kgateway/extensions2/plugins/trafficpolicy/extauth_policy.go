@@ -114,6 +114,10 @@ func constructExtAuth(
 		return nil
 	}
 
+	if in.Spec.ShadowMode.ShadowsAll() {
+		return errExtAuthShadowMode
+	}
+
 	if spec.Disable != nil {
 		out.extAuth = &extAuthIR{
 			disableAllProviders: true,
@@ -5,7 +5,7 @@ import (
 	"fmt"
 
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
-	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	envoymetadatav3 "github.com/envoyproxy/go-control-plane/envoy/type/metadata/v3"
 	"google.golang.org/protobuf/proto"
 	"istio.io/istio/pkg/kube/krt"
 
@@ -15,6 +15,14 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
 )
 
+const (
+	// jwtAuthnFilterName is the name of the JWT authentication filter, which sets the payload of the
+	// validated JWT in its dynamic metadata.
+	jwtAuthnFilterName = "envoy.filters.http.jwt_authn"
+	// jwtPayloadMetadataKey is the dynamic metadata key of the payload of the validated JWT.
+	jwtPayloadMetadataKey = "payload"
+)
+
 // globalRateLimitIR represents the intermediate representation for a global rate limit policy.
 type globalRateLimitIR struct {
 	provider         *TrafficPolicyGatewayExtensionIR
@@ -96,6 +104,9 @@ func constructGlobalRateLimit(
 	if gwExtIR.ExtType != v1alpha1.GatewayExtensionTypeRateLimit || gwExtIR.RateLimit == nil {
 		return pluginutils.ErrInvalidExtensionType(v1alpha1.GatewayExtensionTypeRateLimit, gwExtIR.ExtType)
 	}
+	if in.Spec.ShadowMode.Shadows(v1alpha1.ShadowModeFieldRateLimit) {
+		gwExtIR = shadowGatewayExtension(gwExtIR)
+	}
 	// Create route rate limits and store in the RateLimitIR struct
 	out.globalRateLimit = &globalRateLimitIR{
 		provider: gwExtIR,
@@ -158,6 +169,23 @@ func createRateLimitActions(descriptors []v1alpha1.RateLimitDescriptor) ([]*envo
 						DescriptorKey: "path",
 					},
 				}
+			case v1alpha1.RateLimitDescriptorEntryTypeJWTClaim:
+				if entry.JWTClaim == nil {
+					return nil, fmt.Errorf("jwt claim entry requires JWTClaim field to be set")
+				}
+				action.ActionSpecifier = &envoyroutev3.RateLimit_Action_Metadata{
+					Metadata: &envoyroutev3.RateLimit_Action_MetaData{
+						DescriptorKey: *entry.JWTClaim,
+						MetadataKey: &envoymetadatav3.MetadataKey{
+							Key: jwtAuthnFilterName,
+							Path: []*envoymetadatav3.MetadataKey_PathSegment{
+								{Segment: &envoymetadatav3.MetadataKey_PathSegment_Key{Key: jwtPayloadMetadataKey}},
+								{Segment: &envoymetadatav3.MetadataKey_PathSegment_Key{Key: *entry.JWTClaim}},
+							},
+						},
+						Source: envoyroutev3.RateLimit_Action_MetaData_DYNAMIC,
+					},
+				}
 			default:
 				return nil, fmt.Errorf("unsupported entry type: %s", entry.Type)
 			}
@@ -196,14 +224,6 @@ func (p *trafficPolicyPluginGwPass) handleGlobalRateLimit(fcn string, typedFilte
 		return
 	}
 
-	providerName := globalRateLimit.provider.ResourceName()
-
-	// Initialize the map if it doesn't exist yet
-	p.rateLimitPerProvider.Add(fcn, providerName, globalRateLimit.provider)
-
 	// Configure rate limit per route - enabling it for this specific route
-	rateLimitPerRoute := &ratev3.RateLimitPerRoute{
-		RateLimits: globalRateLimit.rateLimitActions,
-	}
-	typedFilterConfig.AddTypedConfig(getRateLimitFilterName(providerName), rateLimitPerRoute)
+	p.addRateLimitsPerRoute(fcn, typedFilterConfig, globalRateLimit)
 }
@@ -237,6 +237,30 @@ func TestCreateRateLimitActions(t *testing.T) {
 				assert.Equal(t, "path", requestHeaders.DescriptorKey)
 			},
 		},
+		{
+			name: "with jwt claim descriptor",
+			descriptors: []v1alpha1.RateLimitDescriptor{
+				{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{
+							Type:     v1alpha1.RateLimitDescriptorEntryTypeJWTClaim,
+							JWTClaim: ptr.To("sub"),
+						},
+					},
+				},
+			},
+			validateResult: func(t *testing.T, actions []*envoyroutev3.RateLimit_Action) {
+				require.Len(t, actions, 1)
+				metadata := actions[0].GetMetadata()
+				require.NotNil(t, metadata)
+				assert.Equal(t, "sub", metadata.GetDescriptorKey())
+				assert.Equal(t, envoyroutev3.RateLimit_Action_MetaData_DYNAMIC, metadata.GetSource())
+				assert.Equal(t, "envoy.filters.http.jwt_authn", metadata.GetMetadataKey().GetKey())
+				require.Len(t, metadata.GetMetadataKey().GetPath(), 2)
+				assert.Equal(t, "payload", metadata.GetMetadataKey().GetPath()[0].GetKey())
+				assert.Equal(t, "sub", metadata.GetMetadataKey().GetPath()[1].GetKey())
+			},
+		},
 		{
 			name: "with multiple descriptors",
 			descriptors: []v1alpha1.RateLimitDescriptor{
@@ -337,6 +361,19 @@ func TestCreateRateLimitActions(t *testing.T) {
 			},
 			expectedError: "header entry requires Header field to be set",
 		},
+		{
+			name: "with missing jwt claim",
+			descriptors: []v1alpha1.RateLimitDescriptor{
+				{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{
+							Type: v1alpha1.RateLimitDescriptorEntryTypeJWTClaim,
+						},
+					},
+				},
+			},
+			expectedError: "jwt claim entry requires JWTClaim field to be set",
+		},
 		{
 			name: "with unsupported entry type",
 			descriptors: []v1alpha1.RateLimitDescriptor{
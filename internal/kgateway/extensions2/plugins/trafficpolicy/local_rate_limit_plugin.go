@@ -54,6 +54,10 @@ func constructLocalRateLimit(in *v1alpha1.TrafficPolicy, out *trafficPolicySpecI
 	if err != nil {
 		return err
 	}
+	// An empty policy disables rate limiting, so there is nothing to evaluate in shadow mode.
+	if in.Spec.ShadowMode.Shadows(v1alpha1.ShadowModeFieldRateLimit) && *in.Spec.RateLimit.Local != (v1alpha1.LocalRateLimitPolicy{}) {
+		toShadowLocalRateLimit(localRateLimit)
+	}
 	out.localRateLimit = &localRateLimitIR{
 		config: localRateLimit,
 	}
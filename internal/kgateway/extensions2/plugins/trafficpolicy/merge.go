@@ -1,11 +1,26 @@
 package trafficpolicy
 
 import (
+	"cmp"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
 	"slices"
-
+	"strings"
+
+	cncfmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	corsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	envoy_csrf_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
+	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	envoyauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	transformationpb "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	pluginsdkir "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/policy"
@@ -15,12 +30,151 @@ type mergeOpts struct {
 	TrafficPolicy TrafficPolicyMergeOpts `json:"trafficPolicy,omitempty"`
 }
 
+// TrafficPolicyMergeOpts configures the merge strategy per TrafficPolicy field when merging
+// policies at the same hierarchical level. The only supported value is "DeepMerge".
 type TrafficPolicyMergeOpts struct {
+	AI string `json:"ai,omitempty"`
+
 	ExtAuth string `json:"extAuth,omitempty"`
 
 	ExtProc string `json:"extProc,omitempty"`
 
 	Transformation string `json:"transformation,omitempty"`
+
+	Rustformation string `json:"rustformation,omitempty"`
+
+	LocalRateLimit string `json:"localRateLimit,omitempty"`
+
+	GlobalRateLimit string `json:"globalRateLimit,omitempty"`
+
+	CORS string `json:"cors,omitempty"`
+
+	CSRF string `json:"csrf,omitempty"`
+
+	HeaderModifiers string `json:"headerModifiers,omitempty"`
+
+	Buffer string `json:"buffer,omitempty"`
+
+	AutoHostRewrite string `json:"autoHostRewrite,omitempty"`
+
+	Timeouts string `json:"timeouts,omitempty"`
+
+	Retry string `json:"retry,omitempty"`
+
+	RBAC string `json:"rbac,omitempty"`
+
+	WAF string `json:"waf,omitempty"`
+
+	DestinationRuleSubset string `json:"destinationRuleSubset,omitempty"`
+}
+
+// Names of the TrafficPolicy fields as used in TrafficPolicyMergeOpts and in the
+// annotations prefixed with apiannotations.InheritedPolicyPriorityFieldPrefix
+const (
+	mergeFieldAI              = "ai"
+	mergeFieldExtAuth         = "extAuth"
+	mergeFieldExtProc         = "extProc"
+	mergeFieldTransformation  = "transformation"
+	mergeFieldRustformation   = "rustformation"
+	mergeFieldLocalRateLimit  = "localRateLimit"
+	mergeFieldGlobalRateLimit = "globalRateLimit"
+	mergeFieldCORS            = "cors"
+	mergeFieldCSRF            = "csrf"
+	mergeFieldHeaderModifiers = "headerModifiers"
+	mergeFieldBuffer          = "buffer"
+	mergeFieldAutoHostRewrite = "autoHostRewrite"
+	mergeFieldTimeouts        = "timeouts"
+	mergeFieldRetry           = "retry"
+	mergeFieldRBAC            = "rbac"
+	mergeFieldWAF             = "waf"
+
+	mergeFieldDestinationRuleSubset = "destinationRuleSubset"
+)
+
+var mergeFields = sets.New(
+	mergeFieldAI,
+	mergeFieldExtAuth,
+	mergeFieldExtProc,
+	mergeFieldTransformation,
+	mergeFieldRustformation,
+	mergeFieldLocalRateLimit,
+	mergeFieldGlobalRateLimit,
+	mergeFieldCORS,
+	mergeFieldCSRF,
+	mergeFieldHeaderModifiers,
+	mergeFieldBuffer,
+	mergeFieldAutoHostRewrite,
+	mergeFieldTimeouts,
+	mergeFieldRetry,
+	mergeFieldRBAC,
+	mergeFieldWAF,
+	mergeFieldDestinationRuleSubset,
+)
+
+// parseInheritedPolicyPriorities parses the per-field inherited policy priority annotations on a TrafficPolicy
+func parseInheritedPolicyPriorities(annotations map[string]string) (map[string]apiannotations.InheritedPolicyPriorityValue, error) {
+	var out map[string]apiannotations.InheritedPolicyPriorityValue
+	var errs []error
+	for k, v := range annotations {
+		field, ok := strings.CutPrefix(k, apiannotations.InheritedPolicyPriorityFieldPrefix)
+		if !ok {
+			continue
+		}
+		if !mergeFields.Has(field) {
+			errs = append(errs, fmt.Errorf("invalid annotation %s: unknown field %q; must be one of %v", k, field, sets.List(mergeFields)))
+			continue
+		}
+		switch priority := apiannotations.InheritedPolicyPriorityValue(v); priority {
+		case apiannotations.ShallowMergePreferChild, apiannotations.ShallowMergePreferParent, apiannotations.DeepMergePreferChild, apiannotations.DeepMergePreferParent:
+			if out == nil {
+				out = make(map[string]apiannotations.InheritedPolicyPriorityValue)
+			}
+			out[field] = priority
+		default:
+			errs = append(errs, fmt.Errorf("invalid value for annotation %s: %s", k, v))
+		}
+	}
+	// sort errors so that the order is deterministic for status reporting
+	slices.SortFunc(errs, func(a, b error) int { return strings.Compare(a.Error(), b.Error()) })
+	return out, errors.Join(errs...)
+}
+
+// fieldMergeOptions returns the merge options to use for the given field.
+// When merging policies at the same hierarchical level, the globally configured strategy for the field
+// (if any) overrides the default merge strategy. When merging across hierarchies, the inherited policy priority
+// configured for the field on p2 (if any) overrides the priority inherited from the parent resource.
+func fieldMergeOptions(
+	p2 *TrafficPolicy,
+	field string,
+	globalStrategy string,
+	opts policy.MergeOptions,
+) policy.MergeOptions {
+	if opts.SameHierarchy {
+		if globalStrategy != "" {
+			opts.Strategy = policy.ToInternalMergeStrategy(globalStrategy)
+		}
+		return opts
+	}
+	if priority, ok := p2.inheritedPolicyPriorities[field]; ok {
+		opts.Strategy = policy.GetMergeStrategy(priority, false)
+	}
+	return opts
+}
+
+// setInheritedPolicyPriority records the inherited policy priority of the given field in p2 on p1 after the field
+// has been merged from p2 into p1, so that it is honored when p1 is later merged across hierarchies.
+func setInheritedPolicyPriority(p1, p2 *TrafficPolicy, field string) {
+	priority, ok := p2.inheritedPolicyPriorities[field]
+	if !ok {
+		return
+	}
+	// Always Clone so that the original map in the IR is never modified
+	tmp := maps.Clone(p1.inheritedPolicyPriorities)
+	if tmp == nil {
+		tmp = make(map[string]apiannotations.InheritedPolicyPriorityValue)
+	}
+	tmp[field] = priority
+	p1.inheritedPolicyPriorities = tmp
 }
 
 // MergeTrafficPolicies merges two TrafficPolicy IRs, returning a map that contains information
@@ -53,6 +207,8 @@ func MergeTrafficPolicies(
 		mergeTimeouts,
 		mergeRetry,
 		mergeRBAC,
+		mergeWAF,
+		mergeDestinationRuleSubset,
 	}
 
 	for _, mergeFunc := range mergeFuncs {
@@ -86,13 +242,14 @@ func mergeAI(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[aiPolicyIR]{
 		Get: func(spec *trafficPolicySpecIr) *aiPolicyIR { return spec.ai },
 		Set: func(spec *trafficPolicySpecIr, val *aiPolicyIR) { spec.ai = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "ai")
+	opts = fieldMergeOptions(p2, mergeFieldAI, tpOpts.AI, opts)
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldAI, "ai")
 }
 
 func mergeExtProc(
@@ -108,11 +265,7 @@ func mergeExtProc(
 		Set: func(spec *trafficPolicySpecIr, val *extprocIR) { spec.extProc = val },
 	}
 
-	if tpOpts.ExtProc != "" {
-		// this is merging 2 policies at the same hierarchical level (no parent->child relationship),
-		// so use mergeOpts since it overrides the default merge strategy
-		opts.Strategy = policy.ToInternalMergeStrategy(tpOpts.ExtProc)
-	}
+	opts = fieldMergeOptions(p2, mergeFieldExtProc, tpOpts.ExtProc, opts)
 	if !policy.IsMergeable(p1.spec.extProc, p2.spec.extProc, opts) {
 		return
 	}
@@ -139,6 +292,7 @@ func mergeExtProc(
 			p1.spec.extProc.disableAllProviders = true
 			mergeOrigins.SetOne("extProc", p2Ref, p2MergeOrigins)
 		}
+		setInheritedPolicyPriority(p1, p2, mergeFieldExtProc)
 
 	case policy.OverridableDeepMerge:
 		if p1.spec.extProc == nil {
@@ -161,9 +315,10 @@ func mergeExtProc(
 			p1.spec.extProc.disableAllProviders = true
 			mergeOrigins.SetOne("extProc", p2Ref, p2MergeOrigins)
 		}
+		setInheritedPolicyPriority(p1, p2, mergeFieldExtProc)
 
 	default:
-		defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "extProc")
+		defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldExtProc, "extProc")
 	}
 }
 
@@ -175,11 +330,7 @@ func mergeTransformation(
 	mergeOrigins pluginsdkir.MergeOrigins,
 	tpOpts TrafficPolicyMergeOpts,
 ) {
-	if tpOpts.Transformation != "" {
-		// this is merging 2 policies at the same hierarchical level (no parent->child relationship),
-		// so use tpOpts since it overrides the default merge strategy
-		opts.Strategy = policy.ToInternalMergeStrategy(tpOpts.Transformation)
-	}
+	opts = fieldMergeOptions(p2, mergeFieldTransformation, tpOpts.Transformation, opts)
 	if !policy.IsMergeable(p1.spec.transformation, p2.spec.transformation, opts) {
 		return
 	}
@@ -211,7 +362,9 @@ func mergeTransformation(
 
 	default:
 		logger.Warn("unsupported merge strategy for transformation policy", "strategy", opts.Strategy, "policy", p2Ref)
+		return
 	}
+	setInheritedPolicyPriority(p1, p2, mergeFieldTransformation)
 }
 
 func mergeRustformation(
@@ -220,13 +373,14 @@ func mergeRustformation(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[rustformationIR]{
 		Get: func(spec *trafficPolicySpecIr) *rustformationIR { return spec.rustformation },
 		Set: func(spec *trafficPolicySpecIr, val *rustformationIR) { spec.rustformation = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "rustformation")
+	opts = fieldMergeOptions(p2, mergeFieldRustformation, tpOpts.Rustformation, opts)
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldRustformation, "rustformation")
 }
 
 func mergeExtAuth(
@@ -242,11 +396,7 @@ func mergeExtAuth(
 		Set: func(spec *trafficPolicySpecIr, val *extAuthIR) { spec.extAuth = val },
 	}
 
-	if tpOpts.ExtAuth != "" {
-		// this is merging 2 policies at the same hierarchical level (no parent->child relationship),
-		// so use mergeOpts since it overrides the default merge strategy
-		opts.Strategy = policy.ToInternalMergeStrategy(tpOpts.ExtAuth)
-	}
+	opts = fieldMergeOptions(p2, mergeFieldExtAuth, tpOpts.ExtAuth, opts)
 	if !policy.IsMergeable(p1.spec.extAuth, p2.spec.extAuth, opts) {
 		return
 	}
@@ -274,6 +424,7 @@ func mergeExtAuth(
 			p1.spec.extAuth.disableAllProviders = true
 			mergeOrigins.SetOne("extAuth", p2Ref, p2MergeOrigins)
 		}
+		setInheritedPolicyPriority(p1, p2, mergeFieldExtAuth)
 
 	case policy.OverridableDeepMerge:
 		if p1.spec.extAuth == nil {
@@ -296,9 +447,10 @@ func mergeExtAuth(
 			p1.spec.extAuth.disableAllProviders = true
 			mergeOrigins.SetOne("extAuth", p2Ref, p2MergeOrigins)
 		}
+		setInheritedPolicyPriority(p1, p2, mergeFieldExtAuth)
 
 	default:
-		defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "extAuth")
+		defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldExtAuth, "extAuth")
 	}
 }
 
@@ -308,13 +460,16 @@ func mergeLocalRateLimit(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[localRateLimitIR]{
 		Get: func(spec *trafficPolicySpecIr) *localRateLimitIR { return spec.localRateLimit },
 		Set: func(spec *trafficPolicySpecIr, val *localRateLimitIR) { spec.localRateLimit = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "rateLimit.local")
+	// a local rate limit has a single token bucket that cannot be combined, so deep merging only sets
+	// the token bucket of p2 when p1 does not have one, regardless of which policy is preferred
+	opts = fieldMergeOptions(p2, mergeFieldLocalRateLimit, tpOpts.LocalRateLimit, opts)
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldLocalRateLimit, "rateLimit.local")
 }
 
 func mergeGlobalRateLimit(
@@ -323,13 +478,42 @@ func mergeGlobalRateLimit(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[globalRateLimitIR]{
 		Get: func(spec *trafficPolicySpecIr) *globalRateLimitIR { return spec.globalRateLimit },
 		Set: func(spec *trafficPolicySpecIr, val *globalRateLimitIR) { spec.globalRateLimit = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "rateLimit.global")
+
+	opts = fieldMergeOptions(p2, mergeFieldGlobalRateLimit, tpOpts.GlobalRateLimit, opts)
+	if !policy.IsMergeable(p1.spec.globalRateLimit, p2.spec.globalRateLimit, opts) {
+		return
+	}
+
+	switch opts.Strategy {
+	case policy.AugmentedDeepMerge, policy.OverridableDeepMerge:
+		if p1.spec.globalRateLimit == nil {
+			defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldGlobalRateLimit, "rateLimit.global")
+			return
+		}
+		// descriptors can only be appended when they are sent to the same rate limit service
+		p1Provider, p2Provider := p1.spec.globalRateLimit.provider, p2.spec.globalRateLimit.provider
+		if p1Provider == nil || p2Provider == nil || !p1Provider.Equals(*p2Provider) {
+			logger.Debug("skipping deep merge of global rate limits with different providers", "policy", p2Ref)
+			return
+		}
+		// Always Concat so that the original slice in the IR is never modified
+		// Note: every descriptor is sent to the rate limit service, so the order does not imply preference
+		p1.spec.globalRateLimit = &globalRateLimitIR{
+			provider:         p1.spec.globalRateLimit.provider,
+			rateLimitActions: slices.Concat(p1.spec.globalRateLimit.rateLimitActions, p2.spec.globalRateLimit.rateLimitActions),
+		}
+		mergeOrigins.Append("rateLimit.global", p2Ref, p2MergeOrigins)
+		setInheritedPolicyPriority(p1, p2, mergeFieldGlobalRateLimit)
+
+	default:
+		defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldGlobalRateLimit, "rateLimit.global")
+	}
 }
 
 func mergeCORS(
@@ -338,13 +522,17 @@ func mergeCORS(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[corsIR]{
 		Get: func(spec *trafficPolicySpecIr) *corsIR { return spec.cors },
 		Set: func(spec *trafficPolicySpecIr, val *corsIR) { spec.cors = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "cors")
+	opts = fieldMergeOptions(p2, mergeFieldCORS, tpOpts.CORS, opts)
+	protoMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldCORS, "cors",
+		func(in *corsIR) *corsv3.CorsPolicy { return in.policy },
+		func(policy *corsv3.CorsPolicy) *corsIR { return &corsIR{policy: policy} },
+	)
 }
 
 func mergeCSRF(
@@ -353,13 +541,17 @@ func mergeCSRF(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[csrfIR]{
 		Get: func(spec *trafficPolicySpecIr) *csrfIR { return spec.csrf },
 		Set: func(spec *trafficPolicySpecIr, val *csrfIR) { spec.csrf = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "csrf")
+	opts = fieldMergeOptions(p2, mergeFieldCSRF, tpOpts.CSRF, opts)
+	protoMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldCSRF, "csrf",
+		func(in *csrfIR) *envoy_csrf_v3.CsrfPolicy { return in.policy },
+		func(policy *envoy_csrf_v3.CsrfPolicy) *csrfIR { return &csrfIR{policy: policy} },
+	)
 }
 
 func mergeHeaderModifiers(
@@ -368,14 +560,21 @@ func mergeHeaderModifiers(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[headerModifiersIR]{
 		Get: func(spec *trafficPolicySpecIr) *headerModifiersIR { return spec.headerModifiers },
 		Set: func(spec *trafficPolicySpecIr, val *headerModifiersIR) { spec.headerModifiers = val },
 	}
-
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "headerModifiers")
+	// header mutations are applied in order, so appending the mutations of the preferred policy
+	// last ensures they take precedence when both policies mutate the same header
+	opts = fieldMergeOptions(p2, mergeFieldHeaderModifiers, tpOpts.HeaderModifiers, opts)
+	protoMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldHeaderModifiers, "headerModifiers",
+		func(in *headerModifiersIR) *header_mutationv3.HeaderMutationPerRoute { return in.policy },
+		func(policy *header_mutationv3.HeaderMutationPerRoute) *headerModifiersIR {
+			return &headerModifiersIR{policy: policy}
+		},
+	)
 }
 
 func mergeBuffer(
@@ -384,13 +583,14 @@ func mergeBuffer(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[bufferIR]{
 		Get: func(spec *trafficPolicySpecIr) *bufferIR { return spec.buffer },
 		Set: func(spec *trafficPolicySpecIr, val *bufferIR) { spec.buffer = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "buffer")
+	opts = fieldMergeOptions(p2, mergeFieldBuffer, tpOpts.Buffer, opts)
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldBuffer, "buffer")
 }
 
 func mergeAutoHostRewrite(
@@ -399,13 +599,14 @@ func mergeAutoHostRewrite(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[autoHostRewriteIR]{
 		Get: func(spec *trafficPolicySpecIr) *autoHostRewriteIR { return spec.autoHostRewrite },
 		Set: func(spec *trafficPolicySpecIr, val *autoHostRewriteIR) { spec.autoHostRewrite = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "autoHostRewrite")
+	opts = fieldMergeOptions(p2, mergeFieldAutoHostRewrite, tpOpts.AutoHostRewrite, opts)
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldAutoHostRewrite, "autoHostRewrite")
 }
 
 func mergeTimeouts(
@@ -414,13 +615,43 @@ func mergeTimeouts(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[timeoutsIR]{
 		Get: func(spec *trafficPolicySpecIr) *timeoutsIR { return spec.timeouts },
 		Set: func(spec *trafficPolicySpecIr, val *timeoutsIR) { spec.timeouts = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "timeouts")
+
+	opts = fieldMergeOptions(p2, mergeFieldTimeouts, tpOpts.Timeouts, opts)
+	if !policy.IsMergeable(p1.spec.timeouts, p2.spec.timeouts, opts) {
+		return
+	}
+
+	switch opts.Strategy {
+	case policy.AugmentedDeepMerge, policy.OverridableDeepMerge:
+		if p1.spec.timeouts == nil {
+			defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldTimeouts, "timeouts")
+			return
+		}
+		preferred, other := p1.spec.timeouts, p2.spec.timeouts
+		if opts.Strategy == policy.OverridableDeepMerge {
+			preferred, other = other, preferred
+		}
+		merged := &timeoutsIR{
+			routeTimeout:           cmp.Or(preferred.routeTimeout, other.routeTimeout),
+			routeStreamIdleTimeout: cmp.Or(preferred.routeStreamIdleTimeout, other.routeStreamIdleTimeout),
+		}
+		if merged.Equals(p1.spec.timeouts) {
+			// nothing was contributed by p2
+			return
+		}
+		p1.spec.timeouts = merged
+		mergeOrigins.Append("timeouts", p2Ref, p2MergeOrigins)
+		setInheritedPolicyPriority(p1, p2, mergeFieldTimeouts)
+
+	default:
+		defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldTimeouts, "timeouts")
+	}
 }
 
 func mergeRBAC(
@@ -429,13 +660,195 @@ func mergeRBAC(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[rbacIR]{
 		Get: func(spec *trafficPolicySpecIr) *rbacIR { return spec.rbac },
 		Set: func(spec *trafficPolicySpecIr, val *rbacIR) { spec.rbac = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "rbac")
+
+	opts = fieldMergeOptions(p2, mergeFieldRBAC, tpOpts.RBAC, opts)
+	if !policy.IsMergeable(p1.spec.rbac, p2.spec.rbac, opts) {
+		return
+	}
+
+	switch opts.Strategy {
+	case policy.AugmentedDeepMerge, policy.OverridableDeepMerge:
+		if p1.spec.rbac == nil {
+			defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldRBAC, "rbac")
+			return
+		}
+		preferred, other := p1.spec.rbac.rbacConfig, p2.spec.rbac.rbacConfig
+		if opts.Strategy == policy.OverridableDeepMerge {
+			preferred, other = other, preferred
+		}
+		merged, err := unionRBACMatchers(preferred, other)
+		if err != nil {
+			// fall back to the shallow merge rather than silently dropping the rules of one of the policies
+			logger.Warn("cannot deep merge RBAC policies, the preferred policy overrides the other one", "policy", p2Ref, "error", err)
+			if opts.Strategy == policy.OverridableDeepMerge {
+				p1.spec.rbac = p2.spec.rbac
+				mergeOrigins.SetOne("rbac", p2Ref, p2MergeOrigins)
+				setInheritedPolicyPriority(p1, p2, mergeFieldRBAC)
+			}
+			return
+		}
+		if merged == nil {
+			logger.Debug("skipping deep merge of RBAC policies without an RBAC config", "policy", p2Ref)
+			return
+		}
+		if proto.Equal(merged, p1.spec.rbac.rbacConfig) {
+			// nothing was contributed by p2
+			return
+		}
+		p1.spec.rbac = &rbacIR{rbacConfig: merged}
+		mergeOrigins.Append("rbac", p2Ref, p2MergeOrigins)
+		setInheritedPolicyPriority(p1, p2, mergeFieldRBAC)
+
+	default:
+		defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldRBAC, "rbac")
+	}
+}
+
+// unionRBACMatchers returns an RBAC config whose enforced and shadow matchers are the union of the
+// matchers in the preferred and other config. Matchers are evaluated in order and the first match wins,
+// so the matchers in the preferred config are evaluated first.
+// Rules without policies, such as the rules of a policy without match expressions, are converted to
+// matchers so that they are part of the union. A config without rules nor matchers, such as the enforced
+// part of a shadowed policy, does not contribute to the union.
+// Returns nil if the preferred config has no RBAC config, and an error if the configs cannot be combined.
+func unionRBACMatchers(preferred, other *envoyauthz.RBACPerRoute) (*envoyauthz.RBACPerRoute, error) {
+	if preferred.GetRbac() == nil {
+		return nil, nil
+	}
+	// Always Clone so that the original config in the IR is never modified
+	out := proto.Clone(preferred).(*envoyauthz.RBACPerRoute)
+	rbac := out.GetRbac()
+	otherRbac := other.GetRbac()
+
+	var err error
+	rbac.Matcher, rbac.Rules, err = unionMatchers(rbac.GetMatcher(), otherRbac.GetMatcher(), rbac.GetRules(), otherRbac.GetRules())
+	if err != nil {
+		return nil, err
+	}
+	rbac.ShadowMatcher, rbac.ShadowRules, err = unionMatchers(rbac.GetShadowMatcher(), otherRbac.GetShadowMatcher(), rbac.GetShadowRules(), otherRbac.GetShadowRules())
+	if err != nil {
+		return nil, fmt.Errorf("shadow rules: %w", err)
+	}
+	if rbac.GetShadowMatcher() == nil && rbac.GetShadowRules() == nil {
+		rbac.ShadowRulesStatPrefix = ""
+	} else if rbac.GetShadowRulesStatPrefix() == "" {
+		rbac.ShadowRulesStatPrefix = otherRbac.GetShadowRulesStatPrefix()
+	}
+	return out, nil
+}
+
+// unionMatchers returns the union of the preferred and other matchers, which Envoy does not allow to be set
+// together with rules. The request is denied when no matcher matches if either side denies it.
+// The rules are only kept when neither config has matchers and the rules of both configs are the same.
+func unionMatchers(
+	preferred, other *cncfmatcherv3.Matcher,
+	preferredRules, otherRules *envoyrbacv3.RBAC,
+) (*cncfmatcherv3.Matcher, *envoyrbacv3.RBAC, error) {
+	switch {
+	case other == nil && otherRules == nil:
+		return preferred, preferredRules, nil
+	case preferred == nil && preferredRules == nil:
+		if other != nil {
+			return proto.Clone(other).(*cncfmatcherv3.Matcher), nil, nil
+		}
+		return nil, proto.Clone(otherRules).(*envoyrbacv3.RBAC), nil
+	case preferred == nil && other == nil && proto.Equal(preferredRules, otherRules):
+		return nil, preferredRules, nil
+	}
+
+	preferred, err := rbacMatcher(preferred, preferredRules)
+	if err != nil {
+		return nil, nil, err
+	}
+	other, err = rbacMatcher(other, otherRules)
+	if err != nil {
+		return nil, nil, err
+	}
+	if preferred.GetMatcherTree() != nil || other.GetMatcherTree() != nil {
+		return nil, nil, fmt.Errorf("only matcher lists can be combined")
+	}
+
+	out := &cncfmatcherv3.Matcher{
+		OnNoMatch: preferred.GetOnNoMatch(),
+	}
+	if rbacMatcherAllowsOnNoMatch(preferred) && !rbacMatcherAllowsOnNoMatch(other) {
+		out.OnNoMatch = other.GetOnNoMatch()
+	}
+	if matchers := slices.Concat(preferred.GetMatcherList().GetMatchers(), other.GetMatcherList().GetMatchers()); len(matchers) > 0 {
+		out.MatcherType = &cncfmatcherv3.Matcher_MatcherList_{
+			MatcherList: &cncfmatcherv3.Matcher_MatcherList{Matchers: matchers},
+		}
+	}
+	return proto.Clone(out).(*cncfmatcherv3.Matcher), nil, nil
+}
+
+// rbacMatcher returns the matcher equivalent to the rules if the config has no matcher.
+// Only rules without policies, which apply the opposite of their action to all the requests, can be converted.
+func rbacMatcher(matcher *cncfmatcherv3.Matcher, rules *envoyrbacv3.RBAC) (*cncfmatcherv3.Matcher, error) {
+	if matcher != nil || rules == nil {
+		return matcher, nil
+	}
+	if len(rules.GetPolicies()) > 0 {
+		return nil, fmt.Errorf("rules with policies cannot be combined with matchers")
+	}
+	switch rules.GetAction() {
+	case envoyrbacv3.RBAC_ALLOW:
+		// no request is allowed
+		return &cncfmatcherv3.Matcher{OnNoMatch: createDefaultAction(envoyrbacv3.RBAC_DENY)}, nil
+	case envoyrbacv3.RBAC_DENY:
+		// no request is denied
+		return &cncfmatcherv3.Matcher{OnNoMatch: createDefaultAction(envoyrbacv3.RBAC_ALLOW)}, nil
+	default:
+		return nil, fmt.Errorf("rules with the %s action cannot be combined with matchers", rules.GetAction())
+	}
+}
+
+// rbacMatcherAllowsOnNoMatch returns whether the requests that are not matched by the matcher are allowed.
+// Envoy denies them when the matcher has no on_no_match action.
+func rbacMatcherAllowsOnNoMatch(matcher *cncfmatcherv3.Matcher) bool {
+	action := &envoyrbacv3.Action{}
+	if err := matcher.GetOnNoMatch().GetAction().GetTypedConfig().UnmarshalTo(action); err != nil {
+		return false
+	}
+	return action.GetAction() == envoyrbacv3.RBAC_ALLOW
+}
+
+func mergeWAF(
+	p1, p2 *TrafficPolicy,
+	p2Ref *pluginsdkir.AttachedPolicyRef,
+	p2MergeOrigins pluginsdkir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins pluginsdkir.MergeOrigins,
+	tpOpts TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[wafIR]{
+		Get: func(spec *trafficPolicySpecIr) *wafIR { return spec.waf },
+		Set: func(spec *trafficPolicySpecIr, val *wafIR) { spec.waf = val },
+	}
+	opts = fieldMergeOptions(p2, mergeFieldWAF, tpOpts.WAF, opts)
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldWAF, "waf")
+}
+
+func mergeDestinationRuleSubset(
+	p1, p2 *TrafficPolicy,
+	p2Ref *pluginsdkir.AttachedPolicyRef,
+	p2MergeOrigins pluginsdkir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins pluginsdkir.MergeOrigins,
+	tpOpts TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[destinationRuleSubsetIR]{
+		Get: func(spec *trafficPolicySpecIr) *destinationRuleSubsetIR { return spec.destinationRuleSubset },
+		Set: func(spec *trafficPolicySpecIr, val *destinationRuleSubsetIR) { spec.destinationRuleSubset = val },
+	}
+	opts = fieldMergeOptions(p2, mergeFieldDestinationRuleSubset, tpOpts.DestinationRuleSubset, opts)
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldDestinationRuleSubset, "destinationRuleSubset")
 }
 
 func mergeRetry(
@@ -444,13 +857,28 @@ func mergeRetry(
 	p2MergeOrigins pluginsdkir.MergeOrigins,
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
-	_ TrafficPolicyMergeOpts,
+	tpOpts TrafficPolicyMergeOpts,
 ) {
 	accessor := fieldAccessor[retryIR]{
 		Get: func(spec *trafficPolicySpecIr) *retryIR { return spec.retry },
 		Set: func(spec *trafficPolicySpecIr, val *retryIR) { spec.retry = val },
 	}
-	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "retry")
+	opts = fieldMergeOptions(p2, mergeFieldRetry, tpOpts.Retry, opts)
+	protoMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeFieldRetry, "retry",
+		func(in *retryIR) *envoyroutev3.RetryPolicy { return in.policy },
+		func(policy *envoyroutev3.RetryPolicy) *retryIR {
+			// proto.Merge concatenates the status codes of both policies
+			seen := sets.New[uint32]()
+			policy.RetriableStatusCodes = slices.DeleteFunc(policy.GetRetriableStatusCodes(), func(code uint32) bool {
+				if seen.Has(code) {
+					return true
+				}
+				seen.Insert(code)
+				return false
+			})
+			return &retryIR{policy: policy}
+		},
+	)
 }
 
 // fieldAccessor defines how to access and set a field on trafficPolicySpecIr
@@ -468,6 +896,7 @@ func defaultMerge[T any](
 	opts policy.MergeOptions,
 	mergeOrigins pluginsdkir.MergeOrigins,
 	accessor fieldAccessor[T],
+	mergeField string,
 	fieldName string,
 ) {
 	p1Field := accessor.Get(&p1.spec)
@@ -487,8 +916,59 @@ func defaultMerge[T any](
 	case policy.AugmentedShallowMerge, policy.OverridableShallowMerge:
 		accessor.Set(&p1.spec, p2Field)
 		mergeOrigins.SetOne(fieldName, p2Ref, p2MergeOrigins)
+		setInheritedPolicyPriority(p1, p2, mergeField)
 
 	default:
 		logger.Warn("unsupported merge strategy for policy", "strategy", opts.Strategy, "policy", p2Ref, "field", fieldName)
 	}
 }
+
+// protoMerge is a generic merge function for fields backed by a single proto message.
+// Deep merging uses proto.Merge semantics: singular fields set on the preferred policy take precedence,
+// while repeated fields are concatenated with the elements of the preferred policy last.
+// newField may normalize the merged message, e.g. to deduplicate the elements of repeated fields.
+func protoMerge[T any, M proto.Message](
+	p1, p2 *TrafficPolicy,
+	p2Ref *pluginsdkir.AttachedPolicyRef,
+	p2MergeOrigins pluginsdkir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins pluginsdkir.MergeOrigins,
+	accessor fieldAccessor[T],
+	mergeField string,
+	fieldName string,
+	getMsg func(*T) M,
+	newField func(M) *T,
+) {
+	p1Field := accessor.Get(&p1.spec)
+	p2Field := accessor.Get(&p2.spec)
+
+	if !policy.IsMergeable(p1Field, p2Field, opts) {
+		return
+	}
+
+	switch opts.Strategy {
+	case policy.AugmentedDeepMerge, policy.OverridableDeepMerge:
+		if p1Field == nil || !getMsg(p1Field).ProtoReflect().IsValid() || !getMsg(p2Field).ProtoReflect().IsValid() {
+			defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeField, fieldName)
+			return
+		}
+		preferred, other := getMsg(p1Field), getMsg(p2Field)
+		if opts.Strategy == policy.OverridableDeepMerge {
+			preferred, other = other, preferred
+		}
+		// Always Clone so that the original message in the IR is never modified
+		merged := proto.Clone(other).(M)
+		proto.Merge(merged, preferred)
+		mergedField := newField(merged)
+		if proto.Equal(getMsg(mergedField), getMsg(p1Field)) {
+			// nothing was contributed by p2
+			return
+		}
+		accessor.Set(&p1.spec, mergedField)
+		mergeOrigins.Append(fieldName, p2Ref, p2MergeOrigins)
+		setInheritedPolicyPriority(p1, p2, mergeField)
+
+	default:
+		defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, mergeField, fieldName)
+	}
+}
@@ -5,11 +5,25 @@ import (
 	"testing"
 	"time"
 
+	cncfcorev3 "github.com/cncf/xds/go/xds/core/v3"
+	cncfmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	mutation_rulesv3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyrbacconfigv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	bufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	pluginsdkir "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/policy"
 )
 
@@ -37,3 +51,367 @@ func TestMergePoliciesPreservesErrors(t *testing.T) {
 	assert.Contains(t, merged.Errors, err1)
 	assert.Contains(t, merged.Errors, err2)
 }
+
+func TestMergePoliciesGlobalPolicyTiers(t *testing.T) {
+	gk := schema.GroupKind{Group: "test", Kind: "TrafficPolicy"}
+	now := time.Now()
+
+	override := ir.PolicyAtt{
+		GroupKind:        gk,
+		PolicyRef:        &ir.AttachedPolicyRef{Namespace: "platform", Name: "override"},
+		GlobalPolicyTier: apiannotations.GlobalPolicyTierOverride,
+		PolicyIr: &TrafficPolicy{ct: now, spec: trafficPolicySpecIr{
+			timeouts: &timeoutsIR{routeTimeout: durationpb.New(5 * time.Second)},
+		}},
+	}
+	app := ir.PolicyAtt{
+		GroupKind:        gk,
+		PolicyRef:        &ir.AttachedPolicyRef{Namespace: "app", Name: "app"},
+		PrecedenceWeight: 100,
+		PolicyIr: &TrafficPolicy{ct: now.Add(-time.Hour), spec: trafficPolicySpecIr{
+			timeouts: &timeoutsIR{routeTimeout: durationpb.New(60 * time.Second)},
+			buffer:   &bufferIR{},
+		}},
+	}
+	def := ir.PolicyAtt{
+		GroupKind:        gk,
+		PolicyRef:        &ir.AttachedPolicyRef{Namespace: "platform", Name: "default"},
+		GlobalPolicyTier: apiannotations.GlobalPolicyTierDefault,
+		PolicyIr: &TrafficPolicy{ct: now.Add(-2 * time.Hour), spec: trafficPolicySpecIr{
+			buffer: &bufferIR{perRoute: &bufferv3.BufferPerRoute{}},
+			cors:   &corsIR{},
+		}},
+	}
+
+	// the input order must not matter as tiers take precedence over the order of the policies
+	merged := policy.MergePolicies([]ir.PolicyAtt{app, def, override}, mergeTrafficPolicies, `{"trafficPolicy":{"extAuth":"DeepMerge"}}`)
+	require.Empty(t, merged.Errors)
+	tp := merged.PolicyIr.(*TrafficPolicy)
+
+	a := assert.New(t)
+	// override tier is preferred over the app policy
+	a.Equal(5*time.Second, tp.spec.timeouts.routeTimeout.AsDuration())
+	a.ElementsMatch([]string{override.PolicyRef.ID()}, merged.MergeOrigins.Get("timeouts"))
+	// app policy is preferred over the default tier
+	a.Same(app.PolicyIr.(*TrafficPolicy).spec.buffer, tp.spec.buffer)
+	a.ElementsMatch([]string{app.PolicyRef.ID()}, merged.MergeOrigins.Get("buffer"))
+	// default tier fills in unset fields
+	a.NotNil(tp.spec.cors)
+	a.ElementsMatch([]string{def.PolicyRef.ID()}, merged.MergeOrigins.Get("cors"))
+}
+
+func TestMergePoliciesDeepMergeFields(t *testing.T) {
+	gk := schema.GroupKind{Group: "test", Kind: "TrafficPolicy"}
+	now := time.Now()
+	provider := &TrafficPolicyGatewayExtensionIR{Name: "ratelimit"}
+
+	p1 := ir.PolicyAtt{
+		GroupKind: gk,
+		PolicyRef: &ir.AttachedPolicyRef{Name: "p1"},
+		PolicyIr: &TrafficPolicy{ct: now, spec: trafficPolicySpecIr{
+			headerModifiers: &headerModifiersIR{policy: headerMutation("x-p1")},
+			globalRateLimit: &globalRateLimitIR{provider: provider, rateLimitActions: []*envoyroutev3.RateLimit{{Stage: wrapperspb.UInt32(1)}}},
+			rbac:            &rbacIR{rbacConfig: rbacWithMatchers("p1")},
+			timeouts:        &timeoutsIR{routeTimeout: durationpb.New(5 * time.Second)},
+		}},
+	}
+	p2 := ir.PolicyAtt{
+		GroupKind: gk,
+		PolicyRef: &ir.AttachedPolicyRef{Name: "p2"},
+		PolicyIr: &TrafficPolicy{ct: now.Add(time.Minute), spec: trafficPolicySpecIr{
+			headerModifiers: &headerModifiersIR{policy: headerMutation("x-p2")},
+			globalRateLimit: &globalRateLimitIR{provider: provider, rateLimitActions: []*envoyroutev3.RateLimit{{Stage: wrapperspb.UInt32(2)}}},
+			rbac:            &rbacIR{rbacConfig: rbacWithMatchers("p2")},
+			timeouts: &timeoutsIR{
+				routeTimeout:           durationpb.New(10 * time.Second),
+				routeStreamIdleTimeout: durationpb.New(time.Minute),
+			},
+		}},
+	}
+
+	mergeSettings := `{"trafficPolicy":{"headerModifiers":"DeepMerge","globalRateLimit":"DeepMerge","rbac":"DeepMerge","timeouts":"DeepMerge"}}`
+	merged := policy.MergePolicies([]ir.PolicyAtt{p1, p2}, mergeTrafficPolicies, mergeSettings)
+	require.Empty(t, merged.Errors)
+	tp := merged.PolicyIr.(*TrafficPolicy)
+	origins := []string{p1.PolicyRef.ID(), p2.PolicyRef.ID()}
+
+	a := assert.New(t)
+	// mutations of the preferred policy are applied last
+	var headers []string
+	for _, m := range tp.spec.headerModifiers.policy.GetMutations().GetRequestMutations() {
+		headers = append(headers, m.GetAppend().GetHeader().GetKey())
+	}
+	a.Equal([]string{"x-p2", "x-p1"}, headers)
+	a.ElementsMatch(origins, merged.MergeOrigins.Get("headerModifiers"))
+
+	a.Len(tp.spec.globalRateLimit.rateLimitActions, 2)
+	a.ElementsMatch(origins, merged.MergeOrigins.Get("rateLimit.global"))
+
+	// matchers of the preferred policy are evaluated first
+	var matchers []string
+	for _, m := range tp.spec.rbac.rbacConfig.GetRbac().GetMatcher().GetMatcherList().GetMatchers() {
+		matchers = append(matchers, m.GetOnMatch().GetAction().GetName())
+	}
+	a.Equal([]string{"p1", "p2"}, matchers)
+	a.ElementsMatch(origins, merged.MergeOrigins.Get("rbac"))
+
+	a.Equal(5*time.Second, tp.spec.timeouts.routeTimeout.AsDuration())
+	a.Equal(time.Minute, tp.spec.timeouts.routeStreamIdleTimeout.AsDuration())
+	a.ElementsMatch(origins, merged.MergeOrigins.Get("timeouts"))
+
+	// the original IRs must not be modified
+	a.Len(p1.PolicyIr.(*TrafficPolicy).spec.headerModifiers.policy.GetMutations().GetRequestMutations(), 1)
+	a.Len(p1.PolicyIr.(*TrafficPolicy).spec.globalRateLimit.rateLimitActions, 1)
+	a.Len(p1.PolicyIr.(*TrafficPolicy).spec.rbac.rbacConfig.GetRbac().GetMatcher().GetMatcherList().GetMatchers(), 1)
+}
+
+func TestMergePoliciesDeepMergeRetry(t *testing.T) {
+	gk := schema.GroupKind{Group: "test", Kind: "TrafficPolicy"}
+	now := time.Now()
+	retryPolicy := func(name string, codes ...uint32) ir.PolicyAtt {
+		return ir.PolicyAtt{
+			GroupKind: gk,
+			PolicyRef: &ir.AttachedPolicyRef{Name: name},
+			PolicyIr: &TrafficPolicy{ct: now, spec: trafficPolicySpecIr{
+				retry: &retryIR{policy: &envoyroutev3.RetryPolicy{RetryOn: "retriable-status-codes", RetriableStatusCodes: codes}},
+			}},
+		}
+	}
+	mergeSettings := `{"trafficPolicy":{"retry":"DeepMerge"}}`
+
+	t.Run("status codes are deduplicated", func(t *testing.T) {
+		p1, p2 := retryPolicy("p1", 503, 502), retryPolicy("p2", 503, 504)
+		merged := policy.MergePolicies([]ir.PolicyAtt{p1, p2}, mergeTrafficPolicies, mergeSettings)
+		require.Empty(t, merged.Errors)
+
+		a := assert.New(t)
+		a.Equal([]uint32{503, 504, 502}, merged.PolicyIr.(*TrafficPolicy).spec.retry.policy.GetRetriableStatusCodes())
+		a.ElementsMatch([]string{p1.PolicyRef.ID(), p2.PolicyRef.ID()}, merged.MergeOrigins.Get("retry"))
+		// the original IRs must not be modified
+		a.Equal([]uint32{503, 502}, p1.PolicyIr.(*TrafficPolicy).spec.retry.policy.GetRetriableStatusCodes())
+	})
+
+	t.Run("policy without contribution is not a merge origin", func(t *testing.T) {
+		p1, p2 := retryPolicy("p1", 503), retryPolicy("p2", 503)
+		merged := policy.MergePolicies([]ir.PolicyAtt{p1, p2}, mergeTrafficPolicies, mergeSettings)
+		require.Empty(t, merged.Errors)
+
+		a := assert.New(t)
+		a.Equal([]uint32{503}, merged.PolicyIr.(*TrafficPolicy).spec.retry.policy.GetRetriableStatusCodes())
+		a.Equal([]string{p1.PolicyRef.ID()}, merged.MergeOrigins.Get("retry"))
+	})
+}
+
+func TestMergePoliciesDeepMergeRBACWithoutMatchers(t *testing.T) {
+	gk := schema.GroupKind{Group: "test", Kind: "TrafficPolicy"}
+	now := time.Now()
+	rbacPolicy := func(name string, rbacConfig *envoyrbacv3.RBACPerRoute) ir.PolicyAtt {
+		return ir.PolicyAtt{
+			GroupKind: gk,
+			PolicyRef: &ir.AttachedPolicyRef{Name: name},
+			PolicyIr:  &TrafficPolicy{ct: now, spec: trafficPolicySpecIr{rbac: &rbacIR{rbacConfig: rbacConfig}}},
+		}
+	}
+	matcherNames := func(m *cncfmatcherv3.Matcher) []string {
+		var names []string
+		for _, fm := range m.GetMatcherList().GetMatchers() {
+			names = append(names, fm.GetOnMatch().GetAction().GetName())
+		}
+		return names
+	}
+	mergeSettings := `{"trafficPolicy":{"rbac":"DeepMerge"}}`
+
+	t.Run("shadow matchers", func(t *testing.T) {
+		shadow1, shadow2 := rbacWithMatchers("shadow-p1"), rbacWithMatchers("shadow-p2")
+		toShadowRBAC(shadow1)
+		toShadowRBAC(shadow2)
+		p1, p2, p3 := rbacPolicy("p1", shadow1), rbacPolicy("p2", rbacWithMatchers("p2")), rbacPolicy("p3", shadow2)
+		merged := policy.MergePolicies([]ir.PolicyAtt{p1, p2, p3}, mergeTrafficPolicies, mergeSettings)
+		require.Empty(t, merged.Errors)
+
+		a := assert.New(t)
+		rbac := merged.PolicyIr.(*TrafficPolicy).spec.rbac.rbacConfig.GetRbac()
+		a.Equal([]string{"p2"}, matcherNames(rbac.GetMatcher()))
+		a.Equal([]string{"shadow-p1", "shadow-p2"}, matcherNames(rbac.GetShadowMatcher()))
+		a.Equal(rbacShadowRulesStatPrefix, rbac.GetShadowRulesStatPrefix())
+		a.ElementsMatch([]string{p1.PolicyRef.ID(), p2.PolicyRef.ID(), p3.PolicyRef.ID()}, merged.MergeOrigins.Get("rbac"))
+	})
+
+	t.Run("deny-all rules", func(t *testing.T) {
+		denyAll := &envoyrbacv3.RBACPerRoute{Rbac: &envoyrbacv3.RBAC{
+			Rules: &envoyrbacconfigv3.RBAC{Action: envoyrbacconfigv3.RBAC_DENY, Policies: map[string]*envoyrbacconfigv3.Policy{}},
+		}}
+		p1, p2 := rbacPolicy("p1", denyAll), rbacPolicy("p2", rbacWithMatchers("p2"))
+		merged := policy.MergePolicies([]ir.PolicyAtt{p1, p2}, mergeTrafficPolicies, mergeSettings)
+		require.Empty(t, merged.Errors)
+
+		a := assert.New(t)
+		rbac := merged.PolicyIr.(*TrafficPolicy).spec.rbac.rbacConfig.GetRbac()
+		// the rules are converted to a matcher, and the requests that are not matched by the matchers of
+		// the other policy are still denied by it
+		a.Nil(rbac.GetRules())
+		a.Equal([]string{"p2"}, matcherNames(rbac.GetMatcher()))
+		a.False(rbacMatcherAllowsOnNoMatch(rbac.GetMatcher()))
+		a.ElementsMatch([]string{p1.PolicyRef.ID(), p2.PolicyRef.ID()}, merged.MergeOrigins.Get("rbac"))
+		// the original IRs must not be modified
+		a.NotNil(p1.PolicyIr.(*TrafficPolicy).spec.rbac.rbacConfig.GetRbac().GetRules())
+	})
+
+	t.Run("rules denying all the requests", func(t *testing.T) {
+		allowNone := &envoyrbacv3.RBACPerRoute{Rbac: &envoyrbacv3.RBAC{
+			Rules: &envoyrbacconfigv3.RBAC{Action: envoyrbacconfigv3.RBAC_ALLOW, Policies: map[string]*envoyrbacconfigv3.Policy{}},
+		}}
+		allowOnNoMatch := rbacWithMatchers("p2")
+		allowOnNoMatch.GetRbac().GetMatcher().OnNoMatch = createDefaultAction(envoyrbacconfigv3.RBAC_ALLOW)
+		p1, p2 := rbacPolicy("p1", allowNone), rbacPolicy("p2", allowOnNoMatch)
+		merged := policy.MergePolicies([]ir.PolicyAtt{p1, p2}, mergeTrafficPolicies, mergeSettings)
+		require.Empty(t, merged.Errors)
+
+		a := assert.New(t)
+		rbac := merged.PolicyIr.(*TrafficPolicy).spec.rbac.rbacConfig.GetRbac()
+		// the parent denies the requests that the child would allow when they are not matched
+		a.Nil(rbac.GetRules())
+		a.Equal([]string{"p2"}, matcherNames(rbac.GetMatcher()))
+		a.False(rbacMatcherAllowsOnNoMatch(rbac.GetMatcher()))
+		a.ElementsMatch([]string{p1.PolicyRef.ID(), p2.PolicyRef.ID()}, merged.MergeOrigins.Get("rbac"))
+	})
+
+	t.Run("different rules", func(t *testing.T) {
+		rules := func(action envoyrbacconfigv3.RBAC_Action) *envoyrbacv3.RBACPerRoute {
+			return &envoyrbacv3.RBACPerRoute{Rbac: &envoyrbacv3.RBAC{
+				Rules: &envoyrbacconfigv3.RBAC{Action: action, Policies: map[string]*envoyrbacconfigv3.Policy{}},
+			}}
+		}
+		p1, p2 := rbacPolicy("p1", rules(envoyrbacconfigv3.RBAC_DENY)), rbacPolicy("p2", rules(envoyrbacconfigv3.RBAC_ALLOW))
+		merged := policy.MergePolicies([]ir.PolicyAtt{p1, p2}, mergeTrafficPolicies, mergeSettings)
+		require.Empty(t, merged.Errors)
+
+		a := assert.New(t)
+		rbac := merged.PolicyIr.(*TrafficPolicy).spec.rbac.rbacConfig.GetRbac()
+		a.Nil(rbac.GetRules())
+		a.Empty(matcherNames(rbac.GetMatcher()))
+		a.False(rbacMatcherAllowsOnNoMatch(rbac.GetMatcher()))
+		a.ElementsMatch([]string{p1.PolicyRef.ID(), p2.PolicyRef.ID()}, merged.MergeOrigins.Get("rbac"))
+	})
+
+	t.Run("rules with policies fall back to the preferred policy", func(t *testing.T) {
+		withPolicies := &envoyrbacv3.RBACPerRoute{Rbac: &envoyrbacv3.RBAC{
+			Rules: &envoyrbacconfigv3.RBAC{
+				Action: envoyrbacconfigv3.RBAC_DENY,
+				Policies: map[string]*envoyrbacconfigv3.Policy{
+					"deny": {
+						Permissions: []*envoyrbacconfigv3.Permission{{Rule: &envoyrbacconfigv3.Permission_Any{Any: true}}},
+						Principals:  []*envoyrbacconfigv3.Principal{{Identifier: &envoyrbacconfigv3.Principal_Any{Any: true}}},
+					},
+				},
+			},
+		}}
+		p1, p2 := rbacPolicy("p1", withPolicies), rbacPolicy("p2", rbacWithMatchers("p2"))
+		merged := policy.MergePolicies([]ir.PolicyAtt{p1, p2}, mergeTrafficPolicies, mergeSettings)
+		require.Empty(t, merged.Errors)
+
+		a := assert.New(t)
+		rbac := merged.PolicyIr.(*TrafficPolicy).spec.rbac.rbacConfig.GetRbac()
+		a.True(proto.Equal(withPolicies.GetRbac(), rbac))
+		a.Equal([]string{p1.PolicyRef.ID()}, merged.MergeOrigins.Get("rbac"))
+	})
+}
+
+func TestUnionMatchersMatcherTree(t *testing.T) {
+	tree := &cncfmatcherv3.Matcher{
+		MatcherType: &cncfmatcherv3.Matcher_MatcherTree_{MatcherTree: &cncfmatcherv3.Matcher_MatcherTree{}},
+	}
+	_, _, err := unionMatchers(tree, rbacWithMatchers("other").GetRbac().GetMatcher(), nil, nil)
+	assert.ErrorContains(t, err, "only matcher lists can be combined")
+}
+
+func TestMergeTrafficPoliciesInheritedPolicyPriorityPerField(t *testing.T) {
+	parentRef := &ir.AttachedPolicyRef{Name: "parent"}
+	child := &TrafficPolicy{spec: trafficPolicySpecIr{
+		timeouts: &timeoutsIR{routeTimeout: durationpb.New(5 * time.Second)},
+		buffer:   &bufferIR{perRoute: &bufferv3.BufferPerRoute{}},
+	}}
+	parent := &TrafficPolicy{
+		spec: trafficPolicySpecIr{
+			timeouts: &timeoutsIR{routeTimeout: durationpb.New(10 * time.Second)},
+			buffer:   &bufferIR{},
+		},
+		inheritedPolicyPriorities: map[string]apiannotations.InheritedPolicyPriorityValue{
+			mergeFieldTimeouts: apiannotations.ShallowMergePreferParent,
+		},
+	}
+
+	// merging the parent into the child across hierarchies prefers the child by default
+	out := &TrafficPolicy{spec: child.spec}
+	mergeOrigins := pluginsdkir.MergeOrigins{}
+	opts := policy.MergeOptions{Strategy: policy.AugmentedShallowMerge}
+	MergeTrafficPolicies(out, parent, parentRef, nil, opts, mergeOrigins, TrafficPolicyMergeOpts{})
+
+	a := assert.New(t)
+	// the per-field annotation on the parent prefers the parent's timeouts
+	a.Equal(10*time.Second, out.spec.timeouts.routeTimeout.AsDuration())
+	a.Equal([]string{parentRef.ID()}, mergeOrigins.Get("timeouts"))
+	a.Equal(apiannotations.ShallowMergePreferParent, out.inheritedPolicyPriorities[mergeFieldTimeouts])
+	// fields without the annotation keep the inherited priority
+	a.Same(child.spec.buffer, out.spec.buffer)
+	a.Empty(mergeOrigins.Get("buffer"))
+}
+
+func TestParseInheritedPolicyPriorities(t *testing.T) {
+	a := assert.New(t)
+
+	priorities, err := parseInheritedPolicyPriorities(map[string]string{
+		"kgateway.dev/inherited-policy-priority.timeouts": "ShallowMergePreferParent",
+		"kgateway.dev/inherited-policy-priority.extAuth":  "DeepMergePreferChild",
+		"kgateway.dev/inherited-policy-priority":          "DeepMergePreferParent",
+		"other":                                           "value",
+	})
+	a.NoError(err)
+	a.Equal(map[string]apiannotations.InheritedPolicyPriorityValue{
+		mergeFieldTimeouts: apiannotations.ShallowMergePreferParent,
+		mergeFieldExtAuth:  apiannotations.DeepMergePreferChild,
+	}, priorities)
+
+	priorities, err = parseInheritedPolicyPriorities(map[string]string{
+		"kgateway.dev/inherited-policy-priority.unknown": "ShallowMergePreferParent",
+		"kgateway.dev/inherited-policy-priority.retry":   "invalid",
+	})
+	a.Error(err)
+	a.ErrorContains(err, `unknown field "unknown"`)
+	a.ErrorContains(err, "invalid value for annotation kgateway.dev/inherited-policy-priority.retry")
+	a.Empty(priorities)
+}
+
+func headerMutation(header string) *header_mutationv3.HeaderMutationPerRoute {
+	return &header_mutationv3.HeaderMutationPerRoute{
+		Mutations: &header_mutationv3.Mutations{
+			RequestMutations: []*mutation_rulesv3.HeaderMutation{{
+				Action: &mutation_rulesv3.HeaderMutation_Append{
+					Append: &envoycorev3.HeaderValueOption{
+						Header: &envoycorev3.HeaderValue{Key: header, Value: "true"},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func rbacWithMatchers(name string) *envoyrbacv3.RBACPerRoute {
+	return &envoyrbacv3.RBACPerRoute{
+		Rbac: &envoyrbacv3.RBAC{
+			Matcher: &cncfmatcherv3.Matcher{
+				MatcherType: &cncfmatcherv3.Matcher_MatcherList_{
+					MatcherList: &cncfmatcherv3.Matcher_MatcherList{
+						Matchers: []*cncfmatcherv3.Matcher_MatcherList_FieldMatcher{{
+							OnMatch: &cncfmatcherv3.Matcher_OnMatch{
+								OnMatch: &cncfmatcherv3.Matcher_OnMatch_Action{
+									Action: &cncfcorev3.TypedExtensionConfig{Name: name},
+								},
+							},
+						}},
+					},
+				},
+			},
+		},
+	}
+}
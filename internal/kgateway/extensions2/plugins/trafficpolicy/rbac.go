@@ -73,6 +73,9 @@ func constructRBAC(policy *v1alpha1.TrafficPolicy, out *trafficPolicySpecIr) err
 	if err != nil {
 		return err
 	}
+	if spec.ShadowMode.Shadows(v1alpha1.ShadowModeFieldRBAC) {
+		toShadowRBAC(rbacConfig)
+	}
 
 	out.rbac = &rbacIR{
 		rbacConfig: rbacConfig,
@@ -127,6 +130,18 @@ func translateRBAC(rbac *v1alpha1.RBAC) (*envoyauthz.RBACPerRoute, error) {
 	return res, nil
 }
 
+// toShadowRBAC moves the enforced rules of the RBAC config to the shadow rules, so that
+// the filter evaluates them and emits stats and dynamic metadata without enforcing them.
+func toShadowRBAC(rbacConfig *envoyauthz.RBACPerRoute) {
+	rbac := rbacConfig.GetRbac()
+	if rbac == nil {
+		return
+	}
+	rbac.ShadowMatcher, rbac.Matcher = rbac.GetMatcher(), nil
+	rbac.ShadowRules, rbac.Rules = rbac.GetRules(), nil
+	rbac.ShadowRulesStatPrefix = rbacShadowRulesStatPrefix
+}
+
 func createCELMatcher(celExprs []string, action v1alpha1.AuthorizationPolicyAction) (*cncfmatcherv3.Matcher_MatcherList_FieldMatcher, error) {
 	if len(celExprs) == 0 {
 		return nil, fmt.Errorf("no CEL expressions provided")
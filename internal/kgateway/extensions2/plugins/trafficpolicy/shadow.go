@@ -0,0 +1,81 @@
+package trafficpolicy
+
+import (
+	"errors"
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+)
+
+// errExtAuthShadowMode is returned for a policy with extAuth in shadow mode for all its fields, since the
+// ext_authz filter has no dry-run mode and always enforces the denials of the authorization server.
+var errExtAuthShadowMode = errors.New("extAuth does not support shadow mode, list the fields evaluated in shadow mode instead")
+
+const (
+	// LocalRateLimitShadowHeader is added to requests that would have been rate limited
+	// by a local rate limit evaluated in shadow mode.
+	LocalRateLimitShadowHeader = "x-kgateway-ratelimit-shadow"
+
+	shadowStatPrefix          = "shadow"
+	rbacShadowRulesStatPrefix = "shadow_"
+	// shadowProviderNameSuffix is appended to the name of a GatewayExtension provider used in shadow mode,
+	// so that a dedicated filter is configured for it in the filter chain.
+	shadowProviderNameSuffix = "shadow"
+
+	localRatelimitFilterShadowEnforcedRuntimeKey = "local_rate_limit_shadow_enforced"
+	rateLimitFilterShadowEnforcedRuntimeKey      = "rate_limit_shadow_enforced"
+)
+
+// shadowGatewayExtension returns a copy of the given GatewayExtension provider that evaluates
+// requests without enforcing its decisions. The copy has a distinct name so that it is configured
+// as a separate filter from the enforcing provider.
+func shadowGatewayExtension(in *TrafficPolicyGatewayExtensionIR) *TrafficPolicyGatewayExtensionIR {
+	if in == nil {
+		return nil
+	}
+	out := *in
+	out.Name = fmt.Sprintf("%s/%s", in.Name, shadowProviderNameSuffix)
+	if in.RateLimit != nil {
+		rateLimit := proto.Clone(in.RateLimit).(*ratev3.RateLimit)
+		rateLimit.FailureModeDeny = false
+		rateLimit.StatPrefix = shadowName(rateLimit.GetStatPrefix())
+		// Enforce the filter for 0% of the requests so that the rate limit service is still
+		// queried and stats are emitted, but requests over the limit are not rejected.
+		rateLimit.FilterEnforced = &envoycorev3.RuntimeFractionalPercent{
+			RuntimeKey:   rateLimitFilterShadowEnforcedRuntimeKey,
+			DefaultValue: &typev3.FractionalPercent{},
+		}
+		out.RateLimit = rateLimit
+	}
+	return &out
+}
+
+// toShadowLocalRateLimit configures the local rate limit to count requests over the limit
+// without rejecting them.
+func toShadowLocalRateLimit(lrl *localratelimitv3.LocalRateLimit) {
+	lrl.StatPrefix = shadowName(lrl.GetStatPrefix())
+	lrl.FilterEnforced = &envoycorev3.RuntimeFractionalPercent{
+		RuntimeKey:   localRatelimitFilterShadowEnforcedRuntimeKey,
+		DefaultValue: &typev3.FractionalPercent{},
+	}
+	lrl.RequestHeadersToAddWhenNotEnforced = []*envoycorev3.HeaderValueOption{
+		{
+			Header: &envoycorev3.HeaderValue{
+				Key:   LocalRateLimitShadowHeader,
+				Value: "limited",
+			},
+			AppendAction: envoycorev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		},
+	}
+}
+
+func shadowName(name string) string {
+	if name == "" {
+		return shadowStatPrefix
+	}
+	return fmt.Sprintf("%s_%s", shadowStatPrefix, name)
+}
@@ -0,0 +1,140 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoy_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func TestShadowModeShadows(t *testing.T) {
+	var unset *v1alpha1.ShadowMode
+	assert.False(t, unset.Shadows(v1alpha1.ShadowModeFieldRBAC))
+
+	all := &v1alpha1.ShadowMode{}
+	assert.True(t, all.Shadows(v1alpha1.ShadowModeFieldRBAC))
+	assert.True(t, all.Shadows(v1alpha1.ShadowModeFieldRateLimit))
+	assert.True(t, all.ShadowsAll())
+
+	some := &v1alpha1.ShadowMode{Fields: []v1alpha1.ShadowModeField{v1alpha1.ShadowModeFieldRateLimit}}
+	assert.False(t, some.Shadows(v1alpha1.ShadowModeFieldRBAC))
+	assert.True(t, some.Shadows(v1alpha1.ShadowModeFieldRateLimit))
+	assert.False(t, some.ShadowsAll())
+}
+
+func TestConstructRBACShadowMode(t *testing.T) {
+	policy := &v1alpha1.TrafficPolicy{Spec: v1alpha1.TrafficPolicySpec{
+		RBAC: &v1alpha1.RBAC{
+			Policy: v1alpha1.RBACPolicy{
+				MatchExpressions: []string{"request.headers['x-user'] == 'admin'"},
+			},
+		},
+		ShadowMode: &v1alpha1.ShadowMode{Fields: []v1alpha1.ShadowModeField{v1alpha1.ShadowModeFieldRBAC}},
+	}}
+
+	out := trafficPolicySpecIr{}
+	require.NoError(t, constructRBAC(policy, &out))
+	require.NotNil(t, out.rbac)
+
+	rbac := out.rbac.rbacConfig.GetRbac()
+	assert.Nil(t, rbac.GetMatcher())
+	assert.Nil(t, rbac.GetRules())
+	assert.NotNil(t, rbac.GetShadowMatcher())
+	assert.Equal(t, rbacShadowRulesStatPrefix, rbac.GetShadowRulesStatPrefix())
+	require.NoError(t, out.rbac.Validate())
+}
+
+func TestConstructExtAuthShadowMode(t *testing.T) {
+	provider := &TrafficPolicyGatewayExtensionIR{
+		Name:    "default/ext-auth",
+		ExtType: v1alpha1.GatewayExtensionTypeExtAuth,
+		ExtAuth: &envoy_ext_authz_v3.ExtAuthz{StatPrefix: "authz"},
+	}
+	fetch := func(krt.HandlerContext, v1alpha1.NamespacedObjectReference, string) (*TrafficPolicyGatewayExtensionIR, error) {
+		return provider, nil
+	}
+	policy := func(shadowMode *v1alpha1.ShadowMode) *v1alpha1.TrafficPolicy {
+		return &v1alpha1.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: v1alpha1.TrafficPolicySpec{
+				ExtAuth: &v1alpha1.ExtAuthPolicy{
+					ExtensionRef: &v1alpha1.NamespacedObjectReference{Name: "ext-auth"},
+				},
+				ShadowMode: shadowMode,
+			},
+		}
+	}
+
+	// extAuth is rejected when all the fields of the policy are in shadow mode
+	out := trafficPolicySpecIr{}
+	require.ErrorIs(t, constructExtAuth(nil, policy(&v1alpha1.ShadowMode{}), fetch, &out), errExtAuthShadowMode)
+	assert.Nil(t, out.extAuth)
+
+	// extAuth is enforced when other fields of the policy are in shadow mode
+	out = trafficPolicySpecIr{}
+	shadowMode := &v1alpha1.ShadowMode{Fields: []v1alpha1.ShadowModeField{v1alpha1.ShadowModeFieldRBAC}}
+	require.NoError(t, constructExtAuth(nil, policy(shadowMode), fetch, &out))
+	require.Len(t, out.extAuth.perProviderConfig, 1)
+	assert.Equal(t, "default/ext-auth", providerName(out.extAuth.perProviderConfig[0].provider))
+	assert.False(t, out.extAuth.perProviderConfig[0].provider.ExtAuth.GetFailureModeAllow())
+}
+
+func TestConstructRateLimitShadowMode(t *testing.T) {
+	provider := &TrafficPolicyGatewayExtensionIR{
+		Name:      "default/ratelimit",
+		ExtType:   v1alpha1.GatewayExtensionTypeRateLimit,
+		RateLimit: &ratev3.RateLimit{Domain: "test", FailureModeDeny: true},
+	}
+	fetch := func(krt.HandlerContext, v1alpha1.NamespacedObjectReference, string) (*TrafficPolicyGatewayExtensionIR, error) {
+		return provider, nil
+	}
+	policy := &v1alpha1.TrafficPolicy{Spec: v1alpha1.TrafficPolicySpec{
+		RateLimit: &v1alpha1.RateLimit{
+			Local: &v1alpha1.LocalRateLimitPolicy{
+				TokenBucket: &v1alpha1.TokenBucket{
+					MaxTokens:    10,
+					FillInterval: metav1.Duration{Duration: time.Second},
+				},
+			},
+			Global: &v1alpha1.RateLimitPolicy{
+				ExtensionRef: v1alpha1.NamespacedObjectReference{Name: "ratelimit"},
+				Descriptors: []v1alpha1.RateLimitDescriptor{{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{{Type: v1alpha1.RateLimitDescriptorEntryTypeRemoteAddress}},
+				}},
+			},
+		},
+		ShadowMode: &v1alpha1.ShadowMode{Fields: []v1alpha1.ShadowModeField{v1alpha1.ShadowModeFieldRateLimit}},
+	}}
+
+	out := trafficPolicySpecIr{}
+	require.NoError(t, constructLocalRateLimit(policy, &out))
+	require.NoError(t, constructGlobalRateLimit(nil, policy, fetch, &out))
+
+	local := out.localRateLimit.config
+	assert.Equal(t, "shadow_"+localRateLimitStatPrefix, local.GetStatPrefix())
+	assert.Zero(t, local.GetFilterEnforced().GetDefaultValue().GetNumerator())
+	assert.Equal(t, uint32(100), local.GetFilterEnabled().GetDefaultValue().GetNumerator())
+	require.Len(t, local.GetRequestHeadersToAddWhenNotEnforced(), 1)
+	assert.Equal(t, LocalRateLimitShadowHeader, local.GetRequestHeadersToAddWhenNotEnforced()[0].GetHeader().GetKey())
+	require.NoError(t, out.localRateLimit.Validate())
+
+	global := out.globalRateLimit.provider
+	assert.Equal(t, "default/ratelimit/shadow", global.ResourceName())
+	assert.False(t, global.RateLimit.GetFailureModeDeny())
+	assert.NotNil(t, global.RateLimit.GetFilterEnforced())
+	assert.Zero(t, global.RateLimit.GetFilterEnforced().GetDefaultValue().GetNumerator())
+	assert.True(t, provider.RateLimit.GetFailureModeDeny())
+
+	// an empty local rate limit disables rate limiting and is left as is
+	policy.Spec.RateLimit.Local = &v1alpha1.LocalRateLimitPolicy{}
+	out = trafficPolicySpecIr{}
+	require.NoError(t, constructLocalRateLimit(policy, &out))
+	assert.Equal(t, createDisabledRateLimit(), out.localRateLimit.config)
+}
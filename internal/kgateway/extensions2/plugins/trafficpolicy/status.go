@@ -10,6 +10,7 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 )
 
 func getPolicyStatusFn(
@@ -42,3 +43,27 @@ func patchPolicyStatusFn(
 		return nil
 	}
 }
+
+// ignoredFields returns the status of the given fields that are set on the policy but are not
+// applicable at the level the policy is applied to, e.g., the route config of a Gateway listener.
+func (s trafficPolicySpecIr) ignoredFields(level string, fields ...string) []reporter.PolicyFieldStatus {
+	set := map[string]bool{
+		mergeFieldAI:                    s.ai != nil,
+		mergeFieldAutoHostRewrite:       s.autoHostRewrite != nil,
+		mergeFieldTimeouts:              s.timeouts != nil,
+		mergeFieldRetry:                 s.retry != nil,
+		mergeFieldDestinationRuleSubset: s.destinationRuleSubset != nil,
+	}
+	var out []reporter.PolicyFieldStatus
+	for _, field := range fields {
+		if !set[field] {
+			continue
+		}
+		out = append(out, reporter.PolicyFieldStatus{
+			Field:   field,
+			Reason:  reporter.PolicyFieldReasonIgnored,
+			Message: "not applicable to " + level,
+		})
+	}
+	return out
+}
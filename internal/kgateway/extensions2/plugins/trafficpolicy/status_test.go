@@ -0,0 +1,76 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+func TestIgnoredFields(t *testing.T) {
+	ignored := func(message string, fields ...string) []reporter.PolicyFieldStatus {
+		var out []reporter.PolicyFieldStatus
+		for _, f := range fields {
+			out = append(out, reporter.PolicyFieldStatus{
+				Field:   f,
+				Reason:  reporter.PolicyFieldReasonIgnored,
+				Message: message,
+			})
+		}
+		return out
+	}
+	policy := &TrafficPolicy{
+		spec: trafficPolicySpecIr{
+			autoHostRewrite: &autoHostRewriteIR{},
+			timeouts:        &timeoutsIR{},
+			retry:           &retryIR{},
+			buffer:          &bufferIR{},
+		},
+	}
+
+	t.Run("route config", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ir.GwTranslationCtx{}, nil)
+		pCtx := &ir.RouteConfigContext{Policy: policy}
+		pass.ApplyRouteConfigPlugin(pCtx, &envoyroutev3.RouteConfiguration{})
+		assert.Equal(t, ignored("not applicable to the route configuration of Gateway listeners",
+			"autoHostRewrite", "timeouts", "retry"), pCtx.IgnoredFields)
+	})
+
+	t.Run("virtual host applies the retry policy", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ir.GwTranslationCtx{}, nil)
+		pCtx := &ir.VirtualHostContext{Policy: policy}
+		pass.ApplyVhostPlugin(pCtx, &envoyroutev3.VirtualHost{})
+		assert.Equal(t, ignored("not applicable to the virtual hosts of Gateway listeners",
+			"autoHostRewrite", "timeouts"), pCtx.IgnoredFields)
+	})
+
+	t.Run("route applies all the fields", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ir.GwTranslationCtx{}, nil)
+		pCtx := &ir.RouteContext{Policy: policy}
+		err := pass.ApplyForRoute(pCtx, &envoyroutev3.Route{
+			Action: &envoyroutev3.Route_Route{Route: &envoyroutev3.RouteAction{}},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, pCtx.IgnoredFields)
+	})
+
+	t.Run("route without AI backends", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ir.GwTranslationCtx{}, nil)
+		pCtx := &ir.RouteContext{Policy: &TrafficPolicy{spec: trafficPolicySpecIr{ai: &aiPolicyIR{}}}}
+		err := pass.ApplyForRoute(pCtx, &envoyroutev3.Route{})
+		assert.NoError(t, err)
+		assert.Equal(t, ignored("not applicable to routes without AI backends", "ai"), pCtx.IgnoredFields)
+	})
+
+	t.Run("route backend", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ir.GwTranslationCtx{}, nil)
+		pCtx := &ir.RouteBackendContext{}
+		err := pass.ApplyForRouteBackend(policy, pCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, ignored("not applicable to route backends",
+			"autoHostRewrite", "timeouts", "retry"), pCtx.IgnoredFields)
+	})
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"strconv"
 	"time"
 
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
 	exteniondynamicmodulev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/dynamic_modules/v3"
 	bufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
 	corsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
@@ -82,6 +84,9 @@ type PolicySubIR interface {
 type TrafficPolicy struct {
 	ct   time.Time
 	spec trafficPolicySpecIr
+	// inheritedPolicyPriorities holds the per-field inherited policy priorities
+	// that override the priority inherited from the parent resource
+	inheritedPolicyPriorities map[string]apiannotations.InheritedPolicyPriorityValue
 }
 
 type trafficPolicySpecIr struct {
@@ -100,6 +105,9 @@ type trafficPolicySpecIr struct {
 	retry           *retryIR
 	timeouts        *timeoutsIR
 	rbac            *rbacIR
+	waf             *wafIR
+
+	destinationRuleSubset *destinationRuleSubsetIR
 }
 
 func (d *TrafficPolicy) CreationTime() time.Time {
@@ -160,6 +168,15 @@ func (d *TrafficPolicy) Equals(in any) bool {
 	if !d.spec.rbac.Equals(d2.spec.rbac) {
 		return false
 	}
+	if !d.spec.waf.Equals(d2.spec.waf) {
+		return false
+	}
+	if !d.spec.destinationRuleSubset.Equals(d2.spec.destinationRuleSubset) {
+		return false
+	}
+	if !maps.Equal(d.inheritedPolicyPriorities, d2.inheritedPolicyPriorities) {
+		return false
+	}
 	return true
 }
 
@@ -181,6 +198,8 @@ func (p *TrafficPolicy) Validate() error {
 	validators = append(validators, p.spec.buffer.Validate)
 	validators = append(validators, p.spec.autoHostRewrite.Validate)
 	validators = append(validators, p.spec.rbac.Validate)
+	validators = append(validators, p.spec.waf.Validate)
+	validators = append(validators, p.spec.destinationRuleSubset.Validate)
 	for _, validator := range validators {
 		if err := validator(); err != nil {
 			return err
@@ -206,6 +225,7 @@ type trafficPolicyPluginGwPass struct {
 	csrfInChain           map[string]*envoy_csrf_v3.CsrfPolicy
 	headerMutationInChain map[string]*header_mutationv3.HeaderMutationPerRoute
 	bufferInChain         map[string]*bufferv3.Buffer
+	wafInChain            map[string]*envoymatchingv3.ExtensionWithMatcher
 }
 
 var _ ir.ProxyTranslationPass = &trafficPolicyPluginGwPass{}
@@ -256,6 +276,10 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, me
 		if err != nil {
 			errors = append(errors, err)
 		}
+		globalPolicyTier, err := pluginsdkutils.ParseGlobalPolicyTierAnnotation(policyCR.Annotations, policyCR.Namespace, commoncol.Settings.GlobalPolicyNamespace)
+		if err != nil {
+			errors = append(errors, err)
+		}
 
 		pol := &ir.PolicyWrapper{
 			ObjectSource:     objSrc,
@@ -264,6 +288,7 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, me
 			TargetRefs:       pluginsdkutils.TargetRefsToPolicyRefsWithSectionName(policyCR.Spec.TargetRefs, policyCR.Spec.TargetSelectors),
 			Errors:           errors,
 			PrecedenceWeight: precedenceWeight,
+			GlobalPolicyTier: globalPolicyTier,
 		}
 		return pol
 	})
@@ -305,6 +330,8 @@ func (p *trafficPolicyPluginGwPass) ApplyRouteConfigPlugin(
 	}
 
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec)
+	pCtx.IgnoredFields = append(pCtx.IgnoredFields, policy.spec.ignoredFields("the route configuration of Gateway listeners",
+		mergeFieldAI, mergeFieldAutoHostRewrite, mergeFieldTimeouts, mergeFieldRetry, mergeFieldDestinationRuleSubset)...)
 }
 
 func (p *trafficPolicyPluginGwPass) ApplyVhostPlugin(
@@ -318,6 +345,8 @@ func (p *trafficPolicyPluginGwPass) ApplyVhostPlugin(
 
 	p.handlePerVHostPolicies(policy.spec, out)
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec)
+	pCtx.IgnoredFields = append(pCtx.IgnoredFields, policy.spec.ignoredFields("the virtual hosts of Gateway listeners",
+		mergeFieldAI, mergeFieldAutoHostRewrite, mergeFieldTimeouts, mergeFieldDestinationRuleSubset)...)
 }
 
 // called 0 or more times
@@ -378,7 +407,7 @@ func (p *trafficPolicyPluginGwPass) ApplyForRoute(pCtx *ir.RouteContext, outputR
 	}
 
 	if policy.spec.ai != nil {
-		var aiBackends []*v1alpha1.Backend
+		var aiBackends []string
 		// check if the backends selected by targetRef are all AI backends before applying the policy
 		for _, backend := range pCtx.In.Backends {
 			if backend.Backend.BackendObject == nil {
@@ -388,21 +417,22 @@ func (p *trafficPolicyPluginGwPass) ApplyForRoute(pCtx *ir.RouteContext, outputR
 			b, ok := backend.Backend.BackendObject.Obj.(*v1alpha1.Backend)
 			if !ok {
 				// AI policy cannot apply to kubernetes services
-				// TODO(npolshak): Report this as a warning on status
 				logger.Warn("AI Policy cannot apply to kubernetes services", "backend_name", backend.Backend.BackendObject.GetName())
 				continue
 			}
 			if b.Spec.Type != v1alpha1.BackendTypeAI {
 				// AI policy cannot apply to non-AI backends
-				// TODO(npolshak): Report this as a warning on status
 				logger.Warn("AI Policy cannot apply to non-AI backend", "backend_name", backend.Backend.BackendObject.GetName(), "backend_type", string(b.Spec.Type))
 				continue
 			}
-			aiBackends = append(aiBackends, b)
+			aiBackends = append(aiBackends, backend.Backend.BackendObject.ResourceName())
 		}
 		if len(aiBackends) > 0 {
 			// Apply the AI policy to the all AI backends
-			p.processAITrafficPolicy(&pCtx.TypedFilterConfig, policy.spec.ai)
+			p.processAITrafficPolicy(&pCtx.TypedFilterConfig, policy.spec.ai, routeResourceName(pCtx.In), aiBackends)
+			p.handleAITokenRateLimit(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec.ai)
+		} else {
+			pCtx.IgnoredFields = append(pCtx.IgnoredFields, policy.spec.ignoredFields("routes without AI backends", mergeFieldAI)...)
 		}
 	}
 
@@ -422,9 +452,17 @@ func (p *trafficPolicyPluginGwPass) ApplyForRouteBackend(
 	}
 
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, rtPolicy.spec)
+	pCtx.MetadataMatch = withDestinationRuleSubset(pCtx.MetadataMatch, rtPolicy.spec.destinationRuleSubset)
+	pCtx.IgnoredFields = append(pCtx.IgnoredFields, rtPolicy.spec.ignoredFields("route backends",
+		mergeFieldAutoHostRewrite, mergeFieldTimeouts, mergeFieldRetry)...)
 
 	if rtPolicy.spec.ai != nil && (rtPolicy.spec.ai.Transformation != nil || rtPolicy.spec.ai.Extproc != nil) {
-		p.processAITrafficPolicy(&pCtx.TypedFilterConfig, rtPolicy.spec.ai)
+		var backends []string
+		if pCtx.Backend != nil {
+			backends = []string{pCtx.Backend.ResourceName()}
+		}
+		p.processAITrafficPolicy(&pCtx.TypedFilterConfig, rtPolicy.spec.ai, routeResourceName(pCtx.In), backends)
+		p.handleAITokenRateLimit(pCtx.FilterChainName, &pCtx.TypedFilterConfig, rtPolicy.spec.ai)
 	}
 
 	return nil
@@ -604,6 +642,13 @@ func (p *trafficPolicyPluginGwPass) HttpFilters(fcc ir.FilterChainCommon) ([]plu
 		filters = append(filters, filter)
 	}
 
+	// Add the WAF filter, which is enabled by the per-route config.
+	if f := p.wafInChain[fcc.FilterChainName]; f != nil {
+		filter := sdkfilters.MustNewStagedFilter(wafFilterName, f, plugins.DuringStage(plugins.WafStage))
+		filter.Filter.Disabled = true
+		filters = append(filters, filter)
+	}
+
 	if f := p.rbacInChain[fcc.FilterChainName]; f != nil {
 		filter := plugins.MustNewStagedFilter(rbacFilterNamePrefix, f, plugins.DuringStage(plugins.AuthZStage))
 		filters = append(filters, filter)
@@ -635,6 +680,7 @@ func (p *trafficPolicyPluginGwPass) handlePolicies(
 	p.handleHeaderModifiers(fcn, typedFilterConfig, spec.headerModifiers)
 	p.handleBuffer(fcn, typedFilterConfig, spec.buffer)
 	p.handleRBAC(fcn, typedFilterConfig, spec.rbac)
+	p.handleWAF(fcn, typedFilterConfig, spec.waf)
 }
 
 // handlePerRoutePolicies handles policies that are meant to be processed at the route level
@@ -672,6 +718,8 @@ func (p *trafficPolicyPluginGwPass) handlePerRoutePolicies(
 	if action.GetRetryPolicy() == nil && spec.retry != nil {
 		action.RetryPolicy = spec.retry.policy
 	}
+
+	action.MetadataMatch = withDestinationRuleSubset(action.GetMetadataMatch(), spec.destinationRuleSubset)
 }
 
 // handlePerVHostPolicies handles policies that are meant to be processed at the vhost level
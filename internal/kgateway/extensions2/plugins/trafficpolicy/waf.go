@@ -0,0 +1,244 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	envoywasmfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoywasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+)
+
+const (
+	wafFilterName     = "waf"
+	wafCompositeName  = "composite_waf"
+	wafWasmFilterName = "envoy.filters.http.wasm"
+	wafWasmRuntime    = "envoy.wasm.runtime.v8"
+	wafVMID           = "coraza-waf"
+	wafDirectivesName = "default"
+
+	// wafParanoiaLevelRuleID is the id of the rule that sets the paranoia level. It is in the 1-99,999 range
+	// reserved for local use so that it does not conflict with the 900,000-999,999 range of the OWASP CRS,
+	// whose crs-setup.conf uses id 900000 to set the paranoia level.
+	wafParanoiaLevelRuleID = 10000
+)
+
+// wafIR is the internal representation of a WAF policy.
+// The WAF filter in the filter chain is a composite filter that does nothing by default,
+// and the per-route config selects the Coraza Wasm filter to execute for the route.
+type wafIR struct {
+	perRoute *envoymatchingv3.ExtensionWithMatcherPerRoute
+}
+
+var _ PolicySubIR = &wafIR{}
+
+func (w *wafIR) Equals(other PolicySubIR) bool {
+	otherWAF, ok := other.(*wafIR)
+	if !ok {
+		return false
+	}
+	if w == nil || otherWAF == nil {
+		return w == nil && otherWAF == nil
+	}
+	return proto.Equal(w.perRoute, otherWAF.perRoute)
+}
+
+// Validate performs validation on the waf component.
+func (w *wafIR) Validate() error {
+	if w == nil || w.perRoute == nil {
+		return nil
+	}
+	return w.perRoute.ValidateAll()
+}
+
+// constructWAF constructs the WAF policy IR from the policy specification.
+func constructWAF(
+	krtctx krt.HandlerContext,
+	in *v1alpha1.TrafficPolicy,
+	configMaps krt.Collection[*corev1.ConfigMap],
+	wasmPath string,
+	out *trafficPolicySpecIr,
+) error {
+	spec := in.Spec.WAF
+	if spec == nil {
+		return nil
+	}
+
+	// An empty matcher does not execute any filter, which disables the WAF for the target
+	if spec.Disable != nil {
+		out.waf = &wafIR{
+			perRoute: &envoymatchingv3.ExtensionWithMatcherPerRoute{
+				XdsMatcher: &xdsmatcherv3.Matcher{},
+			},
+		}
+		return nil
+	}
+
+	var ruleSets []string
+	for _, ruleSet := range spec.RuleSets {
+		nn := types.NamespacedName{
+			Name:      ruleSet.ConfigMapRef.Name,
+			Namespace: in.GetNamespace(),
+		}
+		cm := krt.FetchOne(krtctx, configMaps, krt.FilterObjectName(nn))
+		if cm == nil {
+			return fmt.Errorf("waf: ConfigMap %s not found", nn)
+		}
+		directives, err := wafRuleSetDirectives(*cm, ruleSet.Key)
+		if err != nil {
+			return fmt.Errorf("waf: %w", err)
+		}
+		ruleSets = append(ruleSets, directives...)
+	}
+
+	wasmFilter, err := buildWAFWasmFilter(buildWAFDirectives(spec, ruleSets), wasmPath)
+	if err != nil {
+		return fmt.Errorf("waf: %w", err)
+	}
+	out.waf = &wafIR{
+		perRoute: &envoymatchingv3.ExtensionWithMatcherPerRoute{
+			XdsMatcher: &xdsmatcherv3.Matcher{
+				OnNoMatch: &xdsmatcherv3.Matcher_OnMatch{
+					OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+						Action: &xdscorev3.TypedExtensionConfig{
+							Name: "composite-action",
+							TypedConfig: utils.MustMessageToAny(&envoycompositev3.ExecuteFilterAction{
+								TypedConfig: &envoycorev3.TypedExtensionConfig{
+									Name:        wafWasmFilterName,
+									TypedConfig: utils.MustMessageToAny(wasmFilter),
+								},
+							}),
+						},
+					},
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// wafRuleSetDirectives returns the directives stored in the given key of the ConfigMap,
+// or in all its keys in lexicographical order if key is nil.
+func wafRuleSetDirectives(cm *corev1.ConfigMap, key *string) ([]string, error) {
+	if key != nil {
+		directives, ok := cm.Data[*key]
+		if !ok {
+			return nil, fmt.Errorf("key %s not found in ConfigMap %s/%s", *key, cm.GetNamespace(), cm.GetName())
+		}
+		return []string{directives}, nil
+	}
+	if len(cm.Data) == 0 {
+		return nil, fmt.Errorf("ConfigMap %s/%s has no data", cm.GetNamespace(), cm.GetName())
+	}
+	keys := make([]string, 0, len(cm.Data))
+	for k := range cm.Data {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	directives := make([]string, 0, len(keys))
+	for _, k := range keys {
+		directives = append(directives, cm.Data[k])
+	}
+	return directives, nil
+}
+
+// buildWAFDirectives returns the SecLang directives for the WAF policy.
+// The paranoia level must be set before the rule sets are loaded, while the rule exclusions
+// and the rule engine mode are set after them so that they take precedence over the rule sets.
+func buildWAFDirectives(spec *v1alpha1.WAF, ruleSets []string) []string {
+	var directives []string
+	if spec.ParanoiaLevel != nil {
+		directives = append(directives, fmt.Sprintf(
+			`SecAction "id:%d,phase:1,pass,t:none,nolog,setvar:tx.blocking_paranoia_level=%d,setvar:tx.paranoia_level=%d"`,
+			wafParanoiaLevelRuleID, *spec.ParanoiaLevel, *spec.ParanoiaLevel,
+		))
+	}
+	directives = append(directives, ruleSets...)
+	for _, exclusion := range spec.RuleExclusions {
+		switch {
+		case exclusion.ID != nil:
+			directives = append(directives, "SecRuleRemoveById "+*exclusion.ID)
+		case exclusion.Tag != nil:
+			directives = append(directives, fmt.Sprintf("SecRuleRemoveByTag %q", *exclusion.Tag))
+		}
+	}
+	engine := "On"
+	if ptr.Deref(spec.Mode, v1alpha1.WAFModeEnforce) == v1alpha1.WAFModeAuditOnly {
+		engine = "DetectionOnly"
+	}
+	return append(directives, "SecRuleEngine "+engine)
+}
+
+// buildWAFWasmFilter builds the Coraza Wasm filter config for the given directives.
+func buildWAFWasmFilter(directives []string, wasmPath string) (*envoywasmfilterv3.Wasm, error) {
+	// see https://github.com/corazawaf/coraza-proxy-wasm for the configuration format
+	configuration, err := json.Marshal(map[string]any{
+		"directives_map": map[string][]string{
+			wafDirectivesName: directives,
+		},
+		"default_directives": wafDirectivesName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &envoywasmfilterv3.Wasm{
+		Config: &envoywasmv3.PluginConfig{
+			Name: wafFilterName,
+			Vm: &envoywasmv3.PluginConfig_VmConfig{
+				VmConfig: &envoywasmv3.VmConfig{
+					VmId:    wafVMID,
+					Runtime: wafWasmRuntime,
+					Code: &envoycorev3.AsyncDataSource{
+						Specifier: &envoycorev3.AsyncDataSource_Local{
+							Local: &envoycorev3.DataSource{
+								Specifier: &envoycorev3.DataSource_Filename{
+									Filename: wasmPath,
+								},
+							},
+						},
+					},
+				},
+			},
+			Configuration: utils.MustMessageToAny(wrapperspb.String(string(configuration))),
+			FailurePolicy: envoywasmv3.FailurePolicy_FAIL_CLOSED,
+		},
+	}, nil
+}
+
+func (p *trafficPolicyPluginGwPass) handleWAF(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, waf *wafIR) {
+	if waf == nil || waf.perRoute == nil {
+		return
+	}
+
+	// Add the per-route matcher that selects the WAF filter to execute for the route
+	pCtxTypedFilterConfig.AddTypedConfig(wafFilterName, waf.perRoute)
+
+	// Add a disabled composite WAF filter to the chain, which is enabled by the per-route config
+	if p.wafInChain == nil {
+		p.wafInChain = make(map[string]*envoymatchingv3.ExtensionWithMatcher)
+	}
+	if _, ok := p.wafInChain[fcn]; !ok {
+		p.wafInChain[fcn] = &envoymatchingv3.ExtensionWithMatcher{
+			ExtensionConfig: &envoycorev3.TypedExtensionConfig{
+				Name:        wafCompositeName,
+				TypedConfig: utils.MustMessageToAny(&envoycompositev3.Composite{}),
+			},
+			XdsMatcher: &xdsmatcherv3.Matcher{},
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func TestBuildWAFDirectives(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *v1alpha1.WAF
+		ruleSets []string
+		expected []string
+	}{
+		{
+			name:     "defaults to enforce",
+			spec:     &v1alpha1.WAF{},
+			ruleSets: []string{"SecRuleEngine DetectionOnly", "SecRule ARGS \"@rx foo\" \"id:1,deny\""},
+			expected: []string{"SecRuleEngine DetectionOnly", "SecRule ARGS \"@rx foo\" \"id:1,deny\"", "SecRuleEngine On"},
+		},
+		{
+			name: "paranoia level, exclusions and audit only",
+			spec: &v1alpha1.WAF{
+				ParanoiaLevel: ptr.To[int32](3),
+				RuleExclusions: []v1alpha1.WAFRuleExclusion{
+					{ID: ptr.To("942100-942999")},
+					{Tag: ptr.To("attack-xss")},
+				},
+				Mode: ptr.To(v1alpha1.WAFModeAuditOnly),
+			},
+			ruleSets: []string{"Include @owasp_crs/*.conf"},
+			expected: []string{
+				`SecAction "id:10000,phase:1,pass,t:none,nolog,setvar:tx.blocking_paranoia_level=3,setvar:tx.paranoia_level=3"`,
+				"Include @owasp_crs/*.conf",
+				"SecRuleRemoveById 942100-942999",
+				`SecRuleRemoveByTag "attack-xss"`,
+				"SecRuleEngine DetectionOnly",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildWAFDirectives(tt.spec, tt.ruleSets))
+		})
+	}
+}
+
+func TestWAFRuleSetDirectives(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "rules"},
+		Data: map[string]string{
+			"b.conf": "SecRule b",
+			"a.conf": "SecRule a",
+		},
+	}
+
+	directives, err := wafRuleSetDirectives(cm, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"SecRule a", "SecRule b"}, directives)
+
+	directives, err = wafRuleSetDirectives(cm, ptr.To("b.conf"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"SecRule b"}, directives)
+
+	_, err = wafRuleSetDirectives(cm, ptr.To("c.conf"))
+	assert.EqualError(t, err, "key c.conf not found in ConfigMap default/rules")
+
+	_, err = wafRuleSetDirectives(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "empty"}}, nil)
+	assert.EqualError(t, err, "ConfigMap default/empty has no data")
+}
+
+func TestWAFIRValidate(t *testing.T) {
+	wasm, err := buildWAFWasmFilter([]string{"SecRuleEngine On"}, "/etc/envoy/wasm/coraza-waf.wasm")
+	require.NoError(t, err)
+	require.NoError(t, wasm.ValidateAll())
+
+	var nilIR *wafIR
+	assert.NoError(t, nilIR.Validate())
+	assert.True(t, nilIR.Equals((*wafIR)(nil)))
+}
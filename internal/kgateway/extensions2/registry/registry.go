@@ -11,6 +11,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/backend"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/backendconfigpolicy"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/backendtlspolicy"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/backendtrafficpolicy"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/destrule"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/directresponse"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/httplistenerpolicy"
@@ -18,6 +19,8 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/kubernetes"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/sandwich"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/serviceentry"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/serviceimport"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/tcppolicy"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/trafficpolicy"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	pluginsdkcol "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
@@ -89,7 +92,10 @@ func Plugins(
 		httplistenerpolicy.NewPlugin(ctx, commoncol),
 		backendtlspolicy.NewPlugin(ctx, commoncol),
 		serviceentry.NewPlugin(ctx, commoncol),
+		serviceimport.NewPlugin(ctx, commoncol),
 		sandwich.NewPlugin(),
 		backendconfigpolicy.NewPlugin(ctx, commoncol, validator),
+		tcppolicy.NewPlugin(ctx, commoncol),
+		backendtrafficpolicy.NewPlugin(ctx, commoncol),
 	}
 }
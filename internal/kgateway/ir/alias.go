@@ -18,6 +18,7 @@ type (
 	HttpRouteRuleIR       = ir.HttpRouteRuleIR
 	EndpointsForBackend   = ir.EndpointsForBackend
 	EndpointWithMd        = ir.EndpointWithMd
+	LocalityLbMap         = ir.LocalityLbMap
 	HttpRouteRuleMatchIR  = ir.HttpRouteRuleMatchIR
 	PodLocality           = ir.PodLocality
 	UniqlyConnectedClient = ir.UniqlyConnectedClient
@@ -49,6 +50,7 @@ type (
 	Resources         = ir.Resources
 	TcpRouteIR        = ir.TcpRouteIR
 	TlsRouteIR        = ir.TlsRouteIR
+	UdpRouteIR        = ir.UdpRouteIR
 
 	Listener = ir.Listener
 
@@ -61,9 +63,13 @@ type (
 	RouteConfigContext = ir.RouteConfigContext
 	TcpIR              = ir.TcpIR
 	TlsBundle          = ir.TlsBundle
+	UdpIR              = ir.UdpIR
 
 	CustomEnvoyFilter = ir.CustomEnvoyFilter
 	VirtualHost       = ir.VirtualHost
+
+	Http3Settings = ir.Http3Settings
+	Http3PolicyIR = ir.Http3PolicyIR
 )
 
 var (
@@ -74,4 +80,5 @@ var (
 	WithInheritedPolicyPriority = ir.WithInheritedPolicyPriority
 	ErrNotAttachable            = ir.ErrNotAttachable
 	ParseAppProtocol            = ir.ParseAppProtocol
+	Http3ForListener            = ir.Http3ForListener
 )
@@ -36,7 +36,8 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 )
 
-const statefulSessionFilterName = "envoy.filters.http.stateful_session"
+// StatefulSessionFilterName is the name of the stateful session filter that implements session persistence.
+const StatefulSessionFilterName = "envoy.filters.http.stateful_session"
 
 type applyToRoute interface {
 	// apply may be invoked multiple times on the route, once for each policy.
@@ -150,7 +151,7 @@ func buildHTTPRouteRulePolicy(rule gwv1.HTTPRouteRule) ruleIR {
 	return ruleIR{
 		retry:              convertRetry(rule.Retry, rule.Timeouts),
 		timeouts:           convertTimeouts(rule.Timeouts),
-		sessionPersistence: convertSessionPersistence(rule.SessionPersistence),
+		sessionPersistence: ConvertSessionPersistence(rule.SessionPersistence),
 	}
 }
 
@@ -168,7 +169,7 @@ func (p *builtinPluginGwPass) applyRulePolicy(
 	r.applyTimeouts(outputRoute.GetRoute(), r.retry != nil, mergeOpts)
 	r.applyRetry(outputRoute.GetRoute(), mergeOpts)
 
-	if r.sessionPersistence != nil && policy.IsSettable(outputRoute.GetTypedPerFilterConfig()[statefulSessionFilterName], mergeOpts) {
+	if r.sessionPersistence != nil && policy.IsSettable(outputRoute.GetTypedPerFilterConfig()[StatefulSessionFilterName], mergeOpts) {
 		if outputRoute.GetTypedPerFilterConfig() == nil {
 			outputRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
 		}
@@ -177,7 +178,7 @@ func (p *builtinPluginGwPass) applyRulePolicy(
 			logger.Error("error marshalling SessionPersistence", "error", err)
 			return err
 		}
-		outputRoute.GetTypedPerFilterConfig()[statefulSessionFilterName] = anyMsg
+		outputRoute.GetTypedPerFilterConfig()[StatefulSessionFilterName] = anyMsg
 		p.needStatefulSession[pCtx.FilterChainName] = true
 	}
 	return nil
@@ -301,7 +302,19 @@ func (r ruleIR) applyRetry(
 	action.RetryPolicy = r.retry
 }
 
-func convertSessionPersistence(sessionPersistence *gwv1.SessionPersistence) *stateful_sessionv3.StatefulSessionPerRoute {
+// RuleHasSessionPersistence returns whether the built-in policies of an HTTPRoute rule configure session persistence.
+func RuleHasSessionPersistence(policies ir.AttachedPolicies) bool {
+	for _, pol := range policies.Policies[pluginsdkir.VirtualBuiltInGK] {
+		if p, ok := pol.PolicyIr.(*builtinPlugin); ok && p.rule.sessionPersistence != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ConvertSessionPersistence converts the Gateway API session persistence config to the
+// per-route config of the stateful session filter.
+func ConvertSessionPersistence(sessionPersistence *gwv1.SessionPersistence) *stateful_sessionv3.StatefulSessionPerRoute {
 	if sessionPersistence == nil {
 		return nil
 	}
@@ -647,7 +660,7 @@ func (p *builtinPluginGwPass) HttpFilters(fcc pluginsdkir.FilterChainCommon) ([]
 	}
 
 	if p.needStatefulSession[fcc.FilterChainName] {
-		stagedFilter, err := plugins.NewStagedFilter(statefulSessionFilterName, &stateful_sessionv3.StatefulSession{}, plugins.DuringStage(plugins.AcceptedStage))
+		stagedFilter, err := plugins.NewStagedFilter(StatefulSessionFilterName, &stateful_sessionv3.StatefulSession{}, plugins.DuringStage(plugins.AcceptedStage))
 		if err != nil {
 			return nil, err
 		}
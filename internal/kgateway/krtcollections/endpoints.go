@@ -146,6 +146,8 @@ func transformK8sEndpoints(inputs EndpointsInputs,
 
 		// Handle deduplication of endpoint addresses
 		seenAddresses := make(map[string]struct{})
+		// A ready endpoint takes precedence over a draining endpoint with the same address
+		readyAddresses := readyEndpointAddresses(endpointSlices)
 
 		// Add an endpoint to the returned EndpointsForBackend for each EndpointSlice
 		for _, endpointSlice := range endpointSlices {
@@ -158,9 +160,14 @@ func transformK8sEndpoints(inputs EndpointsInputs,
 			}
 
 			for _, endpoint := range endpointSlice.Endpoints {
-				// Skip endpoints that are not ready
+				// Skip endpoints that are not ready, except the terminating endpoints that are still serving:
+				// they are kept as draining so that the in-flight requests complete, but get no new requests
+				draining := false
 				if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
-					continue
+					if !IsServingTerminating(endpoint.Conditions) {
+						continue
+					}
+					draining = true
 				}
 				// Get the addresses
 				for _, addr := range endpoint.Addresses {
@@ -168,6 +175,9 @@ func transformK8sEndpoints(inputs EndpointsInputs,
 					if _, exists := seenAddresses[addr]; exists {
 						continue
 					}
+					if _, ready := readyAddresses[addr]; draining && ready {
+						continue
+					}
 					seenAddresses[addr] = struct{}{}
 
 					var podName string
@@ -184,6 +194,7 @@ func transformK8sEndpoints(inputs EndpointsInputs,
 
 					var augmentedLabels map[string]string
 					var l ir.PodLocality
+					var weight uint32
 					if podName != "" {
 						maybePod := krt.FetchOne(kctx, augmentedPods, krt.FilterObjectName(types.NamespacedName{
 							Namespace: podNamespace,
@@ -192,9 +203,16 @@ func transformK8sEndpoints(inputs EndpointsInputs,
 						if maybePod != nil {
 							l = maybePod.Locality
 							augmentedLabels = maybePod.AugmentedLabels
+							weight = maybePod.Weight
 						}
 					}
 					ep := CreateLBEndpoint(addr, port, augmentedLabels, enableAutoMtls)
+					if weight > 0 {
+						ep.LoadBalancingWeight = wrapperspb.UInt32(weight)
+					}
+					if draining {
+						ep.HealthStatus = envoycorev3.HealthStatus_DRAINING
+					}
 
 					ret.Add(l, ir.EndpointWithMd{
 						LbEndpoint: ep,
@@ -212,6 +230,28 @@ func transformK8sEndpoints(inputs EndpointsInputs,
 	}
 }
 
+func readyEndpointAddresses(endpointSlices []*discoveryv1.EndpointSlice) map[string]struct{} {
+	addresses := make(map[string]struct{})
+	for _, endpointSlice := range endpointSlices {
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			for _, addr := range endpoint.Addresses {
+				addresses[addr] = struct{}{}
+			}
+		}
+	}
+	return addresses
+}
+
+// IsServingTerminating returns whether the endpoint is terminating but still serving, in which case it is
+// kept as draining so that the in-flight requests complete.
+func IsServingTerminating(conditions discoveryv1.EndpointConditions) bool {
+	return conditions.Serving != nil && *conditions.Serving &&
+		conditions.Terminating != nil && *conditions.Terminating
+}
+
 func CreateLBEndpoint(address string, port uint32, podLabels map[string]string, enableAutoMtls bool) *envoyendpointv3.LbEndpoint {
 	// Don't get the metadata labels and filter metadata for the envoy load balancer based on the backend, as this is not used
 	// metadata := getLbMetadata(upstream, labels, "")
@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
+	"github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
@@ -1062,6 +1063,133 @@ func TestEndpoints(t *testing.T) {
 				return result
 			},
 		},
+		{
+			name: "weighted and draining endpoints",
+			inputs: []any{
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "pod1",
+						Namespace:   "ns",
+						Annotations: map[string]string{annotations.EndpointWeight: "3"},
+					},
+					Status: corev1.PodStatus{
+						Phase: corev1.PodRunning,
+						PodIP: "1.2.3.4",
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pod2",
+						Namespace: "ns",
+					},
+					Status: corev1.PodStatus{
+						Phase: corev1.PodRunning,
+						PodIP: "1.2.3.5",
+					},
+				},
+				&discoveryv1.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc-slice",
+						Namespace: "ns",
+						Labels: map[string]string{
+							"kubernetes.io/service-name": "svc",
+						},
+					},
+					AddressType: discoveryv1.AddressTypeIPv4,
+					Endpoints: []discoveryv1.Endpoint{
+						{
+							// terminating endpoint with the same address as a ready endpoint
+							Addresses: []string{"1.2.3.4"},
+							Conditions: discoveryv1.EndpointConditions{
+								Ready:       ptr.To(false),
+								Serving:     ptr.To(true),
+								Terminating: ptr.To(true),
+							},
+						},
+						{
+							Addresses: []string{"1.2.3.4"},
+							Conditions: discoveryv1.EndpointConditions{
+								Ready: ptr.To(true),
+							},
+							TargetRef: &corev1.ObjectReference{
+								Kind:      "Pod",
+								Name:      "pod1",
+								Namespace: "ns",
+							},
+						},
+						{
+							Addresses: []string{"1.2.3.5"},
+							Conditions: discoveryv1.EndpointConditions{
+								Ready:       ptr.To(false),
+								Serving:     ptr.To(true),
+								Terminating: ptr.To(true),
+							},
+							TargetRef: &corev1.ObjectReference{
+								Kind:      "Pod",
+								Name:      "pod2",
+								Namespace: "ns",
+							},
+						},
+						{
+							// terminating endpoint that is not serving anymore
+							Addresses: []string{"1.2.3.6"},
+							Conditions: discoveryv1.EndpointConditions{
+								Ready:       ptr.To(false),
+								Serving:     ptr.To(false),
+								Terminating: ptr.To(true),
+							},
+						},
+					},
+					Ports: []discoveryv1.EndpointPort{
+						{
+							Name:     ptr.To("http"),
+							Port:     ptr.To(int32(8080)),
+							Protocol: ptr.To(corev1.ProtocolTCP),
+						},
+					},
+				},
+			},
+			upstream: newBackendObjectIR(ir.BackendObjectIR{
+				ObjectSource: ir.ObjectSource{
+					Namespace: "ns",
+					Name:      "svc",
+					Group:     "",
+					Kind:      "Service",
+				},
+				Port: 8080,
+				Obj: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc",
+						Namespace: "ns",
+					},
+					Spec: corev1.ServiceSpec{
+						Ports: []corev1.ServicePort{
+							{
+								Name: "http",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			}),
+			result: func(us ir.BackendObjectIR) *ir.EndpointsForBackend {
+				weighted := CreateLBEndpoint("1.2.3.4", 8080, nil, false)
+				weighted.LoadBalancingWeight = wrapperspb.UInt32(3)
+				draining := CreateLBEndpoint("1.2.3.5", 8080, nil, false)
+				draining.HealthStatus = envoycorev3.HealthStatus_DRAINING
+
+				result := ir.NewEndpointsForBackend(us)
+				result.Add(ir.PodLocality{}, ir.EndpointWithMd{
+					LbEndpoint: weighted,
+					EndpointMd: ir.EndpointMetadata{Labels: map[string]string{}},
+				})
+				result.Add(ir.PodLocality{}, ir.EndpointWithMd{
+					LbEndpoint: draining,
+					EndpointMd: ir.EndpointMetadata{Labels: map[string]string{}},
+				})
+				return result
+			},
+		},
 		{
 			name: "multiple ports",
 			inputs: []any{
@@ -488,6 +488,7 @@ func TestTransformGRPCRoute(t *testing.T) {
 				grpcRoutes,
 				krttest.GetMockCollection[*gwv1a2.TCPRoute](mock),
 				krttest.GetMockCollection[*gwv1a2.TLSRoute](mock),
+				krttest.GetMockCollection[*gwv1a2.UDPRoute](mock),
 				policies,
 				backends,
 				refgrants,
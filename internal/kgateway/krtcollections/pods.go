@@ -2,6 +2,7 @@ package krtcollections
 
 import (
 	"maps"
+	"strconv"
 
 	istioannot "istio.io/api/annotation"
 	"istio.io/api/label"
@@ -13,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
 )
@@ -125,6 +127,8 @@ type LocalityPod struct {
 	Locality        ir.PodLocality
 	AugmentedLabels map[string]string
 	Addresses       []string
+	// Weight is the load balancing weight of the endpoints of the pod, 0 when unset.
+	Weight uint32
 }
 
 // Addresses returns the first address if there are any.
@@ -139,7 +143,8 @@ func (c LocalityPod) Equals(in LocalityPod) bool {
 	return c.Named == in.Named &&
 		c.Locality == in.Locality &&
 		maps.Equal(c.AugmentedLabels, in.AugmentedLabels) &&
-		slices.Equal(c.Addresses, in.Addresses)
+		slices.Equal(c.Addresses, in.Addresses) &&
+		c.Weight == in.Weight
 }
 
 func newNodeCollection(istioClient kube.Client, krtOptions krtutil.KrtOptions) krt.Collection[NodeMetadata] {
@@ -286,10 +291,29 @@ func augmentPodLabels(nodes krt.Collection[NodeMetadata]) func(kctx krt.HandlerC
 			AugmentedLabels: labels,
 			Locality:        l,
 			Addresses:       extractPodIPs(pod),
+			Weight:          endpointWeight(pod),
 		}
 	}
 }
 
+// endpointWeight returns the load balancing weight of the endpoints of the pod from its EndpointWeight
+// annotation or label, or 0 if it has none or its value is invalid.
+func endpointWeight(pod *corev1.Pod) uint32 {
+	value, ok := pod.Annotations[annotations.EndpointWeight]
+	if !ok {
+		value, ok = pod.Labels[annotations.EndpointWeight]
+	}
+	if !ok {
+		return 0
+	}
+	weight, err := strconv.ParseUint(value, 10, 16)
+	if err != nil || weight == 0 {
+		logger.Warn("ignoring invalid endpoint weight", "pod", pod.Namespace+"/"+pod.Name, "value", value)
+		return 0
+	}
+	return uint32(weight)
+}
+
 func LocalityFromLabels(labels map[string]string) ir.PodLocality {
 	region := labels[corev1.LabelTopologyRegion]
 	zone := labels[corev1.LabelTopologyZone]
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
@@ -159,6 +160,46 @@ func TestPods(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "endpoint weight annotation takes precedence over label",
+			inputs: []any{
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "name",
+						Namespace:   "ns",
+						Labels:      map[string]string{annotations.EndpointWeight: "2"},
+						Annotations: map[string]string{annotations.EndpointWeight: "3"},
+					},
+				},
+			},
+			result: krtcollections.LocalityPod{
+				Named: krt.Named{
+					Name:      "name",
+					Namespace: "ns",
+				},
+				AugmentedLabels: map[string]string{annotations.EndpointWeight: "2"},
+				Weight:          3,
+			},
+		},
+		{
+			name: "invalid endpoint weight",
+			inputs: []any{
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "name",
+						Namespace:   "ns",
+						Annotations: map[string]string{annotations.EndpointWeight: "0"},
+					},
+				},
+			},
+			result: krtcollections.LocalityPod{
+				Named: krt.Named{
+					Name:      "name",
+					Namespace: "ns",
+				},
+				AugmentedLabels: map[string]string{},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"istio.io/istio/pkg/config/labels"
 	"istio.io/istio/pkg/kube/krt"
@@ -282,6 +283,22 @@ func (i *BackendIndex) getBackendFromAlias(kctx krt.HandlerContext, gk schema.Gr
 	return out, nil
 }
 
+// withRouteBackendPolicies returns a copy of the backend with the policies attached to it that
+// apply to the routes referencing it, or the backend itself if there are no such policies.
+// The backend is copied so the policies are only visible on the route backend.
+func (i *BackendIndex) withRouteBackendPolicies(kctx krt.HandlerContext, backend *ir.BackendObjectIR) *ir.BackendObjectIR {
+	if backend == nil {
+		return nil
+	}
+	policies := i.policies.getTargetingPoliciesForRouteBackends(kctx, backend.ObjectSource)
+	if len(policies) == 0 {
+		return backend
+	}
+	out := *backend
+	out.AttachedPolicies = toAttachedPolicies(policies)
+	return &out
+}
+
 func (i *BackendIndex) getBackendFromRef(kctx krt.HandlerContext, localns string, ref gwv1.BackendObjectReference) (*ir.BackendObjectIR, error) {
 	resolved := toFromBackendRef(localns, ref)
 	return i.getBackend(kctx, resolved.GetGroupKind(), types.NamespacedName{Namespace: resolved.Namespace, Name: resolved.Name}, ref.Port)
@@ -561,6 +578,8 @@ type policyAndIndex struct {
 	policiesByTargetRef krt.Collection[ir.PolicyWrapper]
 	index               krt.Index[targetRefIndexKey, ir.PolicyWrapper]
 	forBackends         bool
+	// forRouteBackends is set if the policies attached to backends also apply to the routes referencing them
+	forRouteBackends bool
 }
 type PolicyIndex struct {
 	globalPolicyNamespace string
@@ -640,6 +659,7 @@ func NewPolicyIndex(
 				policiesByTargetRef: policiesByTargetRef,
 				index:               targetRefIndex,
 				forBackends:         forBackends,
+				forRouteBackends:    forBackends && plugin.ApplyBackendPoliciesToRoutes,
 			}
 			index.hasSyncedFuncs = append(index.hasSyncedFuncs, plugin.Policies.HasSynced)
 		}
@@ -706,6 +726,46 @@ func (p *PolicyIndex) fetchByTargetRefLabels(
 	return ret
 }
 
+// getTargetingPoliciesForRouteBackends returns the policies targeting the given backend by name
+// that apply to the routes referencing the backend, ordered by creation time.
+// Only the policy collections of such plugins are fetched, so routes are not recomputed
+// on changes to other backend policies.
+func (p *PolicyIndex) getTargetingPoliciesForRouteBackends(
+	kctx krt.HandlerContext,
+	targetRef ir.ObjectSource,
+) []ir.PolicyAtt {
+	refIndexKey := targetRefIndexKey{
+		Group:     targetRef.Group,
+		Kind:      targetRef.Kind,
+		Name:      targetRef.Name,
+		Namespace: targetRef.Namespace,
+	}
+	var ret []ir.PolicyAtt
+	for _, policyCol := range p.availablePolicies {
+		if !policyCol.forRouteBackends {
+			continue
+		}
+		for _, pol := range krt.Fetch(kctx, policyCol.policiesByTargetRef, krt.FilterIndex(policyCol.index, refIndexKey)) {
+			ret = append(ret, ir.PolicyAtt{
+				Generation: pol.Policy.GetGeneration(),
+				GroupKind:  pol.GetGroupKind(),
+				PolicyIr:   pol.PolicyIR,
+				PolicyRef: &ir.AttachedPolicyRef{
+					Group:     pol.Group,
+					Kind:      pol.Kind,
+					Name:      pol.Name,
+					Namespace: pol.Namespace,
+				},
+				Errors: pol.Errors,
+			})
+		}
+	}
+	slices.SortFunc(ret, func(a, b ir.PolicyAtt) int {
+		return a.PolicyIr.CreationTime().Compare(b.PolicyIr.CreationTime())
+	})
+	return ret
+}
+
 // Attachment happens during collection creation (i.e. this file), and not translation. so these methods don't need to be public!
 // note: we may want to change that for global policies maybe.
 
@@ -780,7 +840,12 @@ func (p *PolicyIndex) getTargetingPoliciesMaybeForBackends(
 		}
 	}
 
+	globalPolicyNamespace := p.globalPolicyNamespace
 	for _, p := range policies {
+		var globalPolicyTier apiannotations.GlobalPolicyTierValue
+		if globalPolicyNamespace != "" && p.Namespace == globalPolicyNamespace {
+			globalPolicyTier = p.GlobalPolicyTier
+		}
 		ret = append(ret, ir.PolicyAtt{
 			Generation: p.Policy.GetGeneration(),
 			GroupKind:  p.GetGroupKind(),
@@ -793,14 +858,19 @@ func (p *PolicyIndex) getTargetingPoliciesMaybeForBackends(
 				SectionName: sectionName,
 			},
 			PrecedenceWeight: p.PrecedenceWeight,
+			GlobalPolicyTier: globalPolicyTier,
 			Errors:           p.Errors,
+			FieldStatuses:    p.FieldStatuses,
 		})
 	}
 
 	slices.SortFunc(ret, func(a, b ir.PolicyAtt) int {
-		// Sort policies by their PrecedenceWeight for the same kind if the weights are different,
-		// otherwise sort by creation time
+		// Sort policies by their global policy tier for the same kind, then by their PrecedenceWeight
+		// if the weights are different, otherwise sort by creation time
 		if a.GroupKind == b.GroupKind {
+			if c := globalPolicyTierRank(a.GlobalPolicyTier) - globalPolicyTierRank(b.GlobalPolicyTier); c != 0 {
+				return c
+			}
 			if a.PrecedenceWeight > b.PrecedenceWeight {
 				return -1
 			} else if a.PrecedenceWeight < b.PrecedenceWeight {
@@ -812,6 +882,19 @@ func (p *PolicyIndex) getTargetingPoliciesMaybeForBackends(
 	return ret
 }
 
+// globalPolicyTierRank returns the sort rank of the given global policy tier, where a lower rank
+// implies a higher priority: Override tier policies come first and Default tier policies come last.
+func globalPolicyTierRank(tier apiannotations.GlobalPolicyTierValue) int {
+	switch tier {
+	case apiannotations.GlobalPolicyTierOverride:
+		return -1
+	case apiannotations.GlobalPolicyTierDefault:
+		return 1
+	default:
+		return 0
+	}
+}
+
 func (p *PolicyIndex) fetchPolicy(kctx krt.HandlerContext, policyRef ir.ObjectSource) *ir.PolicyWrapper {
 	gk := policyRef.GetGroupKind()
 	if f, ok := p.policiesFetch[gk]; ok {
@@ -943,6 +1026,12 @@ func (c RouteWrapper) Equals(in RouteWrapper) bool {
 		} else {
 			return a.Equals(*bhttp)
 		}
+	case *ir.UdpRouteIR:
+		if bhttp, ok := in.Route.(*ir.UdpRouteIR); !ok {
+			return false
+		} else {
+			return a.Equals(*bhttp)
+		}
 	}
 	panic("unknown route type")
 }
@@ -983,6 +1072,7 @@ func NewRoutesIndex(
 	grpcroutes krt.Collection[*gwv1.GRPCRoute],
 	tcproutes krt.Collection[*gwv1a2.TCPRoute],
 	tlsroutes krt.Collection[*gwv1a2.TLSRoute],
+	udproutes krt.Collection[*gwv1a2.UDPRoute],
 	policies *PolicyIndex,
 	backends *BackendIndex,
 	refgrants *RefGrantIndex,
@@ -994,7 +1084,7 @@ func NewRoutesIndex(
 		backends:                backends,
 		weightedRoutePrecedence: globalSettings.WeightedRoutePrecedence,
 	}
-	h.hasSyncedFuncs = append(h.hasSyncedFuncs, httproutes.HasSynced, grpcroutes.HasSynced, tcproutes.HasSynced, tlsroutes.HasSynced)
+	h.hasSyncedFuncs = append(h.hasSyncedFuncs, httproutes.HasSynced, grpcroutes.HasSynced, tcproutes.HasSynced, tlsroutes.HasSynced, udproutes.HasSynced)
 
 	h.httpRoutes = krt.NewCollection(httproutes, h.transformHttpRoute, krtopts.ToOptions("http-routes-with-policy")...)
 	httpRouteCollection := krt.NewCollection(h.httpRoutes, func(kctx krt.HandlerContext, i ir.HttpRouteIR) *RouteWrapper {
@@ -1010,11 +1100,16 @@ func NewRoutesIndex(
 		t := h.transformTlsRoute(kctx, i)
 		return &RouteWrapper{Route: t}
 	}, krtopts.ToOptions("routes-tls-routes-with-policy")...)
+
+	udpRoutesCollection := krt.NewCollection(udproutes, func(kctx krt.HandlerContext, i *gwv1a2.UDPRoute) *RouteWrapper {
+		t := h.transformUdpRoute(kctx, i)
+		return &RouteWrapper{Route: t}
+	}, krtopts.ToOptions("routes-udp-routes-with-policy")...)
 	grpcRoutesCollection := krt.NewCollection(grpcroutes, func(kctx krt.HandlerContext, i *gwv1.GRPCRoute) *RouteWrapper {
 		t := h.transformGRPCRoute(kctx, i)
 		return &RouteWrapper{Route: t}
 	}, krtopts.ToOptions("routes-grpc-routes-with-policy")...)
-	h.routes = krt.JoinCollection([]krt.Collection[RouteWrapper]{httpRouteCollection, grpcRoutesCollection, tcpRoutesCollection, tlsRoutesCollection}, krtopts.ToOptions("all-routes-with-policy")...)
+	h.routes = krt.JoinCollection([]krt.Collection[RouteWrapper]{httpRouteCollection, grpcRoutesCollection, tcpRoutesCollection, tlsRoutesCollection, udpRoutesCollection}, krtopts.ToOptions("all-routes-with-policy")...)
 
 	httpBySelector := krtpkg.UnnamedIndex(h.httpRoutes, func(i ir.HttpRouteIR) []HTTPRouteSelector {
 		value, ok := i.SourceObject.GetLabels()[apilabels.DelegationLabelSelector]
@@ -1133,9 +1228,10 @@ func (h *RoutesIndex) transformTcpRoute(kctx krt.HandlerContext, i *gwv1a2.TCPRo
 		Namespace: i.Namespace,
 		Name:      i.Name,
 	}
+	// TCPRoute rules have no matches, so the backends of all the rules share the traffic based on their weights.
 	var backends []gwv1.BackendRef
-	if len(i.Spec.Rules) > 0 {
-		backends = i.Spec.Rules[0].BackendRefs
+	for _, rule := range i.Spec.Rules {
+		backends = append(backends, rule.BackendRefs...)
 	}
 	return &ir.TcpRouteIR{
 		ObjectSource:     src,
@@ -1153,9 +1249,10 @@ func (h *RoutesIndex) transformTlsRoute(kctx krt.HandlerContext, i *gwv1a2.TLSRo
 		Namespace: i.Namespace,
 		Name:      i.Name,
 	}
+	// TLSRoute rules have no matches, so the backends of all the rules share the traffic based on their weights.
 	var backends []gwv1.BackendRef
-	if len(i.Spec.Rules) > 0 {
-		backends = i.Spec.Rules[0].BackendRefs
+	for _, rule := range i.Spec.Rules {
+		backends = append(backends, rule.BackendRefs...)
 	}
 	return &ir.TlsRouteIR{
 		ObjectSource:     src,
@@ -1167,6 +1264,38 @@ func (h *RoutesIndex) transformTlsRoute(kctx krt.HandlerContext, i *gwv1a2.TLSRo
 	}
 }
 
+func (h *RoutesIndex) transformUdpRoute(kctx krt.HandlerContext, i *gwv1a2.UDPRoute) *ir.UdpRouteIR {
+	src := ir.ObjectSource{
+		Group:     gwv1a2.SchemeGroupVersion.Group,
+		Kind:      "UDPRoute",
+		Namespace: i.Namespace,
+		Name:      i.Name,
+	}
+	var backends []gwv1.BackendRef
+	if len(i.Spec.Rules) > 0 {
+		backends = i.Spec.Rules[0].BackendRefs
+	}
+
+	var idleTimeout *time.Duration
+	if v := i.Annotations[apiannotations.UDPRouteIdleTimeout]; v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			logger.Error("invalid udp idle timeout; using the default", "resource_ref", src, "value", v, "error", err)
+		} else {
+			idleTimeout = &d
+		}
+	}
+
+	return &ir.UdpRouteIR{
+		ObjectSource:     src,
+		SourceObject:     i,
+		ParentRefs:       i.Spec.ParentRefs,
+		Backends:         h.getTcpBackends(kctx, src, backends),
+		AttachedPolicies: toAttachedPolicies(h.policies.getTargetingPolicies(kctx, src, "", i.GetLabels())),
+		IdleTimeout:      idleTimeout,
+	}
+}
+
 func (h *RoutesIndex) transformHttpRoute(kctx krt.HandlerContext, i *gwv1.HTTPRoute) *ir.HttpRouteIR {
 	src := ir.ObjectSource{
 		Group:     gwv1.SchemeGroupVersion.Group,
@@ -1314,6 +1443,7 @@ func (h *RoutesIndex) resolveExtension(kctx krt.HandlerContext, ns string, ext g
 			PolicyRef:        policyRef,
 			Errors:           policy.Errors,
 			PrecedenceWeight: policy.PrecedenceWeight,
+			FieldStatuses:    policy.FieldStatuses,
 		}
 		return policyAtt, nil
 	}
@@ -1366,6 +1496,7 @@ func (h *RoutesIndex) getBackends(kctx krt.HandlerContext, src ir.ObjectSource,
 		}
 
 		backend, err := h.backends.GetBackendFromRef(kctx, src, ref.BackendRef.BackendObjectReference)
+		backend = h.backends.withRouteBackendPolicies(kctx, backend)
 
 		// TODO: if we can't find the backend, should we
 		// still use its cluster name in case it comes up later?
@@ -1442,6 +1573,8 @@ func toAttachedPolicies(policies []ir.PolicyAtt, opts ...ir.PolicyAttachmentOpts
 			Errors:           p.Errors,
 			Generation:       p.Generation,
 			PrecedenceWeight: p.PrecedenceWeight,
+			GlobalPolicyTier: p.GlobalPolicyTier,
+			FieldStatuses:    p.FieldStatuses,
 		}
 		for _, o := range opts {
 			o(&polAtt)
@@ -120,6 +120,9 @@ func InitCollections(
 	tlsRoutes := krt.WrapClient(kclient.NewDelayedInformer[*gwv1a2.TLSRoute](istioClient, gvr.TLSRoute, kubetypes.StandardInformer, filter), krtopts.ToOptions("TLSRoute")...)
 	metrics.RegisterEvents(tlsRoutes, kmetrics.GetResourceMetricEventHandler[*gwv1a2.TLSRoute]())
 
+	udpRoutes := krt.WrapClient(kclient.NewDelayedInformer[*gwv1a2.UDPRoute](istioClient, gvr.UDPRoute, kubetypes.StandardInformer, filter), krtopts.ToOptions("UDPRoute")...)
+	metrics.RegisterEvents(udpRoutes, kmetrics.GetResourceMetricEventHandler[*gwv1a2.UDPRoute]())
+
 	grpcRoutes := krt.WrapClient(kclient.NewFiltered[*gwv1.GRPCRoute](istioClient, filter), krtopts.ToOptions("GRPCRoute")...)
 	metrics.RegisterEvents(grpcRoutes, kmetrics.GetResourceMetricEventHandler[*gwv1.GRPCRoute]())
 
@@ -146,7 +149,7 @@ func InitCollections(
 	endpointIRs := initEndpoints(plugins, krtopts)
 
 	gateways := NewGatewayIndex(krtopts, controllerName, policies, kubeRawGateways, kubeRawListenerSets, gatewayClasses, namespaces)
-	routes := NewRoutesIndex(krtopts, httpRoutes, grpcRoutes, tcproutes, tlsRoutes, policies, backendIndex, refgrants, globalSettings)
+	routes := NewRoutesIndex(krtopts, httpRoutes, grpcRoutes, tcproutes, tlsRoutes, udpRoutes, policies, backendIndex, refgrants, globalSettings)
 	return gateways, routes, backendIndex, endpointIRs
 }
 
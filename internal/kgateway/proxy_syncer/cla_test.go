@@ -6,6 +6,7 @@ import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	"github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/endpoints"
@@ -146,3 +147,39 @@ func TestTranslatesDestrulesFailover(t *testing.T) {
 	g.Expect(localLocality.Priority).To(gomega.Equal(uint32(0)))
 	g.Expect(remoteLocality.Priority).To(gomega.Equal(uint32(1)))
 }
+
+func TestLocalityWeightExcludesDrainingEndpoints(t *testing.T) {
+	g := gomega.NewWithT(t)
+	us := ir.BackendObjectIR{
+		ObjectSource: ir.ObjectSource{
+			Namespace: "ns",
+			Name:      "name",
+		},
+	}
+	efu := ir.NewEndpointsForBackend(us)
+	addEndpoint := func(path string, weight uint32, healthStatus envoycorev3.HealthStatus) {
+		efu.Add(ir.PodLocality{Region: "R1"}, ir.EndpointWithMd{
+			LbEndpoint: &envoyendpointv3.LbEndpoint{
+				HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{
+					Endpoint: &envoyendpointv3.Endpoint{
+						Address: &envoycorev3.Address{
+							Address: &envoycorev3.Address_Pipe{Pipe: &envoycorev3.Pipe{Path: path}},
+						},
+					},
+				},
+				LoadBalancingWeight: wrapperspb.UInt32(weight),
+				HealthStatus:        healthStatus,
+			},
+		})
+	}
+	addEndpoint("a", 3, envoycorev3.HealthStatus_UNKNOWN)
+	addEndpoint("b", 1, envoycorev3.HealthStatus_UNKNOWN)
+	addEndpoint("c", 2, envoycorev3.HealthStatus_DRAINING)
+
+	cla := endpoints.PrioritizeEndpoints(nil, ir.UniqlyConnectedClient{Namespace: "ns"}, endpoints.EndpointsInputs{
+		EndpointsForBackend: *efu,
+	})
+	g.Expect(cla.Endpoints).To(gomega.HaveLen(1))
+	g.Expect(cla.Endpoints[0].LbEndpoints).To(gomega.HaveLen(3))
+	g.Expect(cla.Endpoints[0].LoadBalancingWeight.GetValue()).To(gomega.Equal(uint32(4)))
+}
@@ -0,0 +1,131 @@
+package proxy_syncer
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/idtoken"
+	"istio.io/istio/pkg/kube/krt"
+)
+
+const (
+	// gcpIDTokenRefreshMargin is how long before their expiry the ID tokens are refreshed.
+	gcpIDTokenRefreshMargin = 5 * time.Minute
+	// gcpIDTokenRetryInterval is the interval between two attempts to mint an ID token.
+	gcpIDTokenRetryInterval = 10 * time.Second
+)
+
+var errGcpIDTokenPending = errors.New("the ID token has not been minted yet")
+
+// gcpIDTokenKey identifies the ID tokens minted for an audience with a service account key.
+type gcpIDTokenKey struct {
+	serviceAccountKeyHash [sha256.Size]byte
+	audience              string
+}
+
+// gcpIDToken is the state of the ID tokens minted for an audience with a service account key.
+type gcpIDToken struct {
+	// authorization is the value of the Authorization header carrying the last ID token minted.
+	authorization string
+	// err is the error of the last attempt to mint an ID token, if it failed.
+	err error
+	// requested is set when the ID token is requested, and reset after each refresh.
+	requested bool
+}
+
+// gcpIDTokens mints the Google ID tokens served over SDS to the proxies sending requests to GCP
+// backends authenticated with a service account key. The ID tokens are minted in the background
+// and refreshed before they expire, until they are no longer requested.
+type gcpIDTokens struct {
+	ctx     context.Context
+	trigger *krt.RecomputeTrigger
+	// newTokenSource returns the source of the ID tokens for the audience, minted with the service account key.
+	newTokenSource func(ctx context.Context, audience string, serviceAccountKey []byte) (oauth2.TokenSource, error)
+
+	mu     sync.Mutex
+	tokens map[gcpIDTokenKey]*gcpIDToken
+}
+
+func newGcpIDTokens(ctx context.Context) *gcpIDTokens {
+	return &gcpIDTokens{
+		ctx:     ctx,
+		trigger: krt.NewRecomputeTrigger(true),
+		newTokenSource: func(ctx context.Context, audience string, serviceAccountKey []byte) (oauth2.TokenSource, error) {
+			return idtoken.NewTokenSource(ctx, audience, idtoken.WithCredentialsJSON(serviceAccountKey))
+		},
+		tokens: map[gcpIDTokenKey]*gcpIDToken{},
+	}
+}
+
+// Get returns the value of the Authorization header carrying an ID token for the audience, minted with
+// the service account key. The handler context is recomputed each time the ID token is refreshed.
+func (t *gcpIDTokens) Get(kctx krt.HandlerContext, serviceAccountKey []byte, audience string) (string, error) {
+	t.trigger.MarkDependant(kctx)
+
+	key := gcpIDTokenKey{
+		serviceAccountKeyHash: sha256.Sum256(serviceAccountKey),
+		audience:              audience,
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	token, ok := t.tokens[key]
+	if !ok {
+		token = &gcpIDToken{}
+		t.tokens[key] = token
+		go t.refresh(key, token, serviceAccountKey, audience)
+	}
+	token.requested = true
+	if token.authorization == "" {
+		if token.err != nil {
+			return "", token.err
+		}
+		return "", errGcpIDTokenPending
+	}
+	return token.authorization, nil
+}
+
+// refresh mints the ID tokens until the context is done or the ID token was not requested since
+// the last refresh. Each refresh recomputes the handler contexts that requested ID tokens, so the
+// ID tokens still in use are requested again.
+func (t *gcpIDTokens) refresh(key gcpIDTokenKey, token *gcpIDToken, serviceAccountKey []byte, audience string) {
+	for {
+		wait := gcpIDTokenRetryInterval
+		// A new token source is created for each refresh, as token sources reuse their
+		// tokens until they are about to expire.
+		var minted *oauth2.Token
+		tokenSource, err := t.newTokenSource(t.ctx, audience, serviceAccountKey)
+		if err == nil {
+			minted, err = tokenSource.Token()
+		}
+
+		t.mu.Lock()
+		if !token.requested {
+			delete(t.tokens, key)
+			t.mu.Unlock()
+			return
+		}
+		token.requested = false
+		if err != nil {
+			logger.Error("failed to mint gcp id token", "audience", audience, "error", err)
+			token.err = err
+		} else {
+			token.authorization = "Bearer " + minted.AccessToken
+			token.err = nil
+			if refreshIn := time.Until(minted.Expiry) - gcpIDTokenRefreshMargin; refreshIn > wait {
+				wait = refreshIn
+			}
+		}
+		t.mu.Unlock()
+		t.trigger.TriggerRecomputation()
+
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package proxy_syncer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"istio.io/istio/pkg/kube/krt"
+)
+
+func TestGcpIDTokens(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var minted atomic.Int32
+	tokens := newGcpIDTokens(ctx)
+	tokens.newTokenSource = func(_ context.Context, audience string, serviceAccountKey []byte) (oauth2.TokenSource, error) {
+		if string(serviceAccountKey) != "key" {
+			return nil, errors.New("invalid key")
+		}
+		minted.Add(1)
+		return oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: "token-for-" + audience,
+			Expiry:      time.Now().Add(time.Hour),
+		}), nil
+	}
+	kctx := krt.TestingDummyContext{}
+
+	_, err := tokens.Get(kctx, []byte("key"), "https://example.com")
+	assert.ErrorIs(t, err, errGcpIDTokenPending)
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		authorization, err := tokens.Get(kctx, []byte("key"), "https://example.com")
+		assert.NoError(c, err)
+		assert.Equal(c, "Bearer token-for-https://example.com", authorization)
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), minted.Load())
+
+	_, err = tokens.Get(kctx, []byte("other-key"), "https://example.com")
+	assert.ErrorIs(t, err, errGcpIDTokenPending)
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		_, err := tokens.Get(kctx, []byte("other-key"), "https://example.com")
+		assert.EqualError(c, err, "invalid key")
+	}, time.Second, 10*time.Millisecond)
+}
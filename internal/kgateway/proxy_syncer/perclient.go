@@ -4,13 +4,24 @@ import (
 	"fmt"
 	"maps"
 
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_credential_injector_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/credential_injector/v3"
+	envoy_generic_credential_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/generic/v3"
+	envoy_oauth2_credential_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/oauth2/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
 	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/krt"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/sslutils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
 	krtutil "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
 )
@@ -20,7 +31,10 @@ type clustersWithErrors struct {
 	erroredClusters     []string
 	erroredClustersHash uint64
 	clustersHash        uint64
-	resourceName        string
+	// secrets are the SDS secrets referenced by the clusters, served over ADS.
+	secrets      envoycache.Resources
+	secretsHash  uint64
+	resourceName string
 }
 
 type endpointsWithUccName struct {
@@ -35,7 +49,7 @@ func (c clustersWithErrors) ResourceName() string {
 var _ krt.Equaler[clustersWithErrors] = new(clustersWithErrors)
 
 func (c clustersWithErrors) Equals(k clustersWithErrors) bool {
-	return c.clustersHash == k.clustersHash && c.erroredClustersHash == k.erroredClustersHash
+	return c.clustersHash == k.clustersHash && c.erroredClustersHash == k.erroredClustersHash && c.secretsHash == k.secretsHash
 }
 
 func (c endpointsWithUccName) ResourceName() string {
@@ -54,6 +68,8 @@ func snapshotPerClient(
 	mostXdsSnapshots krt.Collection[GatewayXdsResources],
 	endpoints PerClientEnvoyEndpoints,
 	clusters PerClientEnvoyClusters,
+	secrets *krtcollections.SecretIndex,
+	gcpIDTokens *gcpIDTokens,
 ) krt.Collection[XdsSnapWrapper] {
 	clusterSnapshot := krt.NewCollection(uccCol, func(kctx krt.HandlerContext, ucc ir.UniqlyConnectedClient) *clustersWithErrors {
 		clustersForUcc := clusters.FetchClustersForClient(kctx, ucc)
@@ -68,7 +84,9 @@ func snapshotPerClient(
 			clustersHash        uint64
 			erroredClustersHash uint64
 			erroredClusters     []string
+			secretsHash         uint64
 		)
+		secretsProto := map[string]envoycachetypes.ResourceWithTTL{}
 		for _, c := range clustersForUcc {
 			if c.Error != nil {
 				erroredClusters = append(erroredClusters, c.Name)
@@ -81,6 +99,13 @@ func snapshotPerClient(
 			}
 			clustersProto = append(clustersProto, envoycachetypes.ResourceWithTTL{Resource: c.Cluster})
 			clustersHash ^= c.ClusterVersion
+			for _, secret := range clusterSdsSecrets(kctx, secrets, gcpIDTokens, c.Cluster) {
+				if _, ok := secretsProto[secret.GetName()]; ok {
+					continue
+				}
+				secretsProto[secret.GetName()] = envoycachetypes.ResourceWithTTL{Resource: secret}
+				secretsHash ^= utils.HashProto(secret)
+			}
 		}
 		clustersVersion := fmt.Sprintf("%d", clustersHash)
 
@@ -91,7 +116,12 @@ func snapshotPerClient(
 			erroredClusters:     erroredClusters,
 			clustersHash:        clustersHash,
 			erroredClustersHash: erroredClustersHash,
-			resourceName:        ucc.ResourceName(),
+			secrets: envoycache.Resources{
+				Version: fmt.Sprintf("%d", secretsHash),
+				Items:   secretsProto,
+			},
+			secretsHash:  secretsHash,
+			resourceName: ucc.ResourceName(),
 		}
 	}, krtopts.ToOptions("ClusterResources")...)
 
@@ -160,6 +190,7 @@ func snapshotPerClient(
 		snapshot.Resources[envoycachetypes.Endpoint] = clientEndpointResources.endpoints
 		snapshot.Resources[envoycachetypes.Route] = listenerRouteSnapshot.Routes
 		snapshot.Resources[envoycachetypes.Listener] = listenerRouteSnapshot.Listeners
+		snapshot.Resources[envoycachetypes.Secret] = clustersForUcc.secrets
 		// envoycache.NewResources(version, resource)
 		snap.snap = snapshot
 		logger.Debug("snapshots", "proxy_key", snap.proxyKey,
@@ -233,3 +264,143 @@ func snapshotPerClient(
 
 	return xdsSnapshotsForUcc
 }
+
+// clusterSdsSecrets returns the SDS secrets served from Kubernetes Secrets that are referenced by
+// the upstream TLS contexts and the upstream credential injector filters of the cluster.
+func clusterSdsSecrets(
+	kctx krt.HandlerContext,
+	secrets *krtcollections.SecretIndex,
+	gcpIDTokens *gcpIDTokens,
+	cluster *envoyclusterv3.Cluster,
+) []*envoytlsv3.Secret {
+	transportSockets := make([]*envoycorev3.TransportSocket, 0, len(cluster.GetTransportSocketMatches())+1)
+	if cluster.GetTransportSocket() != nil {
+		transportSockets = append(transportSockets, cluster.GetTransportSocket())
+	}
+	for _, match := range cluster.GetTransportSocketMatches() {
+		transportSockets = append(transportSockets, match.GetTransportSocket())
+	}
+
+	var out []*envoytlsv3.Secret
+	for _, transportSocket := range transportSockets {
+		typedConfig := transportSocket.GetTypedConfig()
+		if typedConfig == nil || !typedConfig.MessageIs(&envoytlsv3.UpstreamTlsContext{}) {
+			continue
+		}
+		tlsContext := &envoytlsv3.UpstreamTlsContext{}
+		if err := typedConfig.UnmarshalTo(tlsContext); err != nil {
+			logger.Error("failed to unmarshal upstream tls context", "cluster", cluster.GetName(), "error", err)
+			continue
+		}
+		for _, sdsName := range sslutils.UpstreamTLSSdsSecretNames(tlsContext) {
+			namespace, name, isCA, _ := sslutils.ParseKubernetesSdsSecretName(sdsName)
+			// The Kubernetes SDS secrets are only referenced by the DestinationRule TLS settings, whose
+			// credentialName refers to a Secret in the namespace of the DestinationRule, which is the
+			// namespace of the SDS secret name. Resolve the secret as referenced by the DestinationRule,
+			// so that the same ReferenceGrant checks apply as when the TLS settings were translated.
+			secret, err := secrets.GetSecret(kctx, krtcollections.From{
+				GroupKind: wellknown.DestinationRuleGVK.GroupKind(),
+				Namespace: namespace,
+			}, gwv1.SecretObjectReference{Name: gwv1.ObjectName(name)})
+			if err != nil {
+				logger.Error("failed to get secret for sds", "cluster", cluster.GetName(), "secret", sdsName, "error", err)
+				continue
+			}
+			sdsSecret, err := sslutils.KubernetesSdsSecret(sdsName, isCA, secret.Data)
+			if err != nil {
+				logger.Error("invalid secret for sds", "cluster", cluster.GetName(), "secret", sdsName, "error", err)
+				continue
+			}
+			out = append(out, sdsSecret)
+		}
+	}
+
+	for _, sdsName := range upstreamFilterSdsSecretNames(cluster) {
+		if namespace, name, key, ok := sslutils.ParseKubernetesGenericSdsSecretName(sdsName); ok {
+			// The generic SDS secrets are only referenced by the auth of the Backends, whose secretRef
+			// refers to a Secret in the namespace of the Backend.
+			secret, err := secrets.GetSecret(kctx, krtcollections.From{
+				GroupKind: wellknown.BackendGVK.GroupKind(),
+				Namespace: namespace,
+			}, gwv1.SecretObjectReference{Name: gwv1.ObjectName(name)})
+			if err != nil {
+				logger.Error("failed to get secret for sds", "cluster", cluster.GetName(), "secret", sdsName, "error", err)
+				continue
+			}
+			value := secret.Data[key]
+			if len(value) == 0 {
+				logger.Error("invalid secret for sds", "cluster", cluster.GetName(), "secret", sdsName, "error", fmt.Sprintf("no %s key", key))
+				continue
+			}
+			out = append(out, sslutils.GenericSdsSecret(sdsName, value))
+			continue
+		}
+		if namespace, name, audience, ok := sslutils.ParseGcpIDTokenSdsSecretName(sdsName); ok {
+			secret, err := secrets.GetSecret(kctx, krtcollections.From{
+				GroupKind: wellknown.BackendGVK.GroupKind(),
+				Namespace: namespace,
+			}, gwv1.SecretObjectReference{Name: gwv1.ObjectName(name)})
+			if err != nil {
+				logger.Error("failed to get secret for sds", "cluster", cluster.GetName(), "secret", sdsName, "error", err)
+				continue
+			}
+			authorization, err := gcpIDTokens.Get(kctx, secret.Data[wellknown.ServiceAccountKey], audience)
+			if err != nil {
+				logger.Debug("gcp id token not available for sds", "cluster", cluster.GetName(), "secret", sdsName, "error", err)
+				continue
+			}
+			out = append(out, sslutils.GenericSdsSecret(sdsName, []byte(authorization)))
+		}
+	}
+	return out
+}
+
+// upstreamFilterSdsSecretNames returns the names of the SDS secrets served by the kgateway xDS server that
+// are referenced by the credentials of the upstream credential injector filters of the cluster.
+func upstreamFilterSdsSecretNames(cluster *envoyclusterv3.Cluster) []string {
+	optsAny, ok := cluster.GetTypedExtensionProtocolOptions()["envoy.extensions.upstreams.http.v3.HttpProtocolOptions"]
+	if !ok {
+		return nil
+	}
+	opts := &envoy_upstreams_v3.HttpProtocolOptions{}
+	if err := optsAny.UnmarshalTo(opts); err != nil {
+		logger.Error("failed to unmarshal http protocol options", "cluster", cluster.GetName(), "error", err)
+		return nil
+	}
+
+	var names []string
+	addName := func(sdsConfig *envoytlsv3.SdsSecretConfig) {
+		if sdsConfig.GetSdsConfig().GetAds() != nil {
+			names = append(names, sdsConfig.GetName())
+		}
+	}
+	for _, filter := range opts.GetHttpFilters() {
+		typedConfig := filter.GetTypedConfig()
+		if typedConfig == nil || !typedConfig.MessageIs(&envoy_credential_injector_v3.CredentialInjector{}) {
+			continue
+		}
+		injector := &envoy_credential_injector_v3.CredentialInjector{}
+		if err := typedConfig.UnmarshalTo(injector); err != nil {
+			logger.Error("failed to unmarshal credential injector", "cluster", cluster.GetName(), "error", err)
+			continue
+		}
+		credentialAny := injector.GetCredential().GetTypedConfig()
+		switch {
+		case credentialAny.MessageIs(&envoy_oauth2_credential_v3.OAuth2{}):
+			credential := &envoy_oauth2_credential_v3.OAuth2{}
+			if err := credentialAny.UnmarshalTo(credential); err != nil {
+				logger.Error("failed to unmarshal oauth2 credential", "cluster", cluster.GetName(), "error", err)
+				continue
+			}
+			addName(credential.GetClientCredentials().GetClientSecret())
+		case credentialAny.MessageIs(&envoy_generic_credential_v3.Generic{}):
+			credential := &envoy_generic_credential_v3.Generic{}
+			if err := credentialAny.UnmarshalTo(credential); err != nil {
+				logger.Error("failed to unmarshal generic credential", "cluster", cluster.GetName(), "error", err)
+				continue
+			}
+			addName(credential.GetCredential())
+		}
+	}
+	return names
+}
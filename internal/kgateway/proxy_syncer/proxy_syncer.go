@@ -194,6 +194,9 @@ func (r report) Equals(in report) bool {
 	if !maps.Equal(r.reportMap.TLSRoutes, in.reportMap.TLSRoutes) {
 		return false
 	}
+	if !maps.Equal(r.reportMap.UDPRoutes, in.reportMap.UDPRoutes) {
+		return false
+	}
 	if !maps.Equal(r.reportMap.Policies, in.reportMap.Policies) {
 		return false
 	}
@@ -253,6 +256,8 @@ func (s *ProxySyncer) Init(ctx context.Context, krtopts krtutil.KrtOptions) {
 		s.mostXdsSnapshots,
 		epPerClient,
 		clustersPerClient,
+		s.commonCols.Secrets,
+		newGcpIDTokens(ctx),
 	)
 
 	s.backendPolicyReport = krt.NewSingleton(func(kctx krt.HandlerContext) *report {
@@ -328,6 +333,18 @@ func mergeProxyReports(
 			maps.Copy(merged.TLSRoutes[rnn].Parents, rr.Parents)
 		}
 
+		for rnn, rr := range p.reports.UDPRoutes {
+			// if we haven't encountered this route, just copy it over completely
+			old := merged.UDPRoutes[rnn]
+			if old == nil {
+				merged.UDPRoutes[rnn] = rr
+				continue
+			}
+			// else, this route has already been seen for a proxy, merge this proxy's parents
+			// into the merged report
+			maps.Copy(merged.UDPRoutes[rnn].Parents, rr.Parents)
+		}
+
 		for rnn, rr := range p.reports.GRPCRoutes {
 			// if we haven't encountered this route, just copy it over completely
 			old := merged.GRPCRoutes[rnn]
@@ -65,6 +65,7 @@ func GenerateBackendPolicyReport(in []*ir.BackendObjectIR) reports.ReportMap {
 					Reason:  string(v1alpha1.PolicyReasonValid),
 					Message: reportssdk.PolicyAcceptedMsg,
 				})
+				r.AddFieldStatuses(polAtt.FieldStatuses...)
 				r.SetCondition(reportssdk.PolicyCondition{
 					Type:    string(v1alpha1.PolicyConditionAttached),
 					Status:  metav1.ConditionTrue,
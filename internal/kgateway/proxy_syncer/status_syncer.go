@@ -168,6 +168,10 @@ func (s *StatusSyncer) syncRouteStatus(ctx context.Context, logger *slog.Logger,
 					for _, parentRef := range r.Spec.ParentRefs {
 						gatewayNames = append(gatewayNames, string(parentRef.Name))
 					}
+				case *gwv1a2.UDPRoute:
+					for _, parentRef := range r.Spec.ParentRefs {
+						gatewayNames = append(gatewayNames, string(parentRef.Name))
+					}
 				case *gwv1.GRPCRoute:
 					for _, parentRef := range r.Spec.ParentRefs {
 						gatewayNames = append(gatewayNames, string(parentRef.Name))
@@ -276,6 +280,12 @@ func (s *StatusSyncer) syncRouteStatus(ctx context.Context, logger *slog.Logger,
 				return nil, nil
 			}
 			r.Status.RouteStatus = *status
+		case *gwv1a2.UDPRoute:
+			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
+				return nil, nil
+			}
+			r.Status.RouteStatus = *status
 		case *gwv1.GRPCRoute:
 			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
 			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
@@ -330,6 +340,18 @@ func (s *StatusSyncer) syncRouteStatus(ctx context.Context, logger *slog.Logger,
 		}
 	}
 
+	// Sync UDPRoute statuses
+	for rnn := range rm.UDPRoutes {
+		err := syncStatusWithRetry(wellknown.UDPRouteKind, rnn,
+			func() client.Object { return new(gwv1a2.UDPRoute) },
+			func(route client.Object) (*gwv1.RouteStatus, error) {
+				return buildAndUpdateStatus(route, wellknown.UDPRouteKind)
+			})
+		if err != nil {
+			logger.Error("all attempts failed at updating UDPRoute status", "error", err, "route", rnn)
+		}
+	}
+
 	// Sync GRPCRoute statuses
 	for rnn := range rm.GRPCRoutes {
 		err := syncStatusWithRetry(wellknown.GRPCRouteKind, rnn,
@@ -126,6 +126,7 @@ func (r *gatewayQueries) GetRouteChain(
 	case *ir.TcpRouteIR:
 		// TODO (danehans): Should TCPRoute delegation support be added in the future?
 	case *ir.TlsRouteIR:
+	case *ir.UdpRouteIR:
 	default:
 		return nil
 	}
@@ -153,10 +154,14 @@ func (r *gatewayQueries) allowedRoutes(resource client.Object, l *gwv1.Listener)
 		}
 	case gwv1.TLSProtocolType:
 		allowedKinds = []metav1.GroupKind{{Kind: wellknown.TLSRouteKind, Group: gwv1.GroupName}}
+		// TLS listeners terminating TLS can forward the decrypted stream of TCPRoutes
+		if l.TLS != nil && l.TLS.Mode != nil && *l.TLS.Mode == gwv1.TLSModeTerminate {
+			allowedKinds = append(allowedKinds, metav1.GroupKind{Kind: wellknown.TCPRouteKind, Group: gwv1a2.GroupName})
+		}
 	case gwv1.TCPProtocolType:
 		allowedKinds = []metav1.GroupKind{{Kind: wellknown.TCPRouteKind, Group: gwv1a2.GroupName}}
 	case gwv1.UDPProtocolType:
-		allowedKinds = []metav1.GroupKind{{}}
+		allowedKinds = []metav1.GroupKind{{Kind: wellknown.UDPRouteKind, Group: gwv1a2.GroupName}}
 	default:
 		// allow custom protocols to work
 		allowedKinds = []metav1.GroupKind{{Kind: wellknown.HTTPRouteKind, Group: gwv1.GroupName}}
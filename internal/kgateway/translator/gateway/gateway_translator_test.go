@@ -7,10 +7,19 @@ import (
 	"strings"
 	"testing"
 
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	mcsv1a1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
 
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/serviceimport"
+	pluginsdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/fsutils"
+	"github.com/kgateway-dev/kgateway/v2/test/testutils"
 	translatortest "github.com/kgateway-dev/kgateway/v2/test/translator"
 )
 
@@ -354,6 +363,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("TrafficPolicy with waf", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/waf.yaml",
+			outputFile: "traffic-policy/waf.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy with header modifiers attached to gateway", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "traffic-policy/header-modifiers-gateway.yaml",
@@ -431,6 +451,83 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("tcp gateway with multiple routes and rules", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "tcp-routing/multi-route.yaml",
+			outputFile: "tcp-routing/multi-route-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("tcp gateway with backend tls", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "tcp-routing/backend-tls.yaml",
+			outputFile: "tcp-routing/backend-tls-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("tcp gateway with tcp policies", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "tcp-routing/tcp-policy.yaml",
+			outputFile: "tcp-routing/tcp-policy-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("udp gateway with basic routing", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "udp-routing/basic.yaml",
+			outputFile: "udp-routing/basic-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("udproute with missing backend reports correctly", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "udp-routing/missing-backend.yaml",
+			outputFile: "udp-routing/missing-backend.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("udp gateway with multiple backend services", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "udp-routing/multi-backend.yaml",
+			outputFile: "udp-routing/multi-backend-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-udp-gateway",
+			},
+		})
+	})
+
+	t.Run("udp and tcp listeners sharing a port", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "udp-routing/shared-port.yaml",
+			outputFile: "udp-routing/shared-port-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("tls gateway with basic routing", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "tls-routing/basic.yaml",
@@ -475,6 +572,28 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("tls gateway with multiple routes", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "tls-routing/multi-route.yaml",
+			outputFile: "tls-routing/multi-route-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("tls gateway terminating TLS for tcp and tls routes", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "tls-routing/terminate.yaml",
+			outputFile: "tls-routing/terminate-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("grpc gateway with basic routing", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "grpc-routing/basic.yaml",
@@ -541,6 +660,28 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("AWS Lambda backend with workload identity", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backends/aws_lambda_workload_identity.yaml",
+			outputFile: "backends/aws_lambda_workload_identity.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("GCP and Azure serverless backends", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backends/serverless.yaml",
+			outputFile: "backends/serverless.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("DFP Backend with TLS", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "dfp/tls.yaml",
@@ -684,6 +825,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("http gateway with XBackendTrafficPolicy", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "session-persistence/backend-traffic-policy.yaml",
+			outputFile: "session-persistence/backend-traffic-policy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("HTTPListenerPolicy with upgrades", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "https-listener-pol/upgrades.yaml",
@@ -706,6 +858,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("HTTPListenerPolicy with http3", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "httplistenerpolicy/http3.yaml",
+			outputFile: "httplistenerpolicy/http3.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("HTTPListenerPolicy with idleTimeout", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "httplistenerpolicy/idle-timeout.yaml",
@@ -992,6 +1155,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("Backend Config Policy with SDS TLS", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backendconfigpolicy/tls-sds.yaml",
+			outputFile: "backendconfigpolicy/tls-sds.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy with explicit generation", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "traffic-policy/generation.yaml",
@@ -1714,3 +1888,49 @@ func TestDiscoveryNamespaceSelector(t *testing.T) {
 ]`, "base.yaml", "base_select_infra.yaml", "condition error for httproute: infra/example-route")
 	})
 }
+
+func TestServiceImport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := fsutils.MustGetThisDir()
+
+	inputFile := filepath.Join(dir, "testutils/inputs/serviceimport/http-route.yaml")
+	extraSchemes := runtime.SchemeBuilder{mcsv1a1.AddToScheme}
+	// the ServiceImports are not served by the fake client, the plugin is built from the input file instead
+	extraPluginsFn := func(ctx context.Context, commoncol *collections.CommonCollections, mergeSettingsJSON string) []pluginsdk.Plugin {
+		objs, err := testutils.LoadFromFiles(inputFile, translatortest.NewScheme(extraSchemes), nil)
+		if err != nil {
+			t.Fatalf("failed to load the ServiceImports: %v", err)
+		}
+		var serviceImports []*mcsv1a1.ServiceImport
+		for _, obj := range objs {
+			if si, ok := obj.(*mcsv1a1.ServiceImport); ok {
+				serviceImports = append(serviceImports, si)
+			}
+		}
+		endpointSlices := krt.WrapClient(kclient.New[*discoveryv1.EndpointSlice](commoncol.Client), commoncol.KrtOpts.ToOptions("ServiceImportEndpointSlices")...)
+		return []pluginsdk.Plugin{serviceimport.NewPluginFromCollections(
+			ctx,
+			commoncol.KrtOpts,
+			commoncol.LocalityPods,
+			krt.NewStaticCollection(nil, serviceImports),
+			endpointSlices,
+			commoncol.Settings,
+		)}
+	}
+
+	translatortest.TestTranslationWithExtraPlugins(
+		t,
+		ctx,
+		[]string{inputFile},
+		filepath.Join(dir, "testutils/outputs/serviceimport/http-route.yaml"),
+		types.NamespacedName{
+			Namespace: "default",
+			Name:      "example-gateway",
+		},
+		extraPluginsFn,
+		extraSchemes,
+		[]string{mcsv1a1.GroupName},
+		"",
+	)
+}
@@ -2,6 +2,7 @@ package irtranslator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 
@@ -10,6 +11,7 @@ import (
 	routerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	quicv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -19,9 +21,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
-	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 	sdkreporter "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 )
 
@@ -35,11 +37,18 @@ type filterChainTranslator struct {
 	gateway         ir.GatewayIR
 	routeConfigName string
 	reporter        sdkreporter.Reporter
+	// quic is set when translating the filter chains of a QUIC listener serving HTTP/3
+	quic bool
 
 	pluginPass TranslationPassPlugins
 }
 
-func computeListenerAddress(bindAddress string, port uint32, reporter sdkreporter.GatewayReporter) *envoycorev3.Address {
+func computeListenerAddress(
+	bindAddress string,
+	port uint32,
+	protocol envoycorev3.SocketAddress_Protocol,
+	reporter sdkreporter.GatewayReporter,
+) *envoycorev3.Address {
 	_, isIpv4Address, err := utils.IsIpv4Address(bindAddress)
 	if err != nil {
 		// TODO: return error ????
@@ -54,7 +63,7 @@ func computeListenerAddress(bindAddress string, port uint32, reporter sdkreporte
 	return &envoycorev3.Address{
 		Address: &envoycorev3.Address_SocketAddress{
 			SocketAddress: &envoycorev3.SocketAddress{
-				Protocol: envoycorev3.SocketAddress_TCP,
+				Protocol: protocol,
 				Address:  bindAddress,
 				PortSpecifier: &envoycorev3.SocketAddress_PortValue{
 					PortValue: port,
@@ -85,10 +94,15 @@ func (h *filterChainTranslator) initFilterChain(fcc ir.FilterChainCommon) *envoy
 		TLS:   fcc.TLS,
 	}
 
+	transportSocket := info.toTransportSocket()
+	if h.quic {
+		transportSocket = info.toQuicTransportSocket()
+	}
+
 	fc := &envoylistenerv3.FilterChain{
 		Name:             fcc.FilterChainName,
 		FilterChainMatch: info.toMatch(),
-		TransportSocket:  info.toTransportSocket(),
+		TransportSocket:  transportSocket,
 	}
 
 	return fc
@@ -117,8 +131,13 @@ func (n *filterChainTranslator) computeNetworkFiltersForHttp(ctx context.Context
 		reporter:          n.reporter,
 		gateway:           n.gateway, // corresponds to Gateway API listener
 		policyAncestorRef: n.listener.PolicyAncestorRef,
+		http3:             n.quic,
+	}
+	var networkFilters []*envoylistenerv3.Filter
+	// QUIC listeners only support the HCM as network filter
+	if !n.quic {
+		networkFilters = sortNetworkFilters(n.computeCustomFilters(ctx, l.CustomNetworkFilters, listenerReporter))
 	}
-	networkFilters := sortNetworkFilters(n.computeCustomFilters(ctx, l.CustomNetworkFilters, listenerReporter))
 	networkFilter, err := hcm.computeNetworkFilters(ctx, l)
 	if err != nil {
 		return nil, err
@@ -129,7 +148,7 @@ func (n *filterChainTranslator) computeNetworkFiltersForHttp(ctx context.Context
 
 // computeCustomFilters computes all custom filters, first from plugins, second
 // from embedded filters on the FilterChain itself.
-// For HTTP FilterChains these must be added before HCM.
+// For HTTP FilterChains these must be added before HCM, and for TCP FilterChains before the tcp_proxy.
 func (n *filterChainTranslator) computeCustomFilters(
 	ctx context.Context,
 	customNetworkFilters []ir.CustomEnvoyFilter,
@@ -193,6 +212,7 @@ type hcmNetworkFilterTranslator struct {
 	listener          ir.HttpFilterChainIR // policies attached to listener
 	gateway           ir.GatewayIR         // policies attached to gateway
 	policyAncestorRef gwv1.ParentReference
+	http3             bool
 }
 
 func (h *hcmNetworkFilterTranslator) computeNetworkFilters(ctx context.Context, l ir.HttpFilterChainIR) (*envoylistenerv3.Filter, error) {
@@ -251,7 +271,7 @@ func (h *hcmNetworkFilterTranslator) initializeHCM() *envoyhttp.HttpConnectionMa
 		statPrefix = DefaultHttpStatPrefix
 	}
 
-	return &envoyhttp.HttpConnectionManager{
+	hcm := &envoyhttp.HttpConnectionManager{
 		CodecType:        envoyhttp.HttpConnectionManager_AUTO,
 		StatPrefix:       statPrefix,
 		NormalizePath:    wrapperspb.Bool(true),
@@ -269,6 +289,11 @@ func (h *hcmNetworkFilterTranslator) initializeHCM() *envoyhttp.HttpConnectionMa
 			},
 		},
 	}
+	if h.http3 {
+		hcm.CodecType = envoyhttp.HttpConnectionManager_HTTP3
+		hcm.Http3ProtocolOptions = &envoycorev3.Http3ProtocolOptions{}
+	}
+	return hcm
 }
 
 func (h *hcmNetworkFilterTranslator) computeHttpFilters(ctx context.Context, l ir.HttpFilterChainIR) []*envoyhttp.HttpFilter {
@@ -369,8 +394,6 @@ func sortHttpFilters(filters plugins.StagedHttpFilterList) []*envoyhttp.HttpFilt
 }
 
 func (h *filterChainTranslator) computeTcpFilters(ctx context.Context, l ir.TcpIR, reporter sdkreporter.ListenerReporter) []*envoylistenerv3.Filter {
-	networkFilters := sortNetworkFilters(h.computeCustomFilters(ctx, l.CustomNetworkFilters, reporter))
-
 	cfg := &envoytcp.TcpProxy{
 		StatPrefix: l.FilterChainName,
 	}
@@ -395,11 +418,64 @@ func (h *filterChainTranslator) computeTcpFilters(ctx context.Context, l ir.TcpI
 		}
 	}
 
+	routeNetworkFilters, err := h.runTcpRoutePlugins(l, cfg)
+	if err != nil {
+		reporter.SetCondition(sdkreporter.ListenerCondition{
+			Type:    gwv1.ListenerConditionProgrammed,
+			Reason:  gwv1.ListenerReasonInvalid,
+			Status:  metav1.ConditionFalse,
+			Message: "Error processing TCP route policies: " + err.Error(),
+		})
+	}
+
+	networkFilters := sortNetworkFilters(append(h.computeCustomFilters(ctx, l.CustomNetworkFilters, reporter), routeNetworkFilters...))
+
 	tcpFilter, _ := NewFilterWithTypedConfig(wellknown.TCPProxy, cfg)
 
 	return append(networkFilters, tcpFilter)
 }
 
+func (h *filterChainTranslator) runTcpRoutePlugins(l ir.TcpIR, out *envoytcp.TcpProxy) ([]plugins.StagedNetworkFilter, error) {
+	var networkFilters []plugins.StagedNetworkFilter
+	var errs []error
+	for _, gk := range l.AttachedPolicies.ApplyOrderedGroupKinds() {
+		pols := l.AttachedPolicies.Policies[gk]
+		pass := h.pluginPass[gk]
+		var tcpPass ir.TcpRouteTranslationPass
+		if pass != nil {
+			tcpPass, _ = pass.ProxyTranslationPass.(ir.TcpRouteTranslationPass)
+		}
+		if tcpPass == nil {
+			reportPolicyNotSupported(h.reporter, h.listener.PolicyAncestorRef,
+				fmt.Sprintf("%s is not supported on TCPRoute and TLSRoute", gk.Kind), pols...)
+			continue
+		}
+		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, pols...)
+		policies, mergeOrigins := mergePolicies(pass, pols)
+		for _, pol := range policies {
+			// skip plugin application if we encountered any errors while constructing
+			// the policy IR.
+			if len(pol.Errors) > 0 {
+				errs = append(errs, pol.Errors...)
+				continue
+			}
+			pctx := &ir.TcpRouteContext{
+				FilterChainName: l.FilterChainName,
+				Policy:          pol.PolicyIr,
+				GatewayContext:  ir.GatewayContext{GatewayClassName: h.gateway.GatewayClassName()},
+			}
+			filters, err := tcpPass.ApplyForTcpRoute(pctx, out)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			networkFilters = append(networkFilters, filters...)
+		}
+		reportPolicyAttachmentStatus(h.reporter, h.listener.PolicyAncestorRef, mergeOrigins, pols...)
+	}
+	return networkFilters, errors.Join(errs...)
+}
+
 func NewFilterWithTypedConfig(name string, config proto.Message) (*envoylistenerv3.Filter, error) {
 	s := &envoylistenerv3.Filter{
 		Name: name,
@@ -453,6 +529,37 @@ func (info *FilterChainInfo) toMatch() *envoylistenerv3.FilterChainMatch {
 }
 
 func (info *FilterChainInfo) toTransportSocket() *envoycorev3.TransportSocket {
+	out := info.toDownstreamTlsContext()
+	if out == nil {
+		return nil
+	}
+	typedConfig, _ := utils.MessageToAny(out)
+
+	return &envoycorev3.TransportSocket{
+		Name:       wellknown.TransportSocketTls,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}
+}
+
+// toQuicTransportSocket returns the transport socket of a QUIC filter chain, which terminates TLS
+// with the same configuration as its HTTPS counterpart, but negotiates HTTP/3.
+func (info *FilterChainInfo) toQuicTransportSocket() *envoycorev3.TransportSocket {
+	tlsContext := info.toDownstreamTlsContext()
+	if tlsContext == nil {
+		return nil
+	}
+	tlsContext.GetCommonTlsContext().AlpnProtocols = []string{"h3"}
+	typedConfig, _ := utils.MessageToAny(&quicv3.QuicDownstreamTransport{
+		DownstreamTlsContext: tlsContext,
+	})
+
+	return &envoycorev3.TransportSocket{
+		Name:       wellknown.TransportSocketQuic,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}
+}
+
+func (info *FilterChainInfo) toDownstreamTlsContext() *envoytlsv3.DownstreamTlsContext {
 	if info == nil {
 		return nil
 	}
@@ -486,15 +593,9 @@ func (info *FilterChainInfo) toTransportSocket() *envoycorev3.TransportSocket {
 	//		common.AlpnProtocols = []string{}
 	//	}
 
-	out := &envoytlsv3.DownstreamTlsContext{
+	return &envoytlsv3.DownstreamTlsContext{
 		CommonTlsContext: common,
 	}
-	typedConfig, _ := utils.MessageToAny(out)
-
-	return &envoycorev3.TransportSocket{
-		Name:       wellknown.TransportSocketTls,
-		ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
-	}
 }
 
 func bytesDataSource(s []byte) *envoycorev3.DataSource {
@@ -1,10 +1,13 @@
 package irtranslator
 
 import (
+	"fmt"
 	"sort"
 	"strconv"
+	"time"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"golang.org/x/net/context"
@@ -17,6 +20,7 @@ import (
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/query"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/listener"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
@@ -47,14 +51,18 @@ func (t *Translator) Translate(ctx context.Context, gw ir.GatewayIR, reporter sd
 
 	for _, l := range gw.Listeners {
 		outListener, routes := t.ComputeListener(ctx, pass, gw, l, reporter)
-		// Envoy rejects listeners with no filter chains; skip adding such listeners.
-		if outListener == nil || len(outListener.GetFilterChains()) == 0 {
+		// Envoy rejects TCP listeners with no filter chains; skip adding such listeners.
+		if outListener == nil || (len(outListener.GetFilterChains()) == 0 && outListener.GetUdpListenerConfig() == nil) {
 			originalListenerName := findOriginalListenerName(gw, l)
 			logger.Warn("invalid listener due to no filter chains generated", "listener", originalListenerName)
 			continue
 		}
 		res.Listeners = append(res.Listeners, outListener)
 		res.Routes = append(res.Routes, routes...)
+
+		if quicListener := t.computeQuicListener(ctx, pass, gw, l, reporter); quicListener != nil {
+			res.Listeners = append(res.Listeners, quicListener)
+		}
 	}
 
 	for _, c := range pass {
@@ -97,9 +105,12 @@ func (t *Translator) ComputeListener(
 	reporter sdkreporter.Reporter,
 ) (*envoylistenerv3.Listener, []*envoyroutev3.RouteConfiguration) {
 	gwreporter := reporter.Gateway(gw.SourceObject.Obj)
+	if lis.UdpProxy != nil {
+		return t.computeUdpListener(gw, lis, reporter), nil
+	}
 	ret := &envoylistenerv3.Listener{
 		Name:    lis.Name,
-		Address: computeListenerAddress(lis.BindAddress, lis.BindPort, gwreporter),
+		Address: computeListenerAddress(lis.BindAddress, lis.BindPort, envoycorev3.SocketAddress_TCP, gwreporter),
 	}
 	if gw.PerConnectionBufferLimitBytes != nil {
 		ret.PerConnectionBufferLimitBytes = &wrapperspb.UInt32Value{Value: *gw.PerConnectionBufferLimitBytes}
@@ -135,6 +146,9 @@ func (t *Translator) ComputeListener(
 		}
 		rc := hr.ComputeRouteConfiguration(ctx, hfc.Vhosts)
 		if rc != nil {
+			if hfc.Http3 != nil {
+				rc.ResponseHeadersToAdd = append(rc.GetResponseHeadersToAdd(), altSvcHeader(lis.BindPort, hfc.Http3.AltSvcMaxAge))
+			}
 			routes = append(routes, rc)
 
 			// Record metrics for the number of domains per listener.
@@ -169,6 +183,7 @@ func (t *Translator) ComputeListener(
 	fct := filterChainTranslator{
 		listener:   lis,
 		gateway:    gw,
+		reporter:   reporter,
 		pluginPass: pass,
 	}
 
@@ -192,6 +207,89 @@ func (t *Translator) ComputeListener(
 	return ret, routes
 }
 
+// computeUdpListener computes a UDP listener proxying the datagrams with a udp_proxy listener filter.
+// UDP listeners have no filter chains, and the listener policies are not applied as they configure TCP listeners.
+func (t *Translator) computeUdpListener(
+	gw ir.GatewayIR,
+	lis ir.ListenerIR,
+	reporter sdkreporter.Reporter,
+) *envoylistenerv3.Listener {
+	gwreporter := reporter.Gateway(gw.SourceObject.Obj)
+	udpFilter, err := computeUdpListenerFilter(lis.UdpProxy)
+	if err != nil {
+		logger.Error("error computing udp proxy", "listener", lis.Name, "error", err)
+		return nil
+	}
+	return &envoylistenerv3.Listener{
+		Name:              lis.Name,
+		Address:           computeListenerAddress(lis.BindAddress, lis.BindPort, envoycorev3.SocketAddress_UDP, gwreporter),
+		UdpListenerConfig: &envoylistenerv3.UdpListenerConfig{},
+		ListenerFilters:   []*envoylistenerv3.ListenerFilter{udpFilter},
+	}
+}
+
+// computeQuicListener computes the companion QUIC listener serving HTTP/3 for the HTTPS filter chains of the
+// listener that enable it. It returns nil if none of them do.
+// The QUIC filter chains share the TLS configuration and the route configurations of their HTTPS counterparts.
+// The listener policies are not applied as they configure TCP listeners.
+func (t *Translator) computeQuicListener(
+	ctx context.Context,
+	pass TranslationPassPlugins,
+	gw ir.GatewayIR,
+	lis ir.ListenerIR,
+	reporter sdkreporter.Reporter,
+) *envoylistenerv3.Listener {
+	var filterChains []*envoylistenerv3.FilterChain
+	for _, hfc := range lis.HttpFilterChain {
+		if hfc.Http3 == nil || hfc.TLS == nil {
+			continue
+		}
+		fct := filterChainTranslator{
+			listener:        lis,
+			gateway:         gw,
+			routeConfigName: hfc.FilterChainName,
+			reporter:        reporter,
+			quic:            true,
+			pluginPass:      pass,
+		}
+		rl := getReporterForFilterChain(gw, reporter, hfc.FilterChainName)
+		fc := fct.initFilterChain(hfc.FilterChainCommon)
+		fc.Filters = fct.computeHttpFilters(ctx, hfc, rl)
+		filterChains = append(filterChains, fc)
+	}
+	if len(filterChains) == 0 {
+		return nil
+	}
+	// sort filter chains for idempotency
+	sort.Slice(filterChains, func(i, j int) bool {
+		return filterChains[i].GetName() < filterChains[j].GetName()
+	})
+
+	gwreporter := reporter.Gateway(gw.SourceObject.Obj)
+	return &envoylistenerv3.Listener{
+		Name:    listener.GenerateQuicListenerName(lis.BindPort),
+		Address: computeListenerAddress(lis.BindAddress, lis.BindPort, envoycorev3.SocketAddress_UDP, gwreporter),
+		UdpListenerConfig: &envoylistenerv3.UdpListenerConfig{
+			QuicOptions: &envoylistenerv3.QuicProtocolOptions{},
+			DownstreamSocketConfig: &envoycorev3.UdpSocketConfig{
+				PreferGro: wrapperspb.Bool(true),
+			},
+		},
+		FilterChains: filterChains,
+	}
+}
+
+// altSvcHeader advertises that HTTP/3 is served on the given port.
+func altSvcHeader(port uint32, maxAge time.Duration) *envoycorev3.HeaderValueOption {
+	return &envoycorev3.HeaderValueOption{
+		Header: &envoycorev3.HeaderValue{
+			Key:   "alt-svc",
+			Value: fmt.Sprintf(`h3=":%d"; ma=%d`, port, int64(maxAge.Seconds())),
+		},
+		AppendAction: envoycorev3.HeaderValueOption_ADD_IF_ABSENT,
+	}
+}
+
 func (t *Translator) runListenerPlugins(
 	ctx context.Context,
 	pass TranslationPassPlugins,
@@ -238,9 +336,10 @@ func (t *Translator) newPass(reporter sdkreporter.Reporter) TranslationPassPlugi
 		tp := v.NewGatewayTranslationPass(ir.GwTranslationCtx{}, reporter)
 		if tp != nil {
 			ret[k] = &TranslationPass{
-				ProxyTranslationPass: tp,
-				Name:                 v.Name,
-				MergePolicies:        v.MergePolicies,
+				ProxyTranslationPass:         tp,
+				Name:                         v.Name,
+				MergePolicies:                v.MergePolicies,
+				ApplyBackendPoliciesToRoutes: v.ApplyBackendPoliciesToRoutes,
 			}
 		}
 	}
@@ -254,4 +353,6 @@ type TranslationPass struct {
 	// such that policies ordered from high to low priority, both hierarchically
 	// and within the same hierarchy, are Merged into a single Policy
 	MergePolicies func(policies []ir.PolicyAtt) ir.PolicyAtt
+	// If set, the policies attached to backend objects are also applied to the routes referencing them
+	ApplyBackendPoliciesToRoutes bool
 }
@@ -1,12 +1,16 @@
 package irtranslator
 
 import (
+	"slices"
+
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"google.golang.org/protobuf/types/known/structpb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
@@ -53,6 +57,81 @@ func reportPolicyAcceptanceStatus(
 			Message:            reporter.PolicyAcceptedMsg,
 			ObservedGeneration: policy.Generation,
 		})
+		r.AddFieldStatuses(policy.FieldStatuses...)
+	}
+}
+
+// reportPolicyNotSupported reports the policies as not accepted because their kind cannot be
+// applied to the resource they are attached to.
+func reportPolicyNotSupported(
+	rp reporter.Reporter,
+	ancestorRef gwv1.ParentReference,
+	message string,
+	policies ...ir.PolicyAtt,
+) {
+	for _, policy := range policies {
+		if policy.PolicyRef == nil {
+			// Not a policy associated with a CR, can't report status on it
+			continue
+		}
+
+		key := reporter.PolicyKey{
+			Group:     policy.PolicyRef.Group,
+			Kind:      policy.PolicyRef.Kind,
+			Namespace: policy.PolicyRef.Namespace,
+			Name:      policy.PolicyRef.Name,
+		}
+		rp.Policy(key, policy.Generation).AncestorRef(ancestorRef).SetCondition(reporter.PolicyCondition{
+			Type:               string(v1alpha1.PolicyConditionAccepted),
+			Status:             metav1.ConditionFalse,
+			Reason:             string(v1alpha1.PolicyReasonInvalid),
+			Message:            message,
+			ObservedGeneration: policy.Generation,
+		})
+	}
+}
+
+// reportIgnoredPolicyFields reports the fields ignored by a translation pass when applying the given policy.
+// When the policy results from merging the attached policies, each field is reported on the policies the
+// field originates from.
+func reportIgnoredPolicyFields(
+	rp reporter.Reporter,
+	ancestorRef gwv1.ParentReference,
+	mergeOrigins ir.MergeOrigins,
+	fields []reporter.PolicyFieldStatus,
+	applied ir.PolicyAtt,
+	policies ...ir.PolicyAtt,
+) {
+	if len(fields) == 0 {
+		return
+	}
+	if !mergeOrigins.IsSet() {
+		policies = []ir.PolicyAtt{applied}
+	}
+
+	for _, policy := range policies {
+		if policy.PolicyRef == nil {
+			// Not a policy associated with a CR, can't report status on it
+			continue
+		}
+
+		var policyFields []reporter.PolicyFieldStatus
+		for _, f := range fields {
+			if !mergeOrigins.IsSet() || slices.Contains(mergeOrigins.Get(f.Field), policy.PolicyRef.ID()) {
+				policyFields = append(policyFields, f)
+			}
+		}
+		if len(policyFields) == 0 {
+			continue
+		}
+
+		key := reporter.PolicyKey{
+			Group:     policy.PolicyRef.Group,
+			Kind:      policy.PolicyRef.Kind,
+			Namespace: policy.PolicyRef.Namespace,
+			Name:      policy.PolicyRef.Name,
+		}
+		rp.Policy(key, policy.Generation).AncestorRef(ancestorRef).AddFieldStatuses(policyFields...)
 	}
 }
 
@@ -62,6 +141,13 @@ func reportPolicyAttachmentStatus(
 	mergeOrigins ir.MergeOrigins,
 	policies ...ir.PolicyAtt,
 ) {
+	globalOverrideRefs := sets.New[string]()
+	for _, policy := range policies {
+		if policy.PolicyRef != nil && policy.GlobalPolicyTier == apiannotations.GlobalPolicyTierOverride {
+			globalOverrideRefs.Insert(policy.PolicyRef.ID())
+		}
+	}
+
 	for _, policy := range policies {
 		if policy.PolicyRef == nil {
 			// Not a policy associated with a CR, can't report status on it
@@ -76,6 +162,19 @@ func reportPolicyAttachmentStatus(
 		}
 		r := rp.Policy(key, policy.Generation).AncestorRef(ancestorRef)
 
+		switch policy.GlobalPolicyTier {
+		case apiannotations.GlobalPolicyTierOverride:
+			r.SetAttachmentState(reporter.PolicyAttachmentStateGlobalOverride)
+		case apiannotations.GlobalPolicyTierDefault:
+			r.SetAttachmentState(reporter.PolicyAttachmentStateGlobalDefault)
+		default:
+			if globalOverrideRefs.Len() > 0 &&
+				mergeOrigins.GetRefCount(policy.PolicyRef) != ir.MergeOriginsRefCountAll &&
+				mergedFromAnyRef(mergeOrigins, globalOverrideRefs) {
+				r.SetAttachmentState(reporter.PolicyAttachmentStateOverriddenByGlobal)
+			}
+		}
+
 		if !mergeOrigins.IsSet() {
 			// Not a merged policy so this should be a direct attachment
 			r.SetAttachmentState(reporter.PolicyAttachmentStateAttached)
@@ -95,6 +194,16 @@ func reportPolicyAttachmentStatus(
 	}
 }
 
+// mergedFromAnyRef returns true if any field in mergeOrigins originates from one of the given policy refs
+func mergedFromAnyRef(mergeOrigins ir.MergeOrigins, refs sets.Set[string]) bool {
+	for _, fieldRefs := range mergeOrigins {
+		if fieldRefs.HasAny(refs.UnsortedList()...) {
+			return true
+		}
+	}
+	return false
+}
+
 func addMergeOriginsToFilterMetadata(
 	gk schema.GroupKind,
 	mergeOrigins ir.MergeOrigins,
@@ -0,0 +1,109 @@
+package irtranslator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+func TestReportPolicyAttachmentStatusGlobalOverride(t *testing.T) {
+	globalRef := &ir.AttachedPolicyRef{Group: "gateway.kgateway.dev", Kind: "TrafficPolicy", Namespace: "kgateway-system", Name: "global"}
+	localRef := &ir.AttachedPolicyRef{Group: "gateway.kgateway.dev", Kind: "TrafficPolicy", Namespace: "default", Name: "local"}
+	policies := []ir.PolicyAtt{
+		{PolicyRef: globalRef, Generation: 1, GlobalPolicyTier: apiannotations.GlobalPolicyTierOverride},
+		{PolicyRef: localRef, Generation: 1},
+	}
+	ancestorRef := gwv1.ParentReference{
+		Group:     ptr.To(gwv1.Group("gateway.networking.k8s.io")),
+		Kind:      ptr.To(gwv1.Kind("HTTPRoute")),
+		Namespace: ptr.To(gwv1.Namespace("default")),
+		Name:      "route",
+	}
+
+	tests := []struct {
+		name         string
+		mergeOrigins ir.MergeOrigins
+		wantGlobal   metav1.Condition
+		wantLocal    metav1.Condition
+	}{
+		{
+			name: "local policy partially overridden by the global policy",
+			mergeOrigins: ir.MergeOrigins{
+				"timeouts": sets.New(globalRef.ID()),
+				"cors":     sets.New(localRef.ID()),
+			},
+			wantGlobal: metav1.Condition{
+				Status:  metav1.ConditionTrue,
+				Reason:  string(v1alpha1.PolicyReasonMerged),
+				Message: reporter.PolicyMergedMsg + "; " + reporter.PolicyGlobalOverrideMsg,
+			},
+			wantLocal: metav1.Condition{
+				Status:  metav1.ConditionTrue,
+				Reason:  string(v1alpha1.PolicyReasonMerged),
+				Message: reporter.PolicyMergedMsg + "; " + reporter.PolicyOverriddenByGlobalMsg,
+			},
+		},
+		{
+			name: "local policy fully overridden by the global policy",
+			mergeOrigins: ir.MergeOrigins{
+				"timeouts": sets.New(globalRef.ID()),
+			},
+			wantGlobal: metav1.Condition{
+				Status:  metav1.ConditionTrue,
+				Reason:  string(v1alpha1.PolicyReasonAttached),
+				Message: reporter.PolicyAttachedMsg + "; " + reporter.PolicyGlobalOverrideMsg,
+			},
+			wantLocal: metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  string(v1alpha1.PolicyReasonOverridden),
+				Message: reporter.PolicyOverriddenMsg + "; " + reporter.PolicyOverriddenByGlobalMsg,
+			},
+		},
+		{
+			name: "no conflict with the global policy",
+			mergeOrigins: ir.MergeOrigins{
+				"cors": sets.New(localRef.ID()),
+			},
+			wantGlobal: metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  string(v1alpha1.PolicyReasonOverridden),
+				Message: reporter.PolicyOverriddenMsg + "; " + reporter.PolicyGlobalOverrideMsg,
+			},
+			wantLocal: metav1.Condition{
+				Status:  metav1.ConditionTrue,
+				Reason:  string(v1alpha1.PolicyReasonAttached),
+				Message: reporter.PolicyAttachedMsg,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+			rm := reports.NewReportMap()
+			reportPolicyAttachmentStatus(reports.NewReporter(&rm), ancestorRef, tt.mergeOrigins, policies...)
+
+			for ref, want := range map[*ir.AttachedPolicyRef]metav1.Condition{globalRef: tt.wantGlobal, localRef: tt.wantLocal} {
+				key := reporter.PolicyKey{Group: ref.Group, Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name}
+				status := rm.BuildPolicyStatus(t.Context(), key, "example-controller", gwv1.PolicyStatus{})
+				a.Len(status.Ancestors, 1)
+				cond := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(v1alpha1.PolicyConditionAttached))
+				if a.NotNil(cond, ref.Name) {
+					a.Equal(want.Status, cond.Status, ref.Name)
+					a.Equal(want.Reason, cond.Reason, ref.Name)
+					a.Equal(want.Message, cond.Message, ref.Name)
+				}
+			}
+		})
+	}
+}
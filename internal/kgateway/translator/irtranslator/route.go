@@ -91,12 +91,14 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(
 				errs = append(errs, pol.Errors...)
 				continue
 			}
-			pass.ApplyRouteConfigPlugin(&ir.RouteConfigContext{
+			pctx := &ir.RouteConfigContext{
 				FilterChainName:   h.fc.FilterChainName,
 				TypedFilterConfig: typedPerFilterConfigRoute,
 				Policy:            pol.PolicyIr,
 				GatewayContext:    ir.GatewayContext{GatewayClassName: h.gw.GatewayClassName()},
-			}, cfg)
+			}
+			pass.ApplyRouteConfigPlugin(pctx, cfg)
+			reportIgnoredPolicyFields(h.reporter, h.listener.PolicyAncestorRef, mergeOrigins, pctx.IgnoredFields, pol, pols...)
 		}
 		cfg.Metadata = addMergeOriginsToFilterMetadata(gk, mergeOrigins, cfg.GetMetadata())
 		reportPolicyAttachmentStatus(h.reporter, h.listener.PolicyAncestorRef, mergeOrigins, pols...)
@@ -366,6 +368,7 @@ func (h *httpRouteConfigurationTranslator) runVhostPlugins(
 				GatewayContext:    ir.GatewayContext{GatewayClassName: h.gw.GatewayClassName()},
 			}
 			pass.ApplyVhostPlugin(pctx, out)
+			reportIgnoredPolicyFields(h.reporter, h.listener.PolicyAncestorRef, mergeOrigins, pctx.IgnoredFields, pol, pols...)
 		}
 		out.Metadata = addMergeOriginsToFilterMetadata(gk, mergeOrigins, out.GetMetadata())
 		reportPolicyAttachmentStatus(h.reporter, h.listener.PolicyAncestorRef, mergeOrigins, pols...)
@@ -434,10 +437,12 @@ func (h *httpRouteConfigurationTranslator) runRoutePlugins(
 			}
 
 			pctx.Policy = pol.PolicyIr
+			pctx.IgnoredFields = nil
 			err := pass.ApplyForRoute(pctx, out)
 			if err != nil {
 				errs = append(errs, err)
 			}
+			reportIgnoredPolicyFields(h.reporter, h.listener.PolicyAncestorRef, mergeOrigins, pctx.IgnoredFields, pol, pols...)
 		}
 		out.Metadata = addMergeOriginsToFilterMetadata(gk, mergeOrigins, out.GetMetadata())
 		reportPolicyAttachmentStatus(h.reporter, h.listener.PolicyAncestorRef, mergeOrigins, pols...)
@@ -466,18 +471,46 @@ func (h *httpRouteConfigurationTranslator) runBackendPolicies(ctx context.Contex
 			continue
 		}
 		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, pols...)
-		policies, _ := mergePolicies(pass, pols)
+		policies, mergeOrigins := mergePolicies(pass, pols)
 		for _, pol := range policies {
 			// Policy on extension ref
+			pCtx.IgnoredFields = nil
 			err := pass.ApplyForRouteBackend(pol.PolicyIr, pCtx)
 			if err != nil {
 				errs = append(errs, err)
 			}
+			reportIgnoredPolicyFields(h.reporter, h.listener.PolicyAncestorRef, mergeOrigins, pCtx.IgnoredFields, pol, pols...)
 		}
 	}
 	return errors.Join(errs...)
 }
 
+// runBackendObjectPolicies applies the policies attached to the backend object (e.g. a Service)
+// of the route backend, for the plugins that apply their backend policies to routes.
+// Policy errors are skipped here as they are reported on the backend policy status.
+func (h *httpRouteConfigurationTranslator) runBackendObjectPolicies(ctx context.Context, in ir.HttpBackend, pCtx *ir.RouteBackendContext) {
+	if in.Backend.BackendObject == nil {
+		return
+	}
+	attachedPolicies := in.Backend.BackendObject.AttachedPolicies
+	for _, gk := range attachedPolicies.ApplyOrderedGroupKinds() {
+		pass := h.pluginPass[gk]
+		if pass == nil || !pass.ApplyBackendPoliciesToRoutes {
+			continue
+		}
+		for _, pol := range attachedPolicies.Policies[gk] {
+			if len(pol.Errors) > 0 {
+				continue
+			}
+			if err := pass.ApplyForRouteBackend(pol.PolicyIr, pCtx); err != nil {
+				h.logger.Error("error processing backend object policy", "error", err)
+				pol.Errors = append(slices.Clone(pol.Errors), err)
+				reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, pol)
+			}
+		}
+	}
+}
+
 func (h *httpRouteConfigurationTranslator) runBackend(ctx context.Context, in ir.HttpBackend, pCtx *ir.RouteBackendContext, outRoute *envoyroutev3.Route) error {
 	var errs []error
 	if in.Backend.BackendObject != nil {
@@ -519,6 +552,7 @@ func (h *httpRouteConfigurationTranslator) translateRouteAction(
 			GatewayContext:    ir.GatewayContext{GatewayClassName: h.gw.GatewayClassName()},
 			FilterChainName:   h.fc.FilterChainName,
 			Backend:           backend.Backend.BackendObject,
+			In:                in,
 			TypedFilterConfig: backendConfigCtx.typedPerFilterConfigRoute,
 		}
 
@@ -542,6 +576,12 @@ func (h *httpRouteConfigurationTranslator) translateRouteAction(
 			// TODO: error on status
 			h.logger.Error("error processing backends with policies", "error", err)
 		}
+		// errors are reported on the status of the backend object policies
+		h.runBackendObjectPolicies(
+			ctx,
+			backend,
+			&pCtx,
+		)
 
 		backendConfigCtx.RequestHeadersToAdd = pCtx.RequestHeadersToAdd
 		backendConfigCtx.RequestHeadersToRemove = pCtx.RequestHeadersToRemove
@@ -554,6 +594,7 @@ func (h *httpRouteConfigurationTranslator) translateRouteAction(
 		cw.RequestHeadersToRemove = backendConfigCtx.RequestHeadersToRemove
 		cw.ResponseHeadersToAdd = backendConfigCtx.ResponseHeadersToAdd
 		cw.ResponseHeadersToRemove = backendConfigCtx.ResponseHeadersToRemove
+		cw.MetadataMatch = pCtx.MetadataMatch
 		clusters = append(clusters, cw)
 	}
 
@@ -577,6 +618,10 @@ func (h *httpRouteConfigurationTranslator) translateRouteAction(
 				Cluster: clusters[0].GetName(),
 			}
 		}
+		// Only set the metadata match if unspecified since a plugin may have set it.
+		if action.GetMetadataMatch() == nil {
+			action.MetadataMatch = clusters[0].GetMetadataMatch()
+		}
 		// Skip setting the typed per filter config here, set it in the envoyRoutes() after runRoutePlugins runs
 
 	default:
@@ -0,0 +1,156 @@
+package irtranslator
+
+import (
+	"time"
+
+	cncfcorev3 "github.com/cncf/xds/go/xds/core/v3"
+	cncfmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	envoynetworkinputs "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
+	envoyconsistenthashing "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/consistent_hashing/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+)
+
+const (
+	UdpProxyListenerFilterName = "envoy.filters.udp_listener.udp_proxy"
+
+	udpRouteActionName           = "route"
+	sourceIPInputName            = "envoy.matching.inputs.source_ip"
+	consistentHashingMatcherName = "envoy.matching.matchers.consistent_hashing"
+)
+
+// DefaultUdpIdleTimeout is the duration after which an idle UDP session is closed.
+const DefaultUdpIdleTimeout = 60 * time.Second
+
+// computeUdpListenerFilter returns the udp_proxy listener filter proxying the datagrams to the backends.
+//
+// udp_proxy routes a session to a single cluster, so the backends are weighted by matching a consistent
+// hash of the source IP of the session against the cumulative weights of the backends.
+// As a result, the sessions from a source IP stick to the same backend, and the source IP is also used
+// as the hash key to select the endpoint when the cluster uses a hash-based load balancer.
+func computeUdpListenerFilter(l *ir.UdpIR) (*envoylistenerv3.ListenerFilter, error) {
+	idleTimeout := DefaultUdpIdleTimeout
+	if l.IdleTimeout != nil {
+		idleTimeout = *l.IdleTimeout
+	}
+
+	matcher, err := udpRouteMatcher(l.BackendRefs)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &envoyudp.UdpProxyConfig{
+		StatPrefix: l.Name,
+		RouteSpecifier: &envoyudp.UdpProxyConfig_Matcher{
+			Matcher: matcher,
+		},
+		IdleTimeout: durationpb.New(idleTimeout),
+		HashPolicies: []*envoyudp.UdpProxyConfig_HashPolicy{{
+			PolicySpecifier: &envoyudp.UdpProxyConfig_HashPolicy_SourceIp{
+				SourceIp: true,
+			},
+		}},
+	}
+
+	typedConfig, err := utils.MessageToAny(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &envoylistenerv3.ListenerFilter{
+		Name: UdpProxyListenerFilterName,
+		ConfigType: &envoylistenerv3.ListenerFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}, nil
+}
+
+// udpRouteMatcher returns the matcher routing the sessions to the backends according to their weights.
+// The backend i receives the sessions whose source IP hash modulo the total weight is in
+// [w_0 + ... + w_(i-1), w_0 + ... + w_i), the first backend being the fallback when no other matches.
+func udpRouteMatcher(backends []ir.BackendRefIR) (*cncfmatcherv3.Matcher, error) {
+	first, err := udpRouteAction(backends[0].ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	matcher := &cncfmatcherv3.Matcher{
+		OnNoMatch: first,
+	}
+	if len(backends) == 1 {
+		return matcher, nil
+	}
+
+	var total uint32
+	thresholds := make([]uint32, len(backends))
+	for i, b := range backends {
+		thresholds[i] = total
+		total += b.Weight
+	}
+
+	sourceIPInput, err := typedExtensionConfig(sourceIPInputName, &envoynetworkinputs.SourceIPInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	// matchers are evaluated in order, so start with the backend with the highest threshold
+	var fieldMatchers []*cncfmatcherv3.Matcher_MatcherList_FieldMatcher
+	for i := len(backends) - 1; i > 0; i-- {
+		consistentHashing, err := typedExtensionConfig(consistentHashingMatcherName, &envoyconsistenthashing.ConsistentHashing{
+			Threshold: thresholds[i],
+			Modulo:    total,
+		})
+		if err != nil {
+			return nil, err
+		}
+		action, err := udpRouteAction(backends[i].ClusterName)
+		if err != nil {
+			return nil, err
+		}
+		fieldMatchers = append(fieldMatchers, &cncfmatcherv3.Matcher_MatcherList_FieldMatcher{
+			Predicate: &cncfmatcherv3.Matcher_MatcherList_Predicate{
+				MatchType: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+					SinglePredicate: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+						Input: sourceIPInput,
+						Matcher: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
+							CustomMatch: consistentHashing,
+						},
+					},
+				},
+			},
+			OnMatch: action,
+		})
+	}
+	matcher.MatcherType = &cncfmatcherv3.Matcher_MatcherList_{
+		MatcherList: &cncfmatcherv3.Matcher_MatcherList{
+			Matchers: fieldMatchers,
+		},
+	}
+	return matcher, nil
+}
+
+func udpRouteAction(cluster string) (*cncfmatcherv3.Matcher_OnMatch, error) {
+	route, err := typedExtensionConfig(udpRouteActionName, &envoyudp.Route{Cluster: cluster})
+	if err != nil {
+		return nil, err
+	}
+	return &cncfmatcherv3.Matcher_OnMatch{
+		OnMatch: &cncfmatcherv3.Matcher_OnMatch_Action{
+			Action: route,
+		},
+	}, nil
+}
+
+func typedExtensionConfig(name string, msg proto.Message) (*cncfcorev3.TypedExtensionConfig, error) {
+	typedConfig, err := utils.MessageToAny(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &cncfcorev3.TypedExtensionConfig{
+		Name:        name,
+		TypedConfig: typedConfig,
+	}, nil
+}
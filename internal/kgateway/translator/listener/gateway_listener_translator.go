@@ -11,6 +11,7 @@ import (
 	"istio.io/istio/pkg/kube/krt"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
@@ -29,8 +30,13 @@ import (
 var logger = logging.New("translator/listener")
 
 const (
-	TcpTlsListenerNoBackendsMessage = "TCP/TLS listener has no valid backends or routes"
-	SecretNotFoundMessageTemplate   = "Secret %s/%s not found." //nolint:gosec // G101: This is a template string, not hardcoded credentials
+	TcpTlsListenerNoBackendsMessage      = "TCP/TLS listener has no valid backends or routes"
+	UdpListenerNoBackendsMessage         = "UDP listener has no valid backends or routes"
+	UdpRouteNotSelectedMessage           = "Only one UDPRoute per listener is supported; the oldest route is used."
+	RouteNoBackendsMessageTemplate       = "%s has no backends."
+	RouteConflictMessageTemplate         = "%s conflicts with an older route attached to the same listener."
+	RouteHostnameConflictMessageTemplate = "%s hostnames %s conflict with an older route attached to the same listener."
+	SecretNotFoundMessageTemplate        = "Secret %s/%s not found." //nolint:gosec // G101: This is a template string, not hardcoded credentials
 )
 
 type ListenerTranslatorConfig struct {
@@ -116,6 +122,8 @@ func (ml *MergedListeners) AppendListener(
 		ml.AppendTcpListener(listener, routes, reporter)
 	case gwv1.TLSProtocolType:
 		ml.AppendTlsListener(listener, routes, reporter)
+	case gwv1.UDPProtocolType:
+		ml.AppendUdpListener(listener, routes, reporter)
 	default:
 		return fmt.Errorf("unsupported protocol: %v", listener.Protocol)
 	}
@@ -144,7 +152,7 @@ func (ml *MergedListeners) appendHttpListener(
 
 	finalPort := getListenerPortNumber(listener)
 	for _, lis := range ml.Listeners {
-		if lis.port != finalPort {
+		if lis.port != finalPort || lis.udpProxy != nil {
 			continue
 		}
 		if lis.httpFilterChain != nil {
@@ -184,13 +192,15 @@ func (ml *MergedListeners) appendHttpsListener(
 		tls:                 tls,
 		routesWithHosts:     routesWithHosts,
 		attachedPolicies:    listener.AttachedPolicies,
+		// listener policies take precedence over gateway policies
+		http3: ir.Http3ForListener(string(listener.Name), listener.AttachedPolicies, ml.parentGw.AttachedHttpPolicies),
 	}
 
 	// Perform the port transformation away from privileged ports only once to use
 	// during both lookup and when appending the listener.
 	finalPort := getListenerPortNumber(listener)
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpProxy == nil {
 			lis.httpsFilterChains = append(lis.httpsFilterChains, mfc)
 			return
 		}
@@ -224,7 +234,7 @@ func (ml *MergedListeners) AppendTcpListener(
 
 	finalPort := getListenerPortNumber(listener)
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpProxy == nil {
 			lis.TcpFilterChains = append(lis.TcpFilterChains, fc)
 			return
 		}
@@ -265,7 +275,7 @@ func (ml *MergedListeners) AppendTlsListener(
 
 	finalPort := getListenerPortNumber(listener)
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpProxy == nil {
 			lis.TcpFilterChains = append(lis.TcpFilterChains, fc)
 			return
 		}
@@ -283,6 +293,39 @@ func (ml *MergedListeners) AppendTlsListener(
 	})
 }
 
+func (ml *MergedListeners) AppendUdpListener(
+	listener ir.Listener,
+	routeInfos []*query.RouteInfo,
+	reporter reports.ListenerReporter,
+) {
+	up := &udpProxy{
+		gatewayListenerName: query.GenerateRouteKey(listener.Parent, string(listener.Name)),
+		routesWithHosts:     routeInfos,
+		listenerReporter:    reporter,
+	}
+
+	finalPort := getListenerPortNumber(listener)
+	for _, lis := range ml.Listeners {
+		if lis.port == finalPort && lis.udpProxy != nil {
+			// UDP listeners can't share a port with other UDP listeners as they have no filter chains,
+			// port conflicts are rejected when validating the Gateway listeners.
+			logger.Warn("ignoring UDP listener with a conflicting port", "listener", listener.Name, "port", finalPort)
+			return
+		}
+	}
+
+	ml.Listeners = append(ml.Listeners, &MergedListener{
+		name:             GenerateUdpListenerName(listener),
+		gatewayNamespace: ml.GatewayNamespace,
+		port:             finalPort,
+		udpProxy:         up,
+		listenerReporter: reporter,
+		listener:         listener,
+		gateway:          ml.parentGw,
+		settings:         ml.settings,
+	})
+}
+
 func (ml *MergedListeners) translateListeners(
 	kctx krt.HandlerContext,
 	ctx context.Context,
@@ -304,6 +347,7 @@ type MergedListener struct {
 	httpFilterChain   *httpFilterChain
 	httpsFilterChains []httpsFilterChain
 	TcpFilterChains   []tcpFilterChain
+	udpProxy          *udpProxy
 	listenerReporter  reports.ListenerReporter
 	listener          ir.Listener
 	gateway           ir.Gateway
@@ -349,10 +393,16 @@ func (ml *MergedListener) TranslateListener(
 
 	// Translate TCP listeners (if any exist)
 	var matchedTcpListeners []ir.TcpIR
-	for _, tfc := range ml.TcpFilterChains {
-		if tcpListener := tfc.translateTcpFilterChain(ml.name, reporter); tcpListener != nil {
-			matchedTcpListeners = append(matchedTcpListeners, *tcpListener)
+	tcpErrs := make([]error, len(ml.TcpFilterChains))
+	for i, tfc := range ml.TcpFilterChains {
+		tcpListeners, err := tfc.translateTcpFilterChain(kctx, ctx, ml.name, ml.gatewayNamespace, queries, reporter)
+		if err != nil {
+			// Log and skip invalid TCP filter chains
+			logger.Error("failed to translate TCP filter chain for listener", "listener", ml.name, "error", err)
+			tcpErrs[i] = err
+			continue
 		}
+		matchedTcpListeners = append(matchedTcpListeners, tcpListeners...)
 	}
 
 	// Only report errors if ALL TCP filter chains failed (port is not programmed)
@@ -365,11 +415,29 @@ func (ml *MergedListener) TranslateListener(
 			Message: TcpTlsListenerNoBackendsMessage,
 		}
 		// Report to all TCP filter chains since the entire port failed
-		for _, tfc := range ml.TcpFilterChains {
+		for i, tfc := range ml.TcpFilterChains {
+			if tcpErrs[i] != nil {
+				// the error has already been reported on the listener
+				continue
+			}
 			tfc.listenerReporter.SetCondition(listenerCondition)
 		}
 	}
 
+	// Translate the UDP proxy (if the listener is a UDP listener)
+	var udpListener *ir.UdpIR
+	if ml.udpProxy != nil {
+		udpListener = ml.udpProxy.translateUdpProxy(ml.name, reporter)
+		if udpListener == nil {
+			ml.udpProxy.listenerReporter.SetCondition(reports.ListenerCondition{
+				Type:    gwv1.ListenerConditionProgrammed,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.ListenerReasonInvalid,
+				Message: UdpListenerNoBackendsMessage,
+			})
+		}
+	}
+
 	// Get bind address based on ListenerBindIpv6 setting
 	bindAddress := "0.0.0.0"
 	if ml.settings.ListenerBindIpv6 {
@@ -384,6 +452,7 @@ func (ml *MergedListener) TranslateListener(
 		AttachedPolicies:  ir.AttachedPolicies{}, // TODO: find policies attached to listener and attach them <- this might not be possible due to listener merging. also a gw listener ~= envoy filter chain; and i don't believe we need policies there
 		HttpFilterChain:   httpFilterChains,
 		TcpFilterChain:    matchedTcpListeners,
+		UdpProxy:          udpListener,
 		PolicyAncestorRef: ml.listener.PolicyAncestorRef,
 	}
 }
@@ -403,150 +472,271 @@ type tcpFilterChainParent struct {
 	routesWithHosts     []*query.RouteInfo
 }
 
-func (tc *tcpFilterChain) translateTcpFilterChain(parentName string, reporter reports.Reporter) *ir.TcpIR {
-	parent := tc.parents
-	if len(parent.routesWithHosts) == 0 {
-		return nil
-	}
+// translateTcpFilterChain translates the TCPRoutes and TLSRoutes attached to a Gateway listener into TCP filter chains.
+// TLSRoutes become distinct filter chains matching the SNI of their hostnames, while a TCPRoute (or a TLSRoute without
+// hostnames) matches any connection. When routes conflict, the oldest route wins as per the Gateway API conflict
+// resolution rules and the conflicting routes are rejected, or marked partially invalid if only some of their
+// hostnames conflict.
+// When the listener terminates TLS, the filter chains terminate TLS and forward the decrypted stream to the backends.
+func (tc *tcpFilterChain) translateTcpFilterChain(
+	kctx krt.HandlerContext,
+	ctx context.Context,
+	parentName string,
+	gatewayNamespace string,
+	queries query.GatewayQueries,
+	reporter reports.Reporter,
+) ([]ir.TcpIR, error) {
+	// process routes first, so any route related errors are reported on the routes.
+	tcpIRs := tc.translateTcpRoutes(parentName, reporter)
 
-	if len(parent.routesWithHosts) > 1 {
-		// Only one route per listener is supported
-		// TODO: report error on the listener
-		//	reporter.Gateway(gw).SetCondition(reports.RouteCondition{
-		//		Type:   gwv1.RouteConditionPartiallyInvalid,
-		//		Status: metav1.ConditionTrue,
-		//		Reason: gwv1.RouteReasonUnsupportedValue,
-		//	})
+	sslConfig, err := translateSslConfig(kctx, ctx, gatewayNamespace, tc.tls, queries)
+	if err != nil {
+		reportSslConfigError(err, tc.listenerReporter)
+		return nil, err
 	}
-	r := slices.MinFunc(parent.routesWithHosts, func(a, b *query.RouteInfo) int {
-		return a.Object.GetSourceObject().GetCreationTimestamp().Compare(b.Object.GetSourceObject().GetCreationTimestamp().Time)
-	})
+	for i := range tcpIRs {
+		tcpIRs[i].TLS = sslConfig
+	}
+	return tcpIRs, nil
+}
 
-	switch r.Object.(type) {
-	case *ir.TcpRouteIR:
-		tRoute := r.Object.(*ir.TcpRouteIR)
+func (tc *tcpFilterChain) translateTcpRoutes(parentName string, reporter reports.Reporter) []ir.TcpIR {
+	routes := slices.Clone(tc.parents.routesWithHosts)
+	slices.SortStableFunc(routes, compareRouteAge)
 
-		var condition reports.RouteCondition
-		if len(tRoute.SourceObject.Spec.Rules) == 1 {
-			condition = reports.RouteCondition{
-				Type:   gwv1.RouteConditionAccepted,
-				Status: metav1.ConditionTrue,
-				Reason: gwv1.RouteReasonAccepted,
-			}
-		} else {
-			condition = reports.RouteCondition{
-				Type:   gwv1.RouteConditionAccepted,
-				Status: metav1.ConditionFalse,
-				Reason: gwv1.RouteReasonUnsupportedValue,
-			}
+	// claimed tracks the SNI hostnames matched by the routes translated so far.
+	// The empty hostname matches any connection.
+	claimed := sets.New[string]()
+	var tcpIRs []ir.TcpIR
+	for _, r := range routes {
+		var (
+			kind      string
+			backends  []ir.BackendRefIR
+			hostnames []string
+			policies  ir.AttachedPolicies
+		)
+		switch route := r.Object.(type) {
+		case *ir.TcpRouteIR:
+			kind = wellknown.TCPRouteKind
+			backends = route.Backends
+			policies = route.AttachedPolicies
+		case *ir.TlsRouteIR:
+			kind = wellknown.TLSRouteKind
+			backends = route.Backends
+			policies = route.AttachedPolicies
+			// the route hostnames have been intersected with the listener hostname
+			hostnames = r.Hostnames()
+		default:
+			continue
 		}
-		if condition.Status != metav1.ConditionTrue {
-			return nil
+		if len(hostnames) == 0 && tc.sniDomain != nil {
+			hostnames = []string{string(*tc.sniDomain)}
 		}
-
-		// Collect ParentRefReporters for the TCPRoute
-		parentRefReporters := make([]reports.ParentRefReporter, 0, len(tRoute.ParentRefs))
-		for _, parentRef := range tRoute.ParentRefs {
-			parentRefReporter := reporter.Route(tRoute.SourceObject).ParentRef(&parentRef)
-			parentRefReporter.SetCondition(condition)
-			parentRefReporters = append(parentRefReporters, parentRefReporter)
+		if len(hostnames) == 0 {
+			hostnames = []string{""}
 		}
 
-		// Ensure unique names by appending the rule index to the TCPRoute name
-		tcpHostName := fmt.Sprintf("%s-%s.%s-rule-%d", parentName, tRoute.Namespace, tRoute.Name, 0)
-		var backends []ir.BackendRefIR
-		for _, backend := range tRoute.Backends {
-			// validate that we don't have an error:
-			if backend.Err != nil || backend.BackendObject == nil {
-				err := backend.Err
-				if err == nil {
-					err = errors.New("not found")
-				}
-				for _, parentRefReporter := range parentRefReporters {
-					query.ProcessBackendError(err, parentRefReporter)
-				}
-			}
-			// add backend even if we have errors, as according to spec, with multiple destinations,
-			// they should fail based of the weights.
-			backends = append(backends, backend)
-		}
-		// Avoid creating a TcpListener if there are no TcpHosts
+		parentRefReporter := reporter.Route(r.Object.GetSourceObject()).ParentRef(&r.ParentRef)
 		if len(backends) == 0 {
-			return nil
-		}
-
-		return &ir.TcpIR{
-			FilterChainCommon: ir.FilterChainCommon{
-				FilterChainName: tcpHostName,
-			},
-			BackendRefs: backends,
+			// a route without backends is invalid, and must not shadow the routes it conflicts with
+			parentRefReporter.SetCondition(reports.RouteCondition{
+				Type:    gwv1.RouteConditionAccepted,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.RouteReasonUnsupportedValue,
+				Message: fmt.Sprintf(RouteNoBackendsMessageTemplate, kind),
+			})
+			continue
 		}
-	case *ir.TlsRouteIR:
-		tRoute := r.Object.(*ir.TlsRouteIR)
 
-		var condition reports.RouteCondition
-		if len(tRoute.SourceObject.Spec.Rules) == 1 {
-			condition = reports.RouteCondition{
-				Type:   gwv1.RouteConditionAccepted,
-				Status: metav1.ConditionTrue,
-				Reason: gwv1.RouteReasonAccepted,
-			}
-		} else {
-			condition = reports.RouteCondition{
-				Type:   gwv1.RouteConditionAccepted,
-				Status: metav1.ConditionFalse,
-				Reason: gwv1.RouteReasonUnsupportedValue,
+		var matched, conflicted []string
+		for _, h := range hostnames {
+			if claimed.Has(h) {
+				conflicted = append(conflicted, h)
+			} else {
+				matched = append(matched, h)
 			}
 		}
-		if condition.Status != metav1.ConditionTrue {
-			return nil
-		}
 
-		parentRefReporters := make([]reports.ParentRefReporter, 0, len(tRoute.ParentRefs))
-		for _, parentRef := range tRoute.ParentRefs {
-			parentRefReporter := reporter.Route(tRoute.SourceObject).ParentRef(&parentRef)
-			parentRefReporter.SetCondition(condition)
-			parentRefReporters = append(parentRefReporters, parentRefReporter)
+		if len(matched) == 0 {
+			parentRefReporter.SetCondition(reports.RouteCondition{
+				Type:    gwv1.RouteConditionAccepted,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.RouteReasonUnsupportedValue,
+				Message: routeConflictMessage(kind, conflicted),
+			})
+			continue
 		}
+		parentRefReporter.SetCondition(reports.RouteCondition{
+			Type:   gwv1.RouteConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: gwv1.RouteReasonAccepted,
+		})
+		if len(conflicted) > 0 {
+			parentRefReporter.SetCondition(reports.RouteCondition{
+				Type:    gwv1.RouteConditionPartiallyInvalid,
+				Status:  metav1.ConditionTrue,
+				Reason:  gwv1.RouteReasonUnsupportedValue,
+				Message: routeConflictMessage(kind, conflicted),
+			})
+		}
+		claimed.Insert(matched...)
 
-		// Ensure unique names by appending the rule index to the TLSRoute name
-		tcpHostName := fmt.Sprintf("%s-%s.%s-rule-%d", parentName, tRoute.Namespace, tRoute.Name, 0)
-		var backends []ir.BackendRefIR
-		for _, backend := range tRoute.Backends {
+		var validBackends []ir.BackendRefIR
+		for _, backend := range backends {
 			// validate that we don't have an error:
 			if backend.Err != nil || backend.BackendObject == nil {
 				err := backend.Err
 				if err == nil {
 					err = errors.New("not found")
 				}
-				for _, parentRefReporter := range parentRefReporters {
-					query.ProcessBackendError(err, parentRefReporter)
-				}
+				query.ProcessBackendError(err, parentRefReporter)
+			}
+			// a backend with a weight of 0 receives no traffic
+			if backend.Weight == 0 {
+				continue
 			}
 			// add backend even if we have errors, as according to spec, with multiple destinations,
 			// they should fail based of the weights.
-			backends = append(backends, backend)
+			validBackends = append(validBackends, backend)
 		}
-		// Avoid creating a TcpListener if there are no TcpHosts
-		if len(backends) == 0 {
-			return nil
+		// Avoid creating a filter chain if there are no backends
+		if len(validBackends) == 0 {
+			continue
 		}
 
 		var matcher ir.FilterChainMatch
-		if tc.sniDomain != nil {
-			matcher.SniDomains = []string{string(*tc.sniDomain)}
+		if matched[0] != "" {
+			matcher.SniDomains = matched
 		}
-
-		return &ir.TcpIR{
+		src := r.Object.GetSourceObject()
+		tcpIRs = append(tcpIRs, ir.TcpIR{
 			FilterChainCommon: ir.FilterChainCommon{
-				FilterChainName: tcpHostName,
+				// The rules of the route share a filter chain, which keeps the name of its first rule
+				FilterChainName: fmt.Sprintf("%s-%s.%s-rule-%d", parentName, src.GetNamespace(), src.GetName(), 0),
 				Matcher:         matcher,
 			},
-			BackendRefs: backends,
+			BackendRefs:      validBackends,
+			AttachedPolicies: policies,
+		})
+	}
+	return tcpIRs
+}
+
+// compareRouteAge orders routes from the oldest to the newest, using the namespace and name of the
+// routes to break ties.
+func compareRouteAge(a, b *query.RouteInfo) int {
+	srcA, srcB := a.Object.GetSourceObject(), b.Object.GetSourceObject()
+	if c := srcA.GetCreationTimestamp().Compare(srcB.GetCreationTimestamp().Time); c != 0 {
+		return c
+	}
+	if c := strings.Compare(srcA.GetNamespace(), srcB.GetNamespace()); c != 0 {
+		return c
+	}
+	return strings.Compare(srcA.GetName(), srcB.GetName())
+}
+
+// routeConflictMessage returns the status message of a route whose hostnames are already matched by
+// an older route attached to the same listener.
+func routeConflictMessage(kind string, hostnames []string) string {
+	if len(hostnames) == 1 && hostnames[0] == "" {
+		return fmt.Sprintf(RouteConflictMessageTemplate, kind)
+	}
+	return fmt.Sprintf(RouteHostnameConflictMessageTemplate, kind, strings.Join(hostnames, ", "))
+}
+
+// udpProxy represents a Gateway UDP listener. Unlike TCP listeners, UDP listeners have no filter chains,
+// so a listener port proxies the datagrams to the backends of a single UDPRoute.
+type udpProxy struct {
+	gatewayListenerName string
+	routesWithHosts     []*query.RouteInfo
+	listenerReporter    reports.ListenerReporter
+}
+
+func (up *udpProxy) translateUdpProxy(parentName string, reporter reports.Reporter) *ir.UdpIR {
+	var routes []*ir.UdpRouteIR
+	for _, r := range up.routesWithHosts {
+		if uRoute, ok := r.Object.(*ir.UdpRouteIR); ok {
+			routes = append(routes, uRoute)
 		}
-	default:
+	}
+	if len(routes) == 0 {
 		return nil
 	}
+
+	// Only one route per listener is supported, use the oldest one
+	uRoute := slices.MinFunc(routes, func(a, b *ir.UdpRouteIR) int {
+		return a.SourceObject.GetCreationTimestamp().Compare(b.SourceObject.GetCreationTimestamp().Time)
+	})
+	for _, r := range routes {
+		if r == uRoute {
+			continue
+		}
+		for _, parentRef := range r.ParentRefs {
+			reporter.Route(r.SourceObject).ParentRef(&parentRef).SetCondition(reports.RouteCondition{
+				Type:    gwv1.RouteConditionAccepted,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.RouteReasonUnsupportedValue,
+				Message: UdpRouteNotSelectedMessage,
+			})
+		}
+	}
+
+	var condition reports.RouteCondition
+	if len(uRoute.SourceObject.Spec.Rules) == 1 {
+		condition = reports.RouteCondition{
+			Type:   gwv1.RouteConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: gwv1.RouteReasonAccepted,
+		}
+	} else {
+		condition = reports.RouteCondition{
+			Type:    gwv1.RouteConditionAccepted,
+			Status:  metav1.ConditionFalse,
+			Reason:  gwv1.RouteReasonUnsupportedValue,
+			Message: "Only UDPRoutes with a single rule are supported.",
+		}
+	}
+
+	// Collect ParentRefReporters for the UDPRoute
+	parentRefReporters := make([]reports.ParentRefReporter, 0, len(uRoute.ParentRefs))
+	for _, parentRef := range uRoute.ParentRefs {
+		parentRefReporter := reporter.Route(uRoute.SourceObject).ParentRef(&parentRef)
+		parentRefReporter.SetCondition(condition)
+		parentRefReporters = append(parentRefReporters, parentRefReporter)
+	}
+	if condition.Status != metav1.ConditionTrue {
+		return nil
+	}
+
+	var backends []ir.BackendRefIR
+	for _, backend := range uRoute.Backends {
+		// validate that we don't have an error:
+		if backend.Err != nil || backend.BackendObject == nil {
+			err := backend.Err
+			if err == nil {
+				err = errors.New("not found")
+			}
+			for _, parentRefReporter := range parentRefReporters {
+				query.ProcessBackendError(err, parentRefReporter)
+			}
+		}
+		// a backend with a weight of 0 receives no traffic
+		if backend.Weight == 0 {
+			continue
+		}
+		// add backend even if we have errors, as according to spec, with multiple destinations,
+		// they should fail based of the weights.
+		backends = append(backends, backend)
+	}
+	if len(backends) == 0 {
+		return nil
+	}
+
+	return &ir.UdpIR{
+		Name:        fmt.Sprintf("%s-%s.%s-rule-%d", parentName, uRoute.Namespace, uRoute.Name, 0),
+		BackendRefs: backends,
+		IdleTimeout: uRoute.IdleTimeout,
+	}
 }
 
 // httpFilterChain each one represents a GW Listener that has been merged into a single Listener (with distinct filter chains).
@@ -663,6 +853,7 @@ type httpsFilterChain struct {
 	tls                 *gwv1.ListenerTLSConfig
 	routesWithHosts     []*query.RouteInfo
 	attachedPolicies    ir.AttachedPolicies
+	http3               *ir.Http3Settings
 }
 
 func (httpsFilterChain *httpsFilterChain) translateHttpsFilterChain(
@@ -714,32 +905,7 @@ func (httpsFilterChain *httpsFilterChain) translateHttpsFilterChain(
 		queries,
 	)
 	if err != nil {
-		reason := gwv1.ListenerReasonInvalidCertificateRef
-		message := "Invalid certificate ref."
-		if errors.Is(err, krtcollections.ErrMissingReferenceGrant) {
-			reason = gwv1.ListenerReasonRefNotPermitted
-			message = "Reference not permitted by ReferenceGrant."
-		}
-		if errors.Is(err, sslutils.ErrInvalidTlsSecret) {
-			message = err.Error()
-		}
-		var notFoundErr *krtcollections.NotFoundError
-		if errors.As(err, &notFoundErr) {
-			message = fmt.Sprintf(SecretNotFoundMessageTemplate, notFoundErr.NotFoundObj.Namespace, notFoundErr.NotFoundObj.Name)
-		}
-		listenerReporter.SetCondition(reports.ListenerCondition{
-			Type:    gwv1.ListenerConditionResolvedRefs,
-			Status:  metav1.ConditionFalse,
-			Reason:  reason,
-			Message: message,
-		})
-		// listener with no ssl is invalid. We return nil so set programmed to false
-		listenerReporter.SetCondition(reports.ListenerCondition{
-			Type:    gwv1.ListenerConditionProgrammed,
-			Status:  metav1.ConditionFalse,
-			Reason:  gwv1.ListenerReasonInvalid,
-			Message: message,
-		})
+		reportSslConfigError(err, listenerReporter)
 		return nil, err
 	}
 	sort.Slice(virtualHosts, func(i, j int) bool {
@@ -754,6 +920,7 @@ func (httpsFilterChain *httpsFilterChain) translateHttpsFilterChain(
 		},
 		AttachedPolicies: httpsFilterChain.attachedPolicies,
 		Vhosts:           virtualHosts,
+		Http3:            httpsFilterChain.http3,
 	}, nil
 }
 
@@ -786,6 +953,36 @@ func buildRoutesPerHost(
 	}
 }
 
+// reportSslConfigError reports the error to translate the TLS config of a listener terminating TLS.
+func reportSslConfigError(err error, listenerReporter reports.ListenerReporter) {
+	reason := gwv1.ListenerReasonInvalidCertificateRef
+	message := "Invalid certificate ref."
+	if errors.Is(err, krtcollections.ErrMissingReferenceGrant) {
+		reason = gwv1.ListenerReasonRefNotPermitted
+		message = "Reference not permitted by ReferenceGrant."
+	}
+	if errors.Is(err, sslutils.ErrInvalidTlsSecret) {
+		message = err.Error()
+	}
+	var notFoundErr *krtcollections.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		message = fmt.Sprintf(SecretNotFoundMessageTemplate, notFoundErr.NotFoundObj.Namespace, notFoundErr.NotFoundObj.Name)
+	}
+	listenerReporter.SetCondition(reports.ListenerCondition{
+		Type:    gwv1.ListenerConditionResolvedRefs,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	})
+	// listener with no ssl is invalid. We return nil so set programmed to false
+	listenerReporter.SetCondition(reports.ListenerCondition{
+		Type:    gwv1.ListenerConditionProgrammed,
+		Status:  metav1.ConditionFalse,
+		Reason:  gwv1.ListenerReasonInvalid,
+		Message: message,
+	})
+}
+
 func translateSslConfig(
 	kctx krt.HandlerContext,
 	ctx context.Context,
@@ -848,3 +1045,15 @@ func GenerateListenerName(listener ir.Listener) string {
 	// Add a ~ to make sure the name won't collide with user provided names in other listeners
 	return fmt.Sprintf("listener~%d", listener.Port)
 }
+
+// GenerateUdpListenerName returns the name of the UDP listener, which can share its port
+// with the TCP-based listeners.
+func GenerateUdpListenerName(listener ir.Listener) string {
+	return fmt.Sprintf("udp~%d", listener.Port)
+}
+
+// GenerateQuicListenerName returns the name of the companion QUIC listener serving HTTP/3 for
+// the HTTPS listeners on the given port.
+func GenerateQuicListenerName(port uint32) string {
+	return fmt.Sprintf("quic~%d", port)
+}
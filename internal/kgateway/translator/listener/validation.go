@@ -21,6 +21,13 @@ const (
 	DefaultHostname        = "*"
 )
 
+// listenerPort identifies the listeners that share a socket, as UDP listeners
+// don't share a socket with the TCP-based listeners on the same port.
+type listenerPort struct {
+	port gwv1.PortNumber
+	udp  bool
+}
+
 type portProtocol struct {
 	// When this struct is created, the listeners will be sorted based on Listener Precedence
 	// This is a map of hostname to the first listener with that hostname (stored as parent-kind/parent-namespace/parent-name.listener-name)
@@ -61,6 +68,11 @@ func getSupportedProtocolsRoutes() map[protocol]map[groupName][]routeKind {
 				wellknown.TLSRouteKind,
 			},
 		},
+		string(gwv1.UDPProtocolType): {
+			gwv1.GroupName: []string{
+				wellknown.UDPRouteKind,
+			},
+		},
 		string(gwv1.ProtocolType(istioprotocol.HBONE)): {
 			gwv1.GroupName: []string{
 				wellknown.HTTPRouteKind,
@@ -73,6 +85,25 @@ func getSupportedProtocolsRoutes() map[protocol]map[groupName][]routeKind {
 	return supportedProtocolToKinds
 }
 
+// getSupportedRouteKinds returns the route kinds supported by a listener. TLS listeners terminating TLS
+// also support TCPRoutes, as the decrypted stream is forwarded to the backends.
+func getSupportedRouteKinds(
+	listener ir.Listener,
+	supportedProtocolToKinds map[protocol]map[groupName][]routeKind,
+) (map[groupName][]routeKind, bool) {
+	supportedRouteKinds, ok := supportedProtocolToKinds[string(listener.Protocol)]
+	if ok && listener.Protocol == gwv1.TLSProtocolType &&
+		listener.TLS != nil && listener.TLS.Mode != nil && *listener.TLS.Mode == gwv1.TLSModeTerminate {
+		supportedRouteKinds = map[groupName][]routeKind{
+			gwv1.GroupName: {
+				wellknown.TLSRouteKind,
+				wellknown.TCPRouteKind,
+			},
+		}
+	}
+	return supportedRouteKinds, ok
+}
+
 func buildDefaultRouteKindsForProtocol(supportedRouteKindsForProtocol map[groupName][]routeKind) []gwv1.RouteGroupKind {
 	rgks := []gwv1.RouteGroupKind{}
 	for group, kinds := range supportedRouteKindsForProtocol {
@@ -91,7 +122,7 @@ func validateSupportedRoutes(listeners []ir.Listener, reporter reports.Reporter)
 	validListeners := []ir.Listener{}
 
 	for _, listener := range listeners {
-		supportedRouteKindsForProtocol, ok := supportedProtocolToKinds[string(listener.Protocol)]
+		supportedRouteKindsForProtocol, ok := getSupportedRouteKinds(listener, supportedProtocolToKinds)
 		parentReporter := listener.GetParentReporter(reporter)
 		if !ok {
 			// todo: log?
@@ -156,9 +187,10 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 
 	validListeners := validateSupportedRoutes(gw.Listeners, reporter)
 
-	portListeners := map[gwv1.PortNumber]*portProtocol{}
+	portListeners := map[listenerPort]*portProtocol{}
 	// The listeners are already sorted based on listener precedence
 	// The following loop groups listeners based on Port and stores the first listener with a unique hostname per port
+	// UDP listeners are grouped separately as they don't conflict with the TCP-based listeners on the same port, e.g. DNS on port 53
 	// The resulting map will then be used to reject listeners that have protocol and hostname conflicts
 	// Listeners on different ports don't need to be validated for a conflict so the example shown is only for a single port
 	// Given a set of listeners :
@@ -184,12 +216,12 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 	// 		  hostname: hostname-conflict-listener.com
 	// 		- name: listenerset-protocol-conflict-listener
 	// 		  port: 80
-	// 		  protocol: UDP
+	// 		  protocol: TCP
 	// This is the resulting map :
 	//		[80] : {
 	//		Protocol: {
+	//			"HTTP": true
 	//			"TCP": true
-	//			"UDP": true
 	//		},
 	//		Hostnames: {
 	// 			# For simplicity, only the listener name is shown
@@ -207,7 +239,8 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 			protocol = NormalizedHTTPSTLSType
 		}
 
-		if existingListener, ok := portListeners[listener.Port]; ok {
+		key := listenerPort{port: listener.Port, udp: listener.Protocol == gwv1.UDPProtocolType}
+		if existingListener, ok := portListeners[key]; ok {
 			existingListener.protocol[protocol] = true
 			existingListener.listeners = append(existingListener.listeners, listener)
 
@@ -227,7 +260,7 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 				},
 				listeners: []ir.Listener{listener},
 			}
-			portListeners[listener.Port] = &pp
+			portListeners[key] = &pp
 		}
 	}
 
@@ -259,8 +292,8 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 	// 		  hostname: hostname-conflict-listener.com
 	// 		- name: listenerset-protocol-conflict-listener		<----- protocol conflicts with gateway-protocol-conflict-listener
 	// 		  port: 80
-	// 		  protocol: UDP
-	for port, pp := range portListeners {
+	// 		  protocol: TCP
+	for key, pp := range portListeners {
 		for _, listener := range pp.listeners {
 			parentReporter := listener.GetParentReporter(reporter)
 			if protocolConflict(*pp, listener) {
@@ -269,7 +302,7 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 				// If a listener does not have a protocol conflict with one listener,
 				// it could still have a hostname conflict with another listener
 				rejectConflictedListener(parentReporter, listener, gwv1.ListenerReasonHostnameConflict, ListenerMessageHostnameConflict)
-			} else if err := validate.ListenerPort(listener, port); err != nil {
+			} else if err := validate.ListenerPort(listener, key.port); err != nil {
 				rejectConflictedListener(parentReporter, listener, gwv1.ListenerReasonInvalid, err.Error())
 			} else {
 				validListeners = append(validListeners, listener)
@@ -334,8 +367,8 @@ func protocolConflict(portProtocol portProtocol, listener ir.Listener) bool {
 	// An example of the portProtocol passed to this method :
 	//	{
 	//		Protocol: {
+	//			"HTTP": true
 	//			"TCP": true
-	//			"UDP": true
 	//		},
 	//		Hostnames: {
 	//			"gateway-listener.com": [gateway-listener],
@@ -350,8 +383,8 @@ func protocolConflict(portProtocol portProtocol, listener ir.Listener) bool {
 	// In this example, protocolConflict = true
 	if protocolConflict {
 		// The first listener in the list of sorted listeners is always accepted - based on listener precedence
-		// Accept all listeners with the same protocol as the first listener (gateway-listener). If not, only the `gateway-listener` will be accepted and all other TCP listeners will be rejected.
-		// This can lead to a situation where one UDP listener on the same port takes down all but the first TCP listener
+		// Accept all listeners with the same protocol as the first listener (gateway-listener). If not, only the `gateway-listener` will be accepted and all other HTTP listeners will be rejected.
+		// This can lead to a situation where one TCP listener on the same port takes down all but the first HTTP listener
 		// Listeners [gateway-hostname-conflict-listener, listenerset-listener, listenerset-hostname-conflict-listener] are accepted - hostname validation will happen later
 		if listener.Protocol == portProtocol.listeners[0].Protocol {
 			logger.Info("accepted listener with protocol conflict as per listener precedence", "name", listener.Name, "parent", listener.Parent.GetName())
@@ -369,8 +402,8 @@ func hostNameConflict(portProtocol portProtocol, listener ir.Listener) bool {
 	// An example of the portProtocol passed to this method :
 	//	{
 	//		Protocol: {
+	//			"HTTP": true
 	//			"TCP": true
-	//			"UDP": true
 	//		},
 	//		Hostnames: {
 	//			"gateway-listener.com": [gateway-listener],
@@ -294,15 +294,15 @@ func TestUnsupportedProtocol(t *testing.T) {
 	g.Expect(validListeners).To(BeEmpty())
 
 	expectedGwStatuses := map[string]gwv1.ListenerStatus{
-		"udp": {
-			Name:           "udp",
+		"sctp": {
+			Name:           "sctp",
 			SupportedKinds: []gwv1.RouteGroupKind{},
 			Conditions: []metav1.Condition{
 				{
 					Type:    string(gwv1.ListenerConditionAccepted),
 					Status:  metav1.ConditionFalse,
 					Reason:  string(gwv1.ListenerReasonUnsupportedProtocol),
-					Message: "Protocol UDP is unsupported.",
+					Message: "Protocol SCTP is unsupported.",
 				},
 			},
 		},
@@ -967,6 +967,41 @@ func TestTCPProtocolConflict(t *testing.T) {
 	assertExpectedListenerStatuses(t, g, report.ListenerSet(listenerSet), utils.ToListenerSlice(listenerSet.Spec.Listeners), expectedLsStatuses)
 }
 
+func TestUDPNoProtocolConflict(t *testing.T) {
+	gateway := udpTCPSharedPortGw()
+	report := reports.NewReportMap()
+	reporter := reports.NewReporter(&report)
+
+	validListeners := validateGateway(gwToIr(gateway, nil, nil), reporter)
+	g := NewWithT(t)
+	g.Expect(validListeners).To(HaveLen(2))
+
+	// UDP listeners don't conflict with the TCP-based listeners on the same port
+	expectedStatuses := map[string]gwv1.ListenerStatus{
+		"dns": {
+			Name: "dns",
+			SupportedKinds: []gwv1.RouteGroupKind{
+				{
+					Group: GroupNameHelper(),
+					Kind:  "UDPRoute",
+				},
+			},
+			Conditions: []metav1.Condition{},
+		},
+		"dns-tcp": {
+			Name: "dns-tcp",
+			SupportedKinds: []gwv1.RouteGroupKind{
+				{
+					Group: GroupNameHelper(),
+					Kind:  "TCPRoute",
+				},
+			},
+			Conditions: []metav1.Condition{},
+		},
+	}
+	assertExpectedListenerStatuses(t, g, report.Gateway(gateway), gateway.Spec.Listeners, expectedStatuses)
+}
+
 func TestTCPHostnameConflict(t *testing.T) {
 	gateway := tcpHostnameConflictGw()
 	listenerSet := tcpHostnameConflictLs()
@@ -1161,6 +1196,30 @@ func tcpProtocolConflictLs() *gwxv1a1.XListenerSet {
 	}
 }
 
+func udpTCPSharedPortGw() *gwv1.Gateway {
+	return &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "udp-tcp-gateway",
+		},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "kgateway",
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "dns",
+					Port:     53,
+					Protocol: gwv1.UDPProtocolType,
+				},
+				{
+					Name:     "dns-tcp",
+					Port:     53,
+					Protocol: gwv1.TCPProtocolType,
+				},
+			},
+		},
+	}
+}
+
 func tcpHostnameConflictGw() *gwv1.Gateway {
 	hostname := gwv1.Hostname("kgateway.dev")
 	return &gwv1.Gateway{
@@ -2199,9 +2258,9 @@ func unsupportedProtocolGw() *gwv1.Gateway {
 			GatewayClassName: "kgateway",
 			Listeners: []gwv1.Listener{
 				{
-					Name:     "udp",
+					Name:     "sctp",
 					Port:     8080,
-					Protocol: gwv1.UDPProtocolType,
+					Protocol: "SCTP",
 				},
 			},
 		},
@@ -0,0 +1,186 @@
+package sslutils
+
+import (
+	"fmt"
+	"strings"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// CACertAltKey is the alternative key of the CA certificate in a Secret, as supported by Istio.
+	CACertAltKey = "cacert"
+
+	// kubernetesSdsSecretPrefix is the prefix of the names of the SDS secrets served by the kgateway
+	// xDS server from Kubernetes Secrets.
+	kubernetesSdsSecretPrefix = "kubernetes://"
+	// caSdsSecretSuffix is appended to the name of the SDS secret serving the CA certificate of a
+	// Kubernetes Secret. ':' is not allowed in a Kubernetes Secret name, so the names never collide.
+	caSdsSecretSuffix = ":cacert"
+	// kubernetesGenericSdsSecretPrefix is the prefix of the names of the generic SDS secrets served by the
+	// kgateway xDS server from a key of Kubernetes Secrets.
+	kubernetesGenericSdsSecretPrefix = "kubernetes-generic://"
+	// gcpIDTokenSdsSecretPrefix is the prefix of the names of the generic SDS secrets served by the kgateway
+	// xDS server from the Google ID tokens minted with the service account key of Kubernetes Secrets.
+	gcpIDTokenSdsSecretPrefix = "gcp-id-token://"
+)
+
+// KubernetesSdsSecretName returns the name of the SDS secret serving the certificate and key of a Kubernetes Secret.
+func KubernetesSdsSecretName(namespace, name string) string {
+	return kubernetesSdsSecretPrefix + namespace + "/" + name
+}
+
+// KubernetesCASdsSecretName returns the name of the SDS secret serving the CA certificate of a Kubernetes Secret.
+func KubernetesCASdsSecretName(namespace, name string) string {
+	return KubernetesSdsSecretName(namespace, name) + caSdsSecretSuffix
+}
+
+// ParseKubernetesSdsSecretName parses the name of an SDS secret served from a Kubernetes Secret.
+// It returns false if the name was not built by KubernetesSdsSecretName or KubernetesCASdsSecretName.
+func ParseKubernetesSdsSecretName(sdsName string) (namespace, name string, isCA bool, ok bool) {
+	ref, ok := strings.CutPrefix(sdsName, kubernetesSdsSecretPrefix)
+	if !ok {
+		return "", "", false, false
+	}
+	ref, isCA = strings.CutSuffix(ref, caSdsSecretSuffix)
+	namespace, name, ok = strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" {
+		return "", "", false, false
+	}
+	return namespace, name, isCA, true
+}
+
+// KubernetesGenericSdsSecretName returns the name of the generic SDS secret serving the given key of a Kubernetes Secret.
+func KubernetesGenericSdsSecretName(namespace, name, key string) string {
+	return kubernetesGenericSdsSecretPrefix + namespace + "/" + name + "/" + key
+}
+
+// ParseKubernetesGenericSdsSecretName parses the name of a generic SDS secret served from a key of a Kubernetes Secret.
+// It returns false if the name was not built by KubernetesGenericSdsSecretName.
+func ParseKubernetesGenericSdsSecretName(sdsName string) (namespace, name, key string, ok bool) {
+	return parseSecretRefSdsSecretName(sdsName, kubernetesGenericSdsSecretPrefix)
+}
+
+// GcpIDTokenSdsSecretName returns the name of the generic SDS secret serving the Google ID tokens for the given
+// audience, minted with the service account key of a Kubernetes Secret.
+func GcpIDTokenSdsSecretName(namespace, name, audience string) string {
+	return gcpIDTokenSdsSecretPrefix + namespace + "/" + name + "/" + audience
+}
+
+// ParseGcpIDTokenSdsSecretName parses the name of a generic SDS secret serving Google ID tokens.
+// It returns false if the name was not built by GcpIDTokenSdsSecretName.
+func ParseGcpIDTokenSdsSecretName(sdsName string) (namespace, name, audience string, ok bool) {
+	return parseSecretRefSdsSecretName(sdsName, gcpIDTokenSdsSecretPrefix)
+}
+
+// parseSecretRefSdsSecretName parses a name made of the prefix, the namespace and name of a Kubernetes Secret
+// and a non-empty suffix, separated by '/'. '/' is not allowed in a Kubernetes Secret name, so the suffix may
+// contain it.
+func parseSecretRefSdsSecretName(sdsName, prefix string) (namespace, name, suffix string, ok bool) {
+	ref, ok := strings.CutPrefix(sdsName, prefix)
+	if !ok {
+		return "", "", "", false
+	}
+	namespace, ref, ok = strings.Cut(ref, "/")
+	if !ok {
+		return "", "", "", false
+	}
+	name, suffix, ok = strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" || suffix == "" {
+		return "", "", "", false
+	}
+	return namespace, name, suffix, true
+}
+
+// AdsConfigSource returns the config source of the SDS secrets served by the kgateway xDS server.
+func AdsConfigSource() *envoycorev3.ConfigSource {
+	return &envoycorev3.ConfigSource{
+		ResourceApiVersion: envoycorev3.ApiVersion_V3,
+		ConfigSourceSpecifier: &envoycorev3.ConfigSource_Ads{
+			Ads: &envoycorev3.AggregatedConfigSource{},
+		},
+	}
+}
+
+// SecretCACertificate returns the CA certificate of a Kubernetes Secret, if any.
+func SecretCACertificate(data map[string][]byte) []byte {
+	if ca, ok := data[corev1.ServiceAccountRootCAKey]; ok {
+		return ca
+	}
+	return data[CACertAltKey]
+}
+
+// KubernetesSdsSecret returns the SDS secret with the given name, built from the data of a Kubernetes Secret.
+func KubernetesSdsSecret(sdsName string, isCA bool, data map[string][]byte) (*envoytlsv3.Secret, error) {
+	if isCA {
+		ca := SecretCACertificate(data)
+		if len(ca) == 0 {
+			return nil, ErrMissingCACertKey
+		}
+		return &envoytlsv3.Secret{
+			Name: sdsName,
+			Type: &envoytlsv3.Secret_ValidationContext{
+				ValidationContext: &envoytlsv3.CertificateValidationContext{
+					TrustedCa: inlineBytes(ca),
+				},
+			},
+		}, nil
+	}
+
+	certChain, privateKey := data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey]
+	if len(certChain) == 0 || len(privateKey) == 0 {
+		return nil, fmt.Errorf("%w: %s and %s are required", NoCertificateFoundError, corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+	}
+	return &envoytlsv3.Secret{
+		Name: sdsName,
+		Type: &envoytlsv3.Secret_TlsCertificate{
+			TlsCertificate: &envoytlsv3.TlsCertificate{
+				CertificateChain: inlineBytes(certChain),
+				PrivateKey:       inlineBytes(privateKey),
+			},
+		},
+	}, nil
+}
+
+// GenericSdsSecret returns the generic SDS secret with the given name and value.
+func GenericSdsSecret(sdsName string, value []byte) *envoytlsv3.Secret {
+	return &envoytlsv3.Secret{
+		Name: sdsName,
+		Type: &envoytlsv3.Secret_GenericSecret{
+			GenericSecret: &envoytlsv3.GenericSecret{
+				Secret: inlineBytes(value),
+			},
+		},
+	}
+}
+
+// UpstreamTLSSdsSecretNames returns the names of the SDS secrets served by the kgateway xDS server that
+// are referenced by the given upstream TLS context.
+func UpstreamTLSSdsSecretNames(tlsContext *envoytlsv3.UpstreamTlsContext) []string {
+	var names []string
+	addName := func(sdsConfig *envoytlsv3.SdsSecretConfig) {
+		if sdsConfig.GetSdsConfig().GetAds() == nil {
+			return
+		}
+		if _, _, _, ok := ParseKubernetesSdsSecretName(sdsConfig.GetName()); ok {
+			names = append(names, sdsConfig.GetName())
+		}
+	}
+	commonTlsContext := tlsContext.GetCommonTlsContext()
+	for _, sdsConfig := range commonTlsContext.GetTlsCertificateSdsSecretConfigs() {
+		addName(sdsConfig)
+	}
+	addName(commonTlsContext.GetValidationContextSdsSecretConfig())
+	addName(commonTlsContext.GetCombinedValidationContext().GetValidationContextSdsSecretConfig())
+	return names
+}
+
+func inlineBytes(data []byte) *envoycorev3.DataSource {
+	return &envoycorev3.DataSource{
+		Specifier: &envoycorev3.DataSource_InlineBytes{
+			InlineBytes: data,
+		},
+	}
+}
@@ -20,6 +20,20 @@ const (
 	// IngressUseWaypointLabel is a Service/ServiceEntry label to ask the ingress to use
 	// a waypoint for ingress traffic.
 	IngressUseWaypointLabel = "istio.io/ingress-use-waypoint"
+
+	// DestinationRuleSubsetMetadataKey is the endpoint metadata key, in the EnvoyLbMetadataNamespace,
+	// listing the names of the DestinationRule subsets an endpoint belongs to.
+	DestinationRuleSubsetMetadataKey = "kgateway.dev/destination-rule-subset"
+
+	// AIProviderMetadataKey is the endpoint metadata key, in the EnvoyLbMetadataNamespace,
+	// holding the name of the provider of the AI backend the endpoint belongs to.
+	AIProviderMetadataKey = "kgateway.dev/ai-provider"
+)
+
+const (
+	// EnvoyLbMetadataNamespace is the metadata namespace used by the Envoy subset load balancer
+	// to match the route metadata against the endpoint metadata.
+	EnvoyLbMetadataNamespace = "envoy.lb"
 )
 
 const (
@@ -51,3 +65,17 @@ const (
 	// DefaultAWSRegion is the default AWS region.
 	DefaultAWSRegion = "us-east-1"
 )
+
+// Azure constants for functions configuration
+const (
+	// FunctionKey is the key name in the secret data for the Azure Functions function key.
+	FunctionKey = "functionKey"
+	// ClientSecret is the key name in the secret data for the client secret of an Entra ID application.
+	ClientSecret = "clientSecret"
+)
+
+// GCP constants for Cloud Run and Cloud Functions configuration
+const (
+	// ServiceAccountKey is the key name in the secret data for the JSON key of a Google service account.
+	ServiceAccountKey = "serviceAccountKey"
+)
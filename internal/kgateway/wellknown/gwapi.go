@@ -21,6 +21,7 @@ const (
 	HTTPRouteKind        = "HTTPRoute"
 	TCPRouteKind         = "TCPRoute"
 	TLSRouteKind         = "TLSRoute"
+	UDPRouteKind         = "UDPRoute"
 	GRPCRouteKind        = "GRPCRoute"
 	GatewayKind          = "Gateway"
 	GatewayClassKind     = "GatewayClass"
@@ -30,6 +31,9 @@ const (
 	// Kind string for XListenerSet resource
 	XListenerSetKind = "XListenerSet"
 
+	// Kind string for XBackendTrafficPolicy resource
+	XBackendTrafficPolicyKind = "XBackendTrafficPolicy"
+
 	// Kind string for InferencePool resource
 	InferencePoolKind = "InferencePool"
 
@@ -69,6 +73,11 @@ var (
 		Version: apiv1alpha2.GroupVersion.Version,
 		Kind:    TCPRouteKind,
 	}
+	UDPRouteGVK = schema.GroupVersionKind{
+		Group:   GatewayGroup,
+		Version: apiv1alpha2.GroupVersion.Version,
+		Kind:    UDPRouteKind,
+	}
 	GRPCRouteGVK = schema.GroupVersionKind{
 		Group:   GatewayGroup,
 		Version: apiv1.GroupVersion.Version,
@@ -112,6 +121,17 @@ var (
 		Version:  apixv1alpha1.GroupVersion.Version,
 		Resource: "xlistenersets",
 	}
+
+	XBackendTrafficPolicyGVK = schema.GroupVersionKind{
+		Group:   XListenerSetGroup,
+		Version: apixv1alpha1.GroupVersion.Version,
+		Kind:    XBackendTrafficPolicyKind,
+	}
+	XBackendTrafficPolicyGVR = schema.GroupVersionResource{
+		Group:    XListenerSetGroup,
+		Version:  apixv1alpha1.GroupVersion.Version,
+		Resource: "xbackendtrafficpolicies",
+	}
 )
 
 // IsInferencePoolGK returns true if the given group and kind match
@@ -6,8 +6,9 @@ import (
 )
 
 var (
-	ServiceEntryGVK = istionetworking.SchemeGroupVersion.WithKind("ServiceEntry")
-	HostnameGVK     = istionetworking.SchemeGroupVersion.WithKind("Hostname")
+	ServiceEntryGVK    = istionetworking.SchemeGroupVersion.WithKind("ServiceEntry")
+	DestinationRuleGVK = istionetworking.SchemeGroupVersion.WithKind("DestinationRule")
+	HostnameGVK        = istionetworking.SchemeGroupVersion.WithKind("Hostname")
 )
 
 var (
@@ -0,0 +1,10 @@
+package wellknown
+
+import (
+	mcsv1a1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+)
+
+var (
+	ServiceImportGVK = mcsv1a1.SchemeGroupVersion.WithKind("ServiceImport")
+	ServiceImportGVR = mcsv1a1.SchemeGroupVersion.WithResource("serviceimports")
+)
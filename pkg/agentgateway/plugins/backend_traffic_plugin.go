@@ -0,0 +1,171 @@
+package plugins
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+// NewBackendTrafficPlugin creates a new XBackendTrafficPolicy plugin.
+// Agentgateway supports neither retry budgets nor session persistence, so the plugin contributes no
+// policies and only reports the policy as not accepted by agentgateway on each of its targets that
+// is referenced by a route of an agentgateway Gateway. The policies of Services only routed by the
+// Envoy-based Gateways are left to the Envoy controller.
+func NewBackendTrafficPlugin(agw *AgwCollections) AgwPlugin {
+	policyStatusCol, policyCol := krt.NewStatusManyCollection(agw.XBackendTrafficPolicies, func(krtctx krt.HandlerContext, btp *gwxv1a1.XBackendTrafficPolicy) (
+		*gwv1.PolicyStatus,
+		[]AgwPolicy,
+	) {
+		routed := agwRoutedServices(krtctx, agw)
+		return translateBackendTrafficPolicyStatus(btp, agw.ControllerName, func(name gwv1.ObjectName) bool {
+			return routed.Has(types.NamespacedName{Namespace: btp.Namespace, Name: string(name)})
+		}), nil
+	})
+
+	return AgwPlugin{
+		ContributesPolicies: map[schema.GroupKind]PolicyPlugin{
+			wellknown.XBackendTrafficPolicyGVK.GroupKind(): {
+				Policies: policyCol,
+				PolicyStatuses: krt.NewCollection(policyStatusCol, func(ctx krt.HandlerContext, item krt.ObjectWithStatus[*gwxv1a1.XBackendTrafficPolicy, gwv1.PolicyStatus]) *krt.ObjectWithStatus[controllers.Object, gwv1.PolicyStatus] {
+					return &krt.ObjectWithStatus[controllers.Object, gwv1.PolicyStatus]{
+						Obj:    controllers.Object(item.Obj),
+						Status: item.Status,
+					}
+				}),
+			},
+		},
+		ExtraHasSynced: func() bool {
+			return policyCol.HasSynced() && policyStatusCol.HasSynced()
+		},
+	}
+}
+
+// backendTrafficPolicyUnsupportedMessage returns the message of the Accepted=False condition, which names
+// the configured fields so that users porting manifests from Envoy-based Gateways know what is not applied.
+func backendTrafficPolicyUnsupportedMessage(btp *gwxv1a1.XBackendTrafficPolicy) string {
+	var fields []string
+	if btp.Spec.RetryConstraint != nil {
+		fields = append(fields, "retryConstraint")
+	}
+	if btp.Spec.SessionPersistence != nil {
+		fields = append(fields, "sessionPersistence")
+	}
+	switch len(fields) {
+	case 0:
+		return "XBackendTrafficPolicy is not supported by agentgateway"
+	case 1:
+		return fmt.Sprintf("XBackendTrafficPolicy is not supported by agentgateway, %s is not applied", fields[0])
+	default:
+		return fmt.Sprintf("XBackendTrafficPolicy is not supported by agentgateway, %s are not applied", strings.Join(fields, " and "))
+	}
+}
+
+// translateBackendTrafficPolicyStatus returns the status of the policy with an ancestor per Service target
+// for which routed returns true
+func translateBackendTrafficPolicyStatus(btp *gwxv1a1.XBackendTrafficPolicy, controllerName string, routed func(gwv1.ObjectName) bool) *gwv1.PolicyStatus {
+	var ancestors []gwv1.PolicyAncestorStatus
+	for _, target := range btp.Spec.TargetRefs {
+		if string(target.Kind) != wellknown.ServiceKind || !routed(target.Name) {
+			continue
+		}
+		var conds []metav1.Condition
+		meta.SetStatusCondition(&conds, metav1.Condition{
+			Type:    string(gwv1.PolicyConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(gwv1.PolicyReasonInvalid),
+			Message: backendTrafficPolicyUnsupportedMessage(btp),
+		})
+		ancestors = append(ancestors, gwv1.PolicyAncestorStatus{
+			AncestorRef: gwv1.ParentReference{
+				Group:     ptr.To(gwv1.Group("")),
+				Kind:      ptr.To(gwv1.Kind(wellknown.ServiceKind)),
+				Name:      target.Name,
+				Namespace: ptr.To(gwv1.Namespace(btp.Namespace)),
+			},
+			ControllerName: gwv1.GatewayController(controllerName),
+			Conditions:     conds,
+		})
+	}
+	return &gwv1.PolicyStatus{
+		Ancestors: ancestors,
+	}
+}
+
+// agwRoutedServices returns the Services referenced by the routes attached to the agentgateway Gateways
+func agwRoutedServices(krtctx krt.HandlerContext, agw *AgwCollections) sets.Set[types.NamespacedName] {
+	services := sets.New[types.NamespacedName]()
+	addRoute := func(namespace string, parentRefs []gwv1.ParentReference, backendRefs []gwv1.BackendRef) {
+		if !slices.ContainsFunc(parentRefs, func(ref gwv1.ParentReference) bool {
+			return isAgwGateway(krtctx, agw, namespace, ref)
+		}) {
+			return
+		}
+		for _, ref := range backendRefs {
+			if ptr.Deref(ref.Group, "") != "" || ptr.Deref(ref.Kind, wellknown.ServiceKind) != wellknown.ServiceKind {
+				continue
+			}
+			services.Insert(types.NamespacedName{
+				Namespace: string(ptr.Deref(ref.Namespace, gwv1.Namespace(namespace))),
+				Name:      string(ref.Name),
+			})
+		}
+	}
+
+	for _, route := range krt.Fetch(krtctx, agw.HTTPRoutes) {
+		for _, rule := range route.Spec.Rules {
+			backendRefs := make([]gwv1.BackendRef, 0, len(rule.BackendRefs))
+			for _, ref := range rule.BackendRefs {
+				backendRefs = append(backendRefs, ref.BackendRef)
+			}
+			addRoute(route.Namespace, route.Spec.ParentRefs, backendRefs)
+		}
+	}
+	for _, route := range krt.Fetch(krtctx, agw.GRPCRoutes) {
+		for _, rule := range route.Spec.Rules {
+			backendRefs := make([]gwv1.BackendRef, 0, len(rule.BackendRefs))
+			for _, ref := range rule.BackendRefs {
+				backendRefs = append(backendRefs, ref.BackendRef)
+			}
+			addRoute(route.Namespace, route.Spec.ParentRefs, backendRefs)
+		}
+	}
+	for _, route := range krt.Fetch(krtctx, agw.TCPRoutes) {
+		for _, rule := range route.Spec.Rules {
+			addRoute(route.Namespace, route.Spec.ParentRefs, rule.BackendRefs)
+		}
+	}
+	for _, route := range krt.Fetch(krtctx, agw.TLSRoutes) {
+		for _, rule := range route.Spec.Rules {
+			addRoute(route.Namespace, route.Spec.ParentRefs, rule.BackendRefs)
+		}
+	}
+	return services
+}
+
+// isAgwGateway returns true if the parent reference of a route in the given namespace is a Gateway
+// of a GatewayClass managed by the agentgateway controller
+func isAgwGateway(krtctx krt.HandlerContext, agw *AgwCollections, namespace string, ref gwv1.ParentReference) bool {
+	if ptr.Deref(ref.Group, gwv1.GroupName) != gwv1.GroupName || ptr.Deref(ref.Kind, wellknown.GatewayKind) != wellknown.GatewayKind {
+		return false
+	}
+	gwKey := string(ptr.Deref(ref.Namespace, gwv1.Namespace(namespace))) + "/" + string(ref.Name)
+	gw := krt.FetchOne(krtctx, agw.Gateways, krt.FilterKey(gwKey))
+	if gw == nil {
+		return false
+	}
+	class := krt.FetchOne(krtctx, agw.GatewayClasses, krt.FilterKey(string((*gw).Spec.GatewayClassName)))
+	return class != nil && string((*class).Spec.ControllerName) == agw.ControllerName
+}
@@ -0,0 +1,99 @@
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+)
+
+func TestTranslateBackendTrafficPolicyStatus(t *testing.T) {
+	btp := &gwxv1a1.XBackendTrafficPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "btp"},
+		Spec: gwxv1a1.BackendTrafficPolicySpec{
+			TargetRefs: []gwxv1a1.LocalPolicyTargetReference{
+				{Group: "", Kind: "Service", Name: "backend"},
+				{Group: "", Kind: "Service", Name: "envoy-backend"},
+				{Group: "gateway.kgateway.dev", Kind: "Backend", Name: "other"},
+			},
+			SessionPersistence: &gwv1.SessionPersistence{SessionName: ptr.To("session")},
+		},
+	}
+
+	// only the Services routed by agentgateway get an ancestor
+	status := translateBackendTrafficPolicyStatus(btp, "kgateway.dev/agentgateway", func(name gwv1.ObjectName) bool {
+		return name != "envoy-backend"
+	})
+	assert.Len(t, status.Ancestors, 1)
+	assert.Equal(t, gwv1.ObjectName("backend"), status.Ancestors[0].AncestorRef.Name)
+	assert.Len(t, status.Ancestors[0].Conditions, 1)
+	assert.Equal(t, metav1.ConditionFalse, status.Ancestors[0].Conditions[0].Status)
+	assert.Equal(t, "XBackendTrafficPolicy is not supported by agentgateway, sessionPersistence is not applied", status.Ancestors[0].Conditions[0].Message)
+
+	btp.Spec.RetryConstraint = &gwxv1a1.RetryConstraint{}
+	assert.Equal(t, "XBackendTrafficPolicy is not supported by agentgateway, retryConstraint and sessionPersistence are not applied",
+		backendTrafficPolicyUnsupportedMessage(btp))
+}
+
+func TestAgwRoutedServices(t *testing.T) {
+	route := func(name, gateway string, backends ...gwv1.HTTPBackendRef) *gwv1.HTTPRoute {
+		return &gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: gwv1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1.CommonRouteSpec{
+					ParentRefs: []gwv1.ParentReference{{Name: gwv1.ObjectName(gateway)}},
+				},
+				Rules: []gwv1.HTTPRouteRule{{BackendRefs: backends}},
+			},
+		}
+	}
+	backendRef := func(name string, namespace *gwv1.Namespace) gwv1.HTTPBackendRef {
+		return gwv1.HTTPBackendRef{BackendRef: gwv1.BackendRef{BackendObjectReference: gwv1.BackendObjectReference{
+			Name:      gwv1.ObjectName(name),
+			Namespace: namespace,
+		}}}
+	}
+
+	mock := krttest.NewMock(t, []any{
+		&gwv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "agentgateway"},
+			Spec:       gwv1.GatewayClassSpec{ControllerName: "kgateway.dev/agentgateway"},
+		},
+		&gwv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "kgateway"},
+			Spec:       gwv1.GatewayClassSpec{ControllerName: "kgateway.dev/kgateway"},
+		},
+		&gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "agw"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "agentgateway"},
+		},
+		&gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "envoy"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "kgateway"},
+		},
+		route("agw-route", "agw", backendRef("backend", nil), backendRef("remote", ptr.To[gwv1.Namespace]("other"))),
+		route("envoy-route", "envoy", backendRef("envoy-backend", nil)),
+	})
+	agw := &AgwCollections{
+		GatewayClasses: krttest.GetMockCollection[*gwv1.GatewayClass](mock),
+		Gateways:       krttest.GetMockCollection[*gwv1.Gateway](mock),
+		HTTPRoutes:     krttest.GetMockCollection[*gwv1.HTTPRoute](mock),
+		GRPCRoutes:     krttest.GetMockCollection[*gwv1.GRPCRoute](mock),
+		TCPRoutes:      krttest.GetMockCollection[*gwv1alpha2.TCPRoute](mock),
+		TLSRoutes:      krttest.GetMockCollection[*gwv1alpha2.TLSRoute](mock),
+		ControllerName: "kgateway.dev/agentgateway",
+	}
+
+	services := agwRoutedServices(krt.TestingDummyContext{}, agw)
+	assert.ElementsMatch(t, []types.NamespacedName{
+		{Namespace: "default", Name: "backend"},
+		{Namespace: "other", Name: "remote"},
+	}, services.UnsortedList())
+}
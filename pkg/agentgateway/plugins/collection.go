@@ -22,6 +22,7 @@ import (
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
@@ -57,6 +58,9 @@ type AgwCollections struct {
 	ReferenceGrants    krt.Collection[*gwv1beta1.ReferenceGrant]
 	BackendTLSPolicies krt.Collection[*gwv1.BackendTLSPolicy]
 
+	// Gateway API experimental resources
+	XBackendTrafficPolicies krt.Collection[*gwxv1a1.XBackendTrafficPolicy]
+
 	// Extended resources
 	InferencePools krt.Collection[*inf.InferencePool]
 
@@ -220,6 +224,7 @@ func (c *AgwCollections) HasSynced() bool {
 		c.TLSRoutes != nil && c.TLSRoutes.HasSynced() &&
 		c.ReferenceGrants != nil && c.ReferenceGrants.HasSynced() &&
 		c.BackendTLSPolicies != nil && c.BackendTLSPolicies.HasSynced() &&
+		c.XBackendTrafficPolicies != nil && c.XBackendTrafficPolicies.HasSynced() &&
 		c.InferencePools != nil && c.InferencePools.HasSynced() &&
 		c.WrappedPods != nil && c.WrappedPods.HasSynced() &&
 		c.RefGrants != nil && c.RefGrants.HasSynced() &&
@@ -288,7 +293,9 @@ func NewAgwCollections(
 		TCPRoutes:       krt.WrapClient(kclient.NewDelayedInformer[*gwv1alpha2.TCPRoute](commoncol.Client, gvr.TCPRoute, kubetypes.StandardInformer, kubetypes.Filter{ObjectFilter: commoncol.Client.ObjectFilter()}), commoncol.KrtOpts.ToOptions("informer/TCPRoutes")...),
 		TLSRoutes:       krt.WrapClient(kclient.NewDelayedInformer[*gwv1alpha2.TLSRoute](commoncol.Client, gvr.TLSRoute, kubetypes.StandardInformer, kubetypes.Filter{ObjectFilter: commoncol.Client.ObjectFilter()}), commoncol.KrtOpts.ToOptions("informer/TLSRoutes")...),
 		ReferenceGrants: krt.WrapClient(kclient.NewFiltered[*gwv1beta1.ReferenceGrant](commoncol.Client, kubetypes.Filter{ObjectFilter: commoncol.Client.ObjectFilter()}), commoncol.KrtOpts.ToOptions("informer/ReferenceGrants")...),
-		// BackendTrafficPolicy?
+
+		// Gateway API experimental
+		XBackendTrafficPolicies: krt.WrapClient(kclient.NewDelayedInformer[*gwxv1a1.XBackendTrafficPolicy](commoncol.Client, gvr.XBackendTrafficPolicy, kubetypes.StandardInformer, kubetypes.Filter{ObjectFilter: commoncol.Client.ObjectFilter()}), commoncol.KrtOpts.ToOptions("informer/XBackendTrafficPolicies")...),
 
 		// inference extensions need to be enabled so control plane has permissions to watch resource. Disable by default
 		InferencePools: krt.NewStaticCollection[*inf.InferencePool](nil, nil, commoncol.KrtOpts.ToOptions("disable/inferencepools")...),
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -38,9 +39,20 @@ const (
 	extauthPolicySuffix         = ":extauth"
 	aiPolicySuffix              = ":ai"
 	rbacPolicySuffix            = ":rbac"
+	mcpAuthorizationSuffix      = ":mcp-authorization"
 	localRateLimitPolicySuffix  = ":rl-local"
 	globalRateLimitPolicySuffix = ":rl-global"
-	transformationPolicySuffix  = ":transformation"
+
+	localTokenRateLimitPolicySuffix  = ":rl-token-local"
+	globalTokenRateLimitPolicySuffix = ":rl-token-global"
+	transformationPolicySuffix       = ":transformation"
+)
+
+// Patterns of the PII entities of the prompt guards that are matched with regexes by agentgateway,
+// kept in sync with the API_KEY and IBAN recognizers of the AI extension.
+const (
+	piiIBANPattern   = `\b[A-Z]{2}[0-9]{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`
+	piiAPIKeyPattern = `\b(?:sk-[A-Za-z0-9_-]{20,}|AKIA[0-9A-Z]{16}|gh[pousr]_[A-Za-z0-9]{36}|xox[abprs]-[A-Za-z0-9-]{10,}|sk_live_[0-9A-Za-z]{24,}|AIza[0-9A-Za-z_-]{35})\b|-----BEGIN [A-Z ]*PRIVATE KEY-----`
 )
 
 var logger = logging.New("agentgateway/plugins")
@@ -107,10 +119,10 @@ func TranslateTrafficPolicy(
 ) (*gwv1.PolicyStatus, []AgwPolicy) {
 	var agwPolicies []AgwPolicy
 
-	isMcpTarget := false
 	var ancestors []gwv1.PolicyAncestorStatus
 	for _, target := range trafficPolicy.Spec.TargetRefs {
 		var policyTarget *api.PolicyTarget
+		var mcpBackend *v1alpha1.MCP
 		// Build a base ParentReference for status
 		parentRef := gwv1.ParentReference{
 			Name:      gwv1.ObjectName(target.Name),
@@ -192,7 +204,7 @@ func TranslateTrafficPolicy(
 			}
 			backendSpec := (*backend).Spec
 			if backendSpec.Type == v1alpha1.BackendTypeMCP {
-				isMcpTarget = true
+				mcpBackend = backendSpec.MCP
 				policyTarget = &api.PolicyTarget{
 					Kind: &api.PolicyTarget_Backend{
 						Backend: trafficPolicy.Namespace + "/" + string(target.Name),
@@ -211,27 +223,9 @@ func TranslateTrafficPolicy(
 		}
 
 		if policyTarget != nil {
-			translatedPolicies, err := translateTrafficPolicyToAgw(ctx, gatewayExtensions, secrets, trafficPolicy, string(target.Name), policyTarget, isMcpTarget)
+			translatedPolicies, fieldStatuses, err := translateTrafficPolicyToAgw(ctx, gatewayExtensions, secrets, trafficPolicy, string(target.Name), policyTarget, mcpBackend)
 			agwPolicies = append(agwPolicies, translatedPolicies...)
-			var conds []metav1.Condition
-			// TODO: support partial translation statuses https://github.com/kgateway-dev/kgateway/issues/12413
-			if err != nil {
-				// Build success conditions per ancestor
-				meta.SetStatusCondition(&conds, metav1.Condition{
-					Type:    string(v1alpha1.PolicyConditionAccepted),
-					Status:  metav1.ConditionFalse,
-					Reason:  string(v1alpha1.PolicyReasonInvalid),
-					Message: err.Error(),
-				})
-			} else {
-				// Build success conditions per ancestor
-				meta.SetStatusCondition(&conds, metav1.Condition{
-					Type:    string(v1alpha1.PolicyConditionAccepted),
-					Status:  metav1.ConditionTrue,
-					Reason:  string(v1alpha1.PolicyReasonValid),
-					Message: reporter.PolicyAcceptedMsg,
-				})
-			}
+			conds := trafficPolicyAcceptedConditions(trafficPolicy, len(translatedPolicies) > 0, fieldStatuses, err)
 			// TODO: validate the target exists with dataplane https://github.com/kgateway-dev/kgateway/issues/12275
 			meta.SetStatusCondition(&conds, metav1.Condition{
 				Type:    string(v1alpha1.PolicyConditionAttached),
@@ -289,7 +283,51 @@ func TranslateTrafficPolicy(
 	return &status, agwPolicies
 }
 
-// translateTrafficPolicyToAgw converts a TrafficPolicy to agentgateway Policy resources
+// trafficPolicyAcceptedConditions returns the Accepted condition of a translated TrafficPolicy, along with the
+// PartiallyAccepted condition reporting the fields that are not applied when at least part of the policy is
+// applied. A policy with none of its fields applied is not accepted.
+func trafficPolicyAcceptedConditions(
+	trafficPolicy *v1alpha1.TrafficPolicy,
+	translated bool,
+	fieldStatuses []reporter.PolicyFieldStatus,
+	err error,
+) []metav1.Condition {
+	var conds []metav1.Condition
+	if !translated && (err != nil || len(fieldStatuses) > 0) {
+		msgs := make([]string, 0, len(fieldStatuses))
+		for _, f := range fieldStatuses {
+			msgs = append(msgs, f.String())
+		}
+		meta.SetStatusCondition(&conds, metav1.Condition{
+			Type:    string(v1alpha1.PolicyConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(v1alpha1.PolicyReasonInvalid),
+			Message: "Policy not accepted, none of the fields are applied: " + strings.Join(msgs, "; "),
+		})
+		return conds
+	}
+
+	meta.SetStatusCondition(&conds, metav1.Condition{
+		Type:    string(v1alpha1.PolicyConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(v1alpha1.PolicyReasonValid),
+		Message: reporter.PolicyAcceptedMsg,
+	})
+	if len(fieldStatuses) > 0 {
+		partiallyAccepted := reporter.PartiallyAcceptedCondition(fieldStatuses, trafficPolicy.Generation)
+		meta.SetStatusCondition(&conds, metav1.Condition{
+			Type:    partiallyAccepted.Type,
+			Status:  partiallyAccepted.Status,
+			Reason:  partiallyAccepted.Reason,
+			Message: partiallyAccepted.Message,
+		})
+	}
+	return conds
+}
+
+// translateTrafficPolicyToAgw converts a TrafficPolicy to agentgateway Policy resources.
+// It also returns the status of the fields that are not applied, either because they are invalid
+// or because they are not supported by agentgateway.
 func translateTrafficPolicyToAgw(
 	ctx krt.HandlerContext,
 	gatewayExtensions krt.Collection[*v1alpha1.GatewayExtension],
@@ -297,49 +335,85 @@ func translateTrafficPolicyToAgw(
 	trafficPolicy *v1alpha1.TrafficPolicy,
 	policyTargetName string,
 	policyTarget *api.PolicyTarget,
-	isMcpTarget bool,
-) ([]AgwPolicy, error) {
+	mcpBackend *v1alpha1.MCP,
+) ([]AgwPolicy, []reporter.PolicyFieldStatus, error) {
+	isMcpTarget := mcpBackend != nil
 	agwPolicies := make([]AgwPolicy, 0)
 	var errs []error
+	var fieldStatuses []reporter.PolicyFieldStatus
+	invalidField := func(field string, err error) {
+		errs = append(errs, err)
+		fieldStatuses = append(fieldStatuses, reporter.PolicyFieldStatus{
+			Field:   field,
+			Reason:  reporter.PolicyFieldReasonInvalid,
+			Message: err.Error(),
+		})
+	}
 
 	// Generate a base policy name from the TrafficPolicy reference
 	policyName := getTrafficPolicyName(trafficPolicy.Namespace, trafficPolicy.Name, policyTargetName)
 
-	// Convert ExtAuth policy if present
+	// Fields in shadow mode are not translated since agentgateway would enforce them,
+	// they are reported as ignored instead.
+	shadowMode := trafficPolicy.Spec.ShadowMode
+
+	// Convert ExtAuth policy if present, which does not support shadow mode
 	if trafficPolicy.Spec.ExtAuth != nil && trafficPolicy.Spec.ExtAuth.ExtensionRef != nil {
-		extAuthPolicies, err := processExtAuthPolicy(ctx, gatewayExtensions, trafficPolicy, policyName, policyTarget)
-		if err != nil {
-			logger.Error("error processing ExtAuth policy", "error", err)
-			errs = append(errs, err)
+		if shadowMode.ShadowsAll() {
+			invalidField("extAuth", errors.New("extAuth does not support shadow mode, list the fields evaluated in shadow mode instead"))
+		} else {
+			extAuthPolicies, err := processExtAuthPolicy(ctx, gatewayExtensions, trafficPolicy, policyName, policyTarget)
+			if err != nil {
+				logger.Error("error processing ExtAuth policy", "error", err)
+				invalidField("extAuth", err)
+			}
+			agwPolicies = append(agwPolicies, extAuthPolicies...)
 		}
-		agwPolicies = append(agwPolicies, extAuthPolicies...)
 	}
 
 	// Convert RBAC policy if present
-	if trafficPolicy.Spec.RBAC != nil {
+	if trafficPolicy.Spec.RBAC != nil && !shadowMode.Shadows(v1alpha1.ShadowModeFieldRBAC) {
 		rbacPolicies, err := processRBACPolicy(trafficPolicy, policyName, policyTarget, isMcpTarget)
 		if err != nil {
 			logger.Error("error processing RBAC policy", "error", err)
-			errs = append(errs, err)
+			invalidField("rbac", err)
 		}
 		agwPolicies = append(agwPolicies, rbacPolicies...)
 	}
 
+	// Convert MCP policy if present, which only applies to MCP backends
+	if trafficPolicy.Spec.MCP != nil {
+		if isMcpTarget {
+			mcpPolicies, err := processMCPPolicy(trafficPolicy, policyName, policyTarget, mcpBackend)
+			if err != nil {
+				logger.Error("error processing MCP policy", "error", err)
+				invalidField("mcp", err)
+			}
+			agwPolicies = append(agwPolicies, mcpPolicies...)
+		} else {
+			fieldStatuses = append(fieldStatuses, reporter.PolicyFieldStatus{
+				Field:   "mcp",
+				Reason:  reporter.PolicyFieldReasonIgnored,
+				Message: "only supported when targeting an MCP Backend",
+			})
+		}
+	}
+
 	// Process AI policies if present
 	if trafficPolicy.Spec.AI != nil {
-		aiPolicies, err := processAIPolicy(ctx, secrets, trafficPolicy, policyName, policyTarget)
+		aiPolicies, err := processAIPolicy(ctx, secrets, gatewayExtensions, trafficPolicy, policyName, policyTarget)
 		if err != nil {
 			logger.Error("error processing AI policy", "error", err)
-			errs = append(errs, err)
+			invalidField("ai", err)
 		}
 		agwPolicies = append(agwPolicies, aiPolicies...)
 	}
 	// Process RateLimit policies if present
-	if trafficPolicy.Spec.RateLimit != nil {
+	if trafficPolicy.Spec.RateLimit != nil && !shadowMode.Shadows(v1alpha1.ShadowModeFieldRateLimit) {
 		rateLimitPolicies, err := processRateLimitPolicy(ctx, gatewayExtensions, trafficPolicy, policyName, policyTarget)
 		if err != nil {
 			logger.Error("error processing rate limit policy", "error", err)
-			errs = append(errs, err)
+			invalidField("rateLimit", err)
 		}
 		agwPolicies = append(agwPolicies, rateLimitPolicies...)
 	}
@@ -349,12 +423,79 @@ func translateTrafficPolicyToAgw(
 		transformationPolicies, err := processTransformationPolicy(trafficPolicy, policyName, policyTarget)
 		if err != nil {
 			logger.Error("error processing transformation policy", "error", err)
-			errs = append(errs, err)
+			invalidField("transformation", err)
 		}
 		agwPolicies = append(agwPolicies, transformationPolicies...)
 	}
 
-	return agwPolicies, errors.Join(errs...)
+	fieldStatuses = append(fieldStatuses, unsupportedTrafficPolicyFields(trafficPolicy)...)
+
+	return agwPolicies, fieldStatuses, errors.Join(errs...)
+}
+
+// unsupportedTrafficPolicyFields returns the status of the TrafficPolicy fields that are set
+// but not supported by agentgateway, and are therefore ignored
+func unsupportedTrafficPolicyFields(trafficPolicy *v1alpha1.TrafficPolicy) []reporter.PolicyFieldStatus {
+	spec := trafficPolicy.Spec
+	var out []reporter.PolicyFieldStatus
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"extProc", spec.ExtProc != nil},
+		{"cors", spec.Cors != nil},
+		{"csrf", spec.Csrf != nil},
+		{"headerModifiers", spec.HeaderModifiers != nil},
+		{"autoHostRewrite", spec.AutoHostRewrite != nil},
+		{"buffer", spec.Buffer != nil},
+		{"timeouts", spec.Timeouts != nil},
+		{"retry", spec.Retry != nil},
+		{"waf", spec.WAF != nil},
+		{"destinationRuleSubset", spec.DestinationRuleSubset != nil},
+	} {
+		if f.set {
+			out = append(out, reporter.PolicyFieldStatus{
+				Field:   f.name,
+				Reason:  reporter.PolicyFieldReasonIgnored,
+				Message: "not supported by agentgateway",
+			})
+		}
+	}
+	for _, f := range []struct {
+		name  string
+		field v1alpha1.ShadowModeField
+		set   bool
+	}{
+		{"rbac", v1alpha1.ShadowModeFieldRBAC, spec.RBAC != nil},
+		{"rateLimit", v1alpha1.ShadowModeFieldRateLimit, spec.RateLimit != nil},
+	} {
+		if f.set && spec.ShadowMode.Shadows(f.field) {
+			out = append(out, reporter.PolicyFieldStatus{
+				Field:   f.name,
+				Reason:  reporter.PolicyFieldReasonIgnored,
+				Message: "shadow mode is not supported by agentgateway, the field is not enforced",
+			})
+		}
+	}
+	if spec.AI != nil {
+		out = append(out, unsupportedModelRoutingFields(spec.AI.ModelRouting)...)
+		if pg := spec.AI.PromptGuard; pg != nil {
+			if pg.Request != nil {
+				out = append(out, unsupportedPIIFields("ai.promptGuard.request.pii", pg.Request.Regex, pg.Request.PII)...)
+			}
+			if pg.Response != nil {
+				out = append(out, unsupportedPIIFields("ai.promptGuard.response.pii", pg.Response.Regex, pg.Response.PII)...)
+			}
+		}
+		if spec.AI.SemanticCache != nil {
+			out = append(out, reporter.PolicyFieldStatus{
+				Field:   "ai.semanticCache",
+				Reason:  reporter.PolicyFieldReasonIgnored,
+				Message: "not supported by agentgateway",
+			})
+		}
+	}
+	return out
 }
 
 // processExtAuthPolicy processes ExtAuth configuration and creates corresponding agentgateway policies
@@ -437,7 +578,14 @@ func processExtAuthPolicy(ctx krt.HandlerContext, gatewayExtensions krt.Collecti
 }
 
 // processAIPolicy processes AI configuration and creates corresponding Agw policies
-func processAIPolicy(krtctx krt.HandlerContext, secrets krt.Collection[*corev1.Secret], trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) ([]AgwPolicy, error) {
+func processAIPolicy(
+	krtctx krt.HandlerContext,
+	secrets krt.Collection[*corev1.Secret],
+	gatewayExtensions krt.Collection[*v1alpha1.GatewayExtension],
+	trafficPolicy *v1alpha1.TrafficPolicy,
+	policyName string,
+	policyTarget *api.PolicyTarget,
+) ([]AgwPolicy, error) {
 	var errs []error
 	aiSpec := trafficPolicy.Spec.AI
 
@@ -488,11 +636,79 @@ func processAIPolicy(krtctx krt.HandlerContext, secrets krt.Collection[*corev1.S
 		}
 	}
 
+	if aiSpec.ModelRouting != nil {
+		aiPolicy.GetSpec().GetAi().ModelAliases = processModelRouting(aiSpec.ModelRouting)
+	}
+
 	logger.Debug("generated AI policy",
 		"policy", trafficPolicy.Name,
 		"agentgateway_policy", aiPolicy.Name)
 
-	return []AgwPolicy{{Policy: aiPolicy}}, errors.Join(errs...)
+	agwPolicies := []AgwPolicy{{Policy: aiPolicy}}
+	if aiSpec.TokenRateLimit != nil {
+		tokenRateLimitPolicies, err := processTokenRateLimitPolicy(krtctx, gatewayExtensions, trafficPolicy, policyName, policyTarget)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		agwPolicies = append(agwPolicies, tokenRateLimitPolicies...)
+	}
+
+	return agwPolicies, errors.Join(errs...)
+}
+
+// processModelRouting translates the model routing to the model aliases of agentgateway, which rewrite
+// the requested model names. The routes that agentgateway does not support are reported as ignored
+// by unsupportedModelRoutingFields.
+func processModelRouting(mr *v1alpha1.AIModelRouting) map[string]string {
+	var modelAliases map[string]string
+	for _, route := range mr.Routes {
+		if route.Provider != nil || route.Model == nil {
+			continue
+		}
+		for _, model := range route.Models {
+			// the first route matching a model is used
+			if _, ok := modelAliases[model]; ok || strings.Contains(model, "*") {
+				continue
+			}
+			if modelAliases == nil {
+				modelAliases = make(map[string]string)
+			}
+			modelAliases[model] = *route.Model
+		}
+	}
+	return modelAliases
+}
+
+// unsupportedModelRoutingFields returns the statuses of the model routing fields that agentgateway does not support.
+// Agentgateway rewrites model names, but does not select providers based on the model nor match wildcard model names.
+func unsupportedModelRoutingFields(mr *v1alpha1.AIModelRouting) []reporter.PolicyFieldStatus {
+	if mr == nil {
+		return nil
+	}
+	var out []reporter.PolicyFieldStatus
+	ignored := func(field string) {
+		out = append(out, reporter.PolicyFieldStatus{
+			Field:   field,
+			Reason:  reporter.PolicyFieldReasonIgnored,
+			Message: "not supported by agentgateway",
+		})
+	}
+	for i, route := range mr.Routes {
+		if route.Provider != nil {
+			// the model rewrite of the route is specific to the provider, so the whole route is ignored
+			ignored(fmt.Sprintf("ai.modelRouting.routes[%d]", i))
+			continue
+		}
+		for j, model := range route.Models {
+			if strings.Contains(model, "*") {
+				ignored(fmt.Sprintf("ai.modelRouting.routes[%d].models[%d]", i, j))
+			}
+		}
+	}
+	if mr.UnknownModelResponse != nil {
+		ignored("ai.modelRouting.unknownModelResponse")
+	}
+	return out
 }
 
 func processRequestGuard(krtctx krt.HandlerContext, secrets krt.Collection[*corev1.Secret], namespace string, req *v1alpha1.PromptguardRequest) *api.PolicySpec_Ai_RequestGuard {
@@ -502,7 +718,7 @@ func processRequestGuard(krtctx krt.HandlerContext, secrets krt.Collection[*core
 
 	pgReq := &api.PolicySpec_Ai_RequestGuard{
 		Webhook:          processWebhook(req.Webhook),
-		Regex:            processRegex(req.Regex, req.CustomResponse),
+		Regex:            processGuardRegex(req.Regex, req.PII, req.CustomResponse),
 		OpenaiModeration: processModeration(krtctx, secrets, namespace, req.Moderation),
 	}
 
@@ -519,7 +735,7 @@ func processRequestGuard(krtctx krt.HandlerContext, secrets krt.Collection[*core
 func processResponseGuard(resp *v1alpha1.PromptguardResponse) *api.PolicySpec_Ai_ResponseGuard {
 	return &api.PolicySpec_Ai_ResponseGuard{
 		Webhook: processWebhook(resp.Webhook),
-		Regex:   processRegex(resp.Regex, nil),
+		Regex:   processGuardRegex(resp.Regex, resp.PII, nil),
 	}
 }
 
@@ -656,6 +872,103 @@ func processRegex(regex *v1alpha1.Regex, customResponse *v1alpha1.CustomResponse
 	return rules
 }
 
+// processGuardRegex translates the regexes and the PII detection of a prompt guard to the regex rules of
+// agentgateway, which applies a single action to all the rules of the guard.
+func processGuardRegex(regex *v1alpha1.Regex, pii *v1alpha1.PIIDetection, customResponse *v1alpha1.CustomResponse) *api.PolicySpec_Ai_RegexRules {
+	if pii == nil {
+		return processRegex(regex, customResponse)
+	}
+	merged := &v1alpha1.Regex{
+		Action: ptr.To(piiRegexAction(regex, pii)),
+	}
+	if regex != nil {
+		merged.Matches = regex.Matches
+		merged.Builtins = regex.Builtins
+	}
+	rules := processRegex(merged, customResponse)
+	for _, entity := range pii.Entities {
+		switch entity.Type {
+		case v1alpha1.PIIEntityNationalID:
+			rules.Rules = append(rules.Rules, processBuiltinRegexRule(v1alpha1.SSN, logger))
+		case v1alpha1.PIIEntityIBAN:
+			rules.Rules = append(rules.Rules, processNamedRegexRule(piiIBANPattern, string(entity.Type)))
+		case v1alpha1.PIIEntityAPIKey:
+			rules.Rules = append(rules.Rules, processNamedRegexRule(piiAPIKeyPattern, string(entity.Type)))
+		}
+	}
+	return rules
+}
+
+// piiRegexAction returns the action applied to the regexes and the PII entities of a prompt guard,
+// which is the strictest action of the regexes and of the entities detected by agentgateway, so that
+// a rejecting entity or regex is never downgraded to masking.
+func piiRegexAction(regex *v1alpha1.Regex, pii *v1alpha1.PIIDetection) v1alpha1.Action {
+	if regex != nil && ptr.Deref(regex.Action, v1alpha1.MASK) == v1alpha1.REJECT {
+		return v1alpha1.REJECT
+	}
+	for _, entity := range pii.Entities {
+		if !piiEntitySupported(entity.Type) {
+			continue
+		}
+		if ptr.Deref(entity.Action, v1alpha1.PIIActionMask) == v1alpha1.PIIActionReject {
+			return v1alpha1.REJECT
+		}
+	}
+	return v1alpha1.MASK
+}
+
+// piiEntitySupported returns whether agentgateway detects the PII entity type.
+func piiEntitySupported(entityType v1alpha1.PIIEntityType) bool {
+	switch entityType {
+	case v1alpha1.PIIEntityPerson, v1alpha1.PIIEntityLocation:
+		return false
+	default:
+		return true
+	}
+}
+
+// unsupportedPIIFields returns the status of the PII entities that are not detected by agentgateway,
+// and of the entity and regex actions that differ from the action applied to the prompt guard.
+func unsupportedPIIFields(field string, regex *v1alpha1.Regex, pii *v1alpha1.PIIDetection) []reporter.PolicyFieldStatus {
+	if pii == nil {
+		return nil
+	}
+	var out []reporter.PolicyFieldStatus
+	action := piiRegexAction(regex, pii)
+	if regex != nil && regex.Action != nil && *regex.Action != action {
+		out = append(out, reporter.PolicyFieldStatus{
+			Field:   strings.TrimSuffix(field, ".pii") + ".regex.action",
+			Reason:  reporter.PolicyFieldReasonIgnored,
+			Message: fmt.Sprintf("a single action applies to the regexes and the PII entities with agentgateway, the %s action is applied", action),
+		})
+	}
+	for i, entity := range pii.Entities {
+		if !piiEntitySupported(entity.Type) {
+			out = append(out, reporter.PolicyFieldStatus{
+				Field:   fmt.Sprintf("%s.entities[%d]", field, i),
+				Reason:  reporter.PolicyFieldReasonIgnored,
+				Message: "not supported by agentgateway",
+			})
+			continue
+		}
+		if entity.Type == v1alpha1.PIIEntityNationalID {
+			out = append(out, reporter.PolicyFieldStatus{
+				Field:   fmt.Sprintf("%s.entities[%d].type", field, i),
+				Reason:  reporter.PolicyFieldReasonIgnored,
+				Message: "agentgateway only matches US Social Security numbers, without validating their checksums",
+			})
+		}
+		if string(ptr.Deref(entity.Action, v1alpha1.PIIActionMask)) != string(action) {
+			out = append(out, reporter.PolicyFieldStatus{
+				Field:   fmt.Sprintf("%s.entities[%d].action", field, i),
+				Reason:  reporter.PolicyFieldReasonIgnored,
+				Message: fmt.Sprintf("per-entity actions are not supported by agentgateway, the %s action is applied", action),
+			})
+		}
+	}
+	return out
+}
+
 func processModeration(krtctx krt.HandlerContext, secrets krt.Collection[*corev1.Secret], namespace string, moderation *v1alpha1.Moderation) *api.PolicySpec_Ai_Moderation {
 	// right now we only support OpenAI moderation, so we can return nil if the moderation is nil or the OpenAIModeration is nil
 	if moderation == nil || moderation.OpenAIModeration == nil {
@@ -766,6 +1079,161 @@ func processRBACPolicy(
 	return []AgwPolicy{{Policy: rbacPolicy}}, nil
 }
 
+// processMCPPolicy processes the MCP authorization rules and creates the corresponding agentgateway policy.
+// agentgateway also applies the MCP authorization to filter the items of the list responses.
+func processMCPPolicy(
+	trafficPolicy *v1alpha1.TrafficPolicy,
+	policyName string,
+	policyTarget *api.PolicyTarget,
+	mcpBackend *v1alpha1.MCP,
+) ([]AgwPolicy, error) {
+	rules := trafficPolicy.Spec.MCP.Authorization
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	// The rules select the targets by their name on the Backend, while agentgateway names the
+	// static targets by their tool prefix
+	targetNames := map[string]string{}
+	for _, target := range mcpBackend.Targets {
+		if target.Static != nil {
+			targetNames[string(target.Name)] = utils.InternalMCPStaticTargetName(target)
+		}
+	}
+
+	// An invalid rule fails the whole policy, as skipping a Deny rule would grant access
+	var allowPolicies, denyPolicies []string
+	var errs []error
+	for i, rule := range rules {
+		expr, err := mcpAuthorizationRuleExpr(rule, targetNames)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("authorization[%d]: %w", i, err))
+			continue
+		}
+		if rule.Action == v1alpha1.AuthorizationPolicyActionDeny {
+			denyPolicies = append(denyPolicies, expr)
+		} else {
+			allowPolicies = append(allowPolicies, expr)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	mcpPolicy := &api.Policy{
+		Name:   policyName + mcpAuthorizationSuffix + attachmentName(policyTarget),
+		Target: policyTarget,
+		Spec: &api.PolicySpec{
+			Kind: &api.PolicySpec_McpAuthorization{
+				McpAuthorization: &api.PolicySpec_RBAC{
+					Allow: allowPolicies,
+					Deny:  denyPolicies,
+				},
+			},
+		},
+	}
+
+	logger.Debug("generated MCP authorization policy",
+		"policy", trafficPolicy.Name,
+		"agentgateway_policy", mcpPolicy.Name,
+		"target", policyTarget)
+
+	return []AgwPolicy{{Policy: mcpPolicy}}, nil
+}
+
+// mcpAuthorizationRuleExpr returns the CEL expression matching the MCP items and the callers of the rule.
+// targetNames maps the names of the targets on the Backend to the names of the agentgateway targets.
+func mcpAuthorizationRuleExpr(rule v1alpha1.MCPAuthorizationRule, targetNames map[string]string) (string, error) {
+	var items []string
+	for _, kind := range []struct {
+		name    string
+		matches []v1alpha1.MCPNameMatch
+	}{
+		{"tool", rule.Tools},
+		{"prompt", rule.Prompts},
+		{"resource", rule.Resources},
+	} {
+		if len(kind.matches) == 0 {
+			continue
+		}
+		names := make([]string, 0, len(kind.matches))
+		for _, m := range kind.matches {
+			expr, err := celMCPNameExpr(fmt.Sprintf("mcp.%s.name", kind.name), m)
+			if err != nil {
+				return "", err
+			}
+			names = append(names, expr)
+		}
+		item := fmt.Sprintf("has(mcp.%s) && (%s)", kind.name, strings.Join(names, " || "))
+		if rule.Target != nil {
+			target := string(*rule.Target)
+			if name, ok := targetNames[target]; ok {
+				target = name
+			}
+			item += fmt.Sprintf(" && mcp.%s.target == %q", kind.name, target)
+		}
+		items = append(items, "("+item+")")
+	}
+	expr := strings.Join(items, " || ")
+
+	if rule.From == nil {
+		return expr, nil
+	}
+	conds := []string{"(" + expr + ")"}
+	for _, claim := range rule.From.Claims {
+		conds = append(conds, celJWTClaimMatchExpr(claim))
+	}
+	for _, claim := range rule.From.NotClaims {
+		conds = append(conds, "!"+celJWTClaimMatchExpr(claim))
+	}
+	for _, header := range rule.From.Headers {
+		expr, err := celHeaderMatchExpr(header)
+		if err != nil {
+			return "", err
+		}
+		conds = append(conds, expr)
+	}
+	return strings.Join(conds, " && "), nil
+}
+
+// celMCPNameExpr returns a CEL expression matching the name of an MCP item.
+func celMCPNameExpr(field string, m v1alpha1.MCPNameMatch) (string, error) {
+	if ptr.Deref(m.Type, v1alpha1.MCPNameMatchExact) == v1alpha1.MCPNameMatchExact {
+		return fmt.Sprintf("%s == %q", field, m.Value), nil
+	}
+	if _, err := regexp.Compile(m.Value); err != nil {
+		return "", fmt.Errorf("invalid regular expression %q: %w", m.Value, err)
+	}
+	// matches() finds the regular expression anywhere in the string, anchor it to match the whole name
+	return fmt.Sprintf("%s.matches(%q)", field, "^(?:"+m.Value+")$"), nil
+}
+
+// celJWTClaimMatchExpr returns a CEL expression matching a claim of the validated JWT equal to,
+// or for a list claim containing, one of the values.
+func celJWTClaimMatchExpr(claim v1alpha1.MCPClaimMatch) string {
+	values := make([]string, 0, len(claim.Values))
+	for _, v := range claim.Values {
+		values = append(values, strconv.Quote(v))
+	}
+	list := "[" + strings.Join(values, ", ") + "]"
+	expr := celJWTClaimExpr(claim.Name)
+	return fmt.Sprintf("(%q in jwt && (type(%s) == list ? %s.exists(v, v in %s) : %s in %s))",
+		claim.Name, expr, expr, list, expr, list)
+}
+
+// celHeaderMatchExpr returns a CEL expression matching a request header.
+func celHeaderMatchExpr(header gwv1.HTTPHeaderMatch) (string, error) {
+	name := strings.ToLower(string(header.Name))
+	expr := celHeaderExpr(name)
+	if ptr.Deref(header.Type, gwv1.HeaderMatchExact) == gwv1.HeaderMatchExact {
+		return fmt.Sprintf("(%q in request.headers && %s == %q)", name, expr, header.Value), nil
+	}
+	if _, err := regexp.Compile(header.Value); err != nil {
+		return "", fmt.Errorf("invalid regular expression %q: %w", header.Value, err)
+	}
+	return fmt.Sprintf("(%q in request.headers && %s.matches(%q))", name, expr, header.Value), nil
+}
+
 func getTrafficPolicyName(trafficPolicyNs, trafficPolicyName, policyTargetName string) string {
 	return fmt.Sprintf("trafficpolicy/%s/%s/%s", trafficPolicyNs, trafficPolicyName, policyTargetName)
 }
@@ -780,11 +1248,47 @@ func getGatewayExtensionKey(extensionNamespace, extensionName string) string {
 
 // processRateLimitPolicy processes RateLimit configuration and creates corresponding agentgateway policies
 func processRateLimitPolicy(ctx krt.HandlerContext, gatewayExtensions krt.Collection[*v1alpha1.GatewayExtension], trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) ([]AgwPolicy, error) {
+	return processRateLimit(ctx, gatewayExtensions, trafficPolicy.Spec.RateLimit, trafficPolicy.Namespace, rateLimitTranslation{
+		localName:  policyName + localRateLimitPolicySuffix + attachmentName(policyTarget),
+		globalName: policyName + globalRateLimitPolicySuffix + attachmentName(policyTarget),
+		localType:  api.PolicySpec_LocalRateLimit_REQUEST,
+		globalType: api.PolicySpec_RemoteRateLimit_REQUESTS,
+	}, policyTarget)
+}
+
+// processTokenRateLimitPolicy processes the token rate limit of the AI policy. The rate limits count the
+// LLM tokens of the requests, which agentgateway parses from the responses of the LLM provider.
+func processTokenRateLimitPolicy(ctx krt.HandlerContext, gatewayExtensions krt.Collection[*v1alpha1.GatewayExtension], trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) ([]AgwPolicy, error) {
+	return processRateLimit(ctx, gatewayExtensions, trafficPolicy.Spec.AI.TokenRateLimit, trafficPolicy.Namespace, rateLimitTranslation{
+		localName:  policyName + localTokenRateLimitPolicySuffix + attachmentName(policyTarget),
+		globalName: policyName + globalTokenRateLimitPolicySuffix + attachmentName(policyTarget),
+		localType:  api.PolicySpec_LocalRateLimit_TOKEN,
+		globalType: api.PolicySpec_RemoteRateLimit_TOKENS,
+	}, policyTarget)
+}
+
+// rateLimitTranslation holds the names of the agentgateway policies a RateLimit translates to,
+// and whether the rate limits count requests or tokens.
+type rateLimitTranslation struct {
+	localName  string
+	globalName string
+	localType  api.PolicySpec_LocalRateLimit_Type
+	globalType api.PolicySpec_RemoteRateLimit_Type
+}
+
+func processRateLimit(
+	ctx krt.HandlerContext,
+	gatewayExtensions krt.Collection[*v1alpha1.GatewayExtension],
+	rateLimit *v1alpha1.RateLimit,
+	namespace string,
+	translation rateLimitTranslation,
+	policyTarget *api.PolicyTarget,
+) ([]AgwPolicy, error) {
 	var agwPolicies []AgwPolicy
 
 	// Process local rate limiting if present
-	if trafficPolicy.Spec.RateLimit.Local != nil {
-		localPolicy, err := processLocalRateLimitPolicy(trafficPolicy, policyName, policyTarget)
+	if rateLimit.Local != nil {
+		localPolicy, err := processLocalRateLimitPolicy(rateLimit.Local, translation.localName, translation.localType, policyTarget)
 		if localPolicy != nil && err == nil {
 			agwPolicies = append(agwPolicies, *localPolicy)
 		} else {
@@ -793,8 +1297,8 @@ func processRateLimitPolicy(ctx krt.HandlerContext, gatewayExtensions krt.Collec
 	}
 
 	// Process global rate limiting if present
-	if trafficPolicy.Spec.RateLimit.Global != nil {
-		globalPolicy, err := processGlobalRateLimitPolicy(ctx, gatewayExtensions, trafficPolicy, policyName, policyTarget)
+	if rateLimit.Global != nil {
+		globalPolicy, err := processGlobalRateLimitPolicy(ctx, gatewayExtensions, rateLimit.Global, namespace, translation.globalName, translation.globalType, policyTarget)
 		if globalPolicy != nil && err == nil {
 			agwPolicies = append(agwPolicies, *globalPolicy)
 		} else {
@@ -806,13 +1310,13 @@ func processRateLimitPolicy(ctx krt.HandlerContext, gatewayExtensions krt.Collec
 }
 
 // processLocalRateLimitPolicy processes local rate limiting configuration
-func processLocalRateLimitPolicy(trafficPolicy *v1alpha1.TrafficPolicy, policyName string, policyTarget *api.PolicyTarget) (*AgwPolicy, error) {
-	if trafficPolicy.Spec.RateLimit.Local.TokenBucket == nil {
+func processLocalRateLimitPolicy(local *v1alpha1.LocalRateLimitPolicy, name string, limitType api.PolicySpec_LocalRateLimit_Type, policyTarget *api.PolicyTarget) (*AgwPolicy, error) {
+	if local.TokenBucket == nil {
 		logger.Error("token bucket configuration is nil")
 		return nil, errors.New("token bucket configuration is nil")
 	}
 
-	tokenBucket := trafficPolicy.Spec.RateLimit.Local.TokenBucket
+	tokenBucket := local.TokenBucket
 
 	// Validate configuration
 	if tokenBucket.MaxTokens <= 0 {
@@ -831,7 +1335,7 @@ func processLocalRateLimitPolicy(trafficPolicy *v1alpha1.TrafficPolicy, policyNa
 	}
 
 	localRateLimitPolicy := &api.Policy{
-		Name:   policyName + localRateLimitPolicySuffix + attachmentName(policyTarget),
+		Name:   name,
 		Target: policyTarget,
 		Spec: &api.PolicySpec{
 			Kind: &api.PolicySpec_LocalRateLimit_{
@@ -839,7 +1343,7 @@ func processLocalRateLimitPolicy(trafficPolicy *v1alpha1.TrafficPolicy, policyNa
 					MaxTokens:     uint64(tokenBucket.MaxTokens),
 					TokensPerFill: tokensPerFill,
 					FillInterval:  &durationpb.Duration{Seconds: int64(fillIntervalSeconds)},
-					Type:          api.PolicySpec_LocalRateLimit_REQUEST,
+					Type:          limitType,
 				},
 			},
 		},
@@ -851,17 +1355,18 @@ func processLocalRateLimitPolicy(trafficPolicy *v1alpha1.TrafficPolicy, policyNa
 func processGlobalRateLimitPolicy(
 	ctx krt.HandlerContext,
 	gatewayExtensions krt.Collection[*v1alpha1.GatewayExtension],
-	trafficPolicy *v1alpha1.TrafficPolicy,
-	policyName string,
+	grl *v1alpha1.RateLimitPolicy,
+	namespace string,
+	name string,
+	descriptorType api.PolicySpec_RemoteRateLimit_Type,
 	policyTarget *api.PolicyTarget,
 ) (*AgwPolicy, error) {
-	grl := trafficPolicy.Spec.RateLimit.Global
 	if grl == nil {
 		return nil, nil
 	}
 
 	gwExt, err := lookupGatewayExtension(
-		ctx, gatewayExtensions, grl.ExtensionRef, trafficPolicy.Namespace, v1alpha1.GatewayExtensionTypeRateLimit,
+		ctx, gatewayExtensions, grl.ExtensionRef, namespace, v1alpha1.GatewayExtensionTypeRateLimit,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to lookup rate limit extension: %w", err)
@@ -873,7 +1378,7 @@ func processGlobalRateLimitPolicy(
 	}
 
 	// Build BackendReference for agentgateway (service/ns + port)
-	agwRef, err := buildAGWServiceRef(gwExt.Spec.RateLimit.GrpcService.BackendRef, trafficPolicy.Namespace)
+	agwRef, err := buildAGWServiceRef(gwExt.Spec.RateLimit.GrpcService.BackendRef, namespace)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build AGW service reference: %w", err)
 	}
@@ -881,14 +1386,14 @@ func processGlobalRateLimitPolicy(
 	// Translate descriptors
 	descriptors := make([]*api.PolicySpec_RemoteRateLimit_Descriptor, 0, len(grl.Descriptors))
 	for _, d := range grl.Descriptors {
-		if agw := processRateLimitDescriptor(d); agw != nil {
+		if agw := processRateLimitDescriptor(d, descriptorType); agw != nil {
 			descriptors = append(descriptors, agw)
 		}
 	}
 
 	// Build the RemoteRateLimit policy that agentgateway expects
 	p := &api.Policy{
-		Name:   policyName + globalRateLimitPolicySuffix + attachmentName(policyTarget),
+		Name:   name,
 		Target: policyTarget,
 		Spec: &api.PolicySpec{
 			Kind: &api.PolicySpec_RemoteRateLimit_{
@@ -919,6 +1424,10 @@ func getDescriptorEntryKey(entry v1alpha1.RateLimitDescriptorEntry) string {
 		return "remote_address"
 	case v1alpha1.RateLimitDescriptorEntryTypePath:
 		return "path"
+	case v1alpha1.RateLimitDescriptorEntryTypeJWTClaim:
+		if entry.JWTClaim != nil {
+			return *entry.JWTClaim
+		}
 	}
 	return ""
 }
@@ -966,7 +1475,7 @@ func buildServiceHost(backendRef *gwv1.BackendRef, defaultNamespace string) (ser
 	return serviceName, namespace, port, nil
 }
 
-func processRateLimitDescriptor(descriptor v1alpha1.RateLimitDescriptor) *api.PolicySpec_RemoteRateLimit_Descriptor {
+func processRateLimitDescriptor(descriptor v1alpha1.RateLimitDescriptor, descriptorType api.PolicySpec_RemoteRateLimit_Type) *api.PolicySpec_RemoteRateLimit_Descriptor {
 	if len(descriptor.Entries) == 0 {
 		return nil
 	}
@@ -992,6 +1501,10 @@ func processRateLimitDescriptor(descriptor v1alpha1.RateLimitDescriptor) *api.Po
 			value = celRemoteIPExpr()
 		case v1alpha1.RateLimitDescriptorEntryTypePath:
 			value = celPathExpr()
+		case v1alpha1.RateLimitDescriptorEntryTypeJWTClaim:
+			if entry.JWTClaim != nil {
+				value = celJWTClaimExpr(*entry.JWTClaim)
+			}
 		}
 		if key != "" && value != "" {
 			entries = append(entries, &api.PolicySpec_RemoteRateLimit_Entry{
@@ -1006,7 +1519,7 @@ func processRateLimitDescriptor(descriptor v1alpha1.RateLimitDescriptor) *api.Po
 	}
 	return &api.PolicySpec_RemoteRateLimit_Descriptor{
 		Entries: entries,
-		Type:    api.PolicySpec_RemoteRateLimit_REQUESTS,
+		Type:    descriptorType,
 	}
 }
 
@@ -1021,6 +1534,11 @@ func celRemoteIPExpr() string {
 	return "source.address"
 }
 
+// celJWTClaimExpr returns a CEL expression that reads a claim of the validated JWT.
+func celJWTClaimExpr(claim string) string {
+	return fmt.Sprintf(`jwt[%q]`, claim)
+}
+
 // celPathExpr returns a CEL expression for the request path (if supported in your env).
 func celPathExpr() string {
 	return "request.path"
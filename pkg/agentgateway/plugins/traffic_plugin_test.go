@@ -1,14 +1,19 @@
 package plugins
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/agentgateway/agentgateway/go/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 )
 
 func TestToJSONValue(t *testing.T) {
@@ -392,3 +397,254 @@ func TestProcessTransformationPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestUnsupportedTrafficPolicyFields(t *testing.T) {
+	tp := &v1alpha1.TrafficPolicy{
+		Spec: v1alpha1.TrafficPolicySpec{
+			Cors:           &v1alpha1.CorsPolicy{},
+			Timeouts:       &v1alpha1.Timeouts{},
+			Transformation: &v1alpha1.TransformationPolicy{},
+		},
+	}
+
+	got := unsupportedTrafficPolicyFields(tp)
+	assert.Equal(t, []reporter.PolicyFieldStatus{
+		{Field: "cors", Reason: reporter.PolicyFieldReasonIgnored, Message: "not supported by agentgateway"},
+		{Field: "timeouts", Reason: reporter.PolicyFieldReasonIgnored, Message: "not supported by agentgateway"},
+	}, got)
+
+	assert.Empty(t, unsupportedTrafficPolicyFields(&v1alpha1.TrafficPolicy{}))
+
+	shadow := &v1alpha1.TrafficPolicy{
+		Spec: v1alpha1.TrafficPolicySpec{
+			RBAC:      &v1alpha1.RBAC{},
+			RateLimit: &v1alpha1.RateLimit{},
+			ShadowMode: &v1alpha1.ShadowMode{
+				Fields: []v1alpha1.ShadowModeField{v1alpha1.ShadowModeFieldRBAC},
+			},
+		},
+	}
+	assert.Equal(t, []reporter.PolicyFieldStatus{
+		{Field: "rbac", Reason: reporter.PolicyFieldReasonIgnored, Message: "shadow mode is not supported by agentgateway, the field is not enforced"},
+	}, unsupportedTrafficPolicyFields(shadow))
+
+	semanticCache := &v1alpha1.TrafficPolicy{
+		Spec: v1alpha1.TrafficPolicySpec{
+			AI: &v1alpha1.AIPolicy{SemanticCache: &v1alpha1.AISemanticCache{}},
+		},
+	}
+	assert.Equal(t, []reporter.PolicyFieldStatus{
+		{Field: "ai.semanticCache", Reason: reporter.PolicyFieldReasonIgnored, Message: "not supported by agentgateway"},
+	}, unsupportedTrafficPolicyFields(semanticCache))
+}
+
+func TestProcessModelRouting(t *testing.T) {
+	mr := &v1alpha1.AIModelRouting{
+		Routes: []v1alpha1.AIModelRoute{
+			{Models: []string{"fast"}, Model: ptr.To("gpt-4o-mini")},
+			{Models: []string{"gpt-4o"}, Provider: ptr.To[gwv1.SectionName]("azure"), Model: ptr.To("gpt-4o-azure")},
+			{Models: []string{"fast", "smart", "gpt-5*"}, Model: ptr.To("gpt-4o")},
+			{Models: []string{"claude"}, Provider: ptr.To[gwv1.SectionName]("anthropic")},
+		},
+		UnknownModelResponse: &v1alpha1.AIUnknownModelResponse{},
+	}
+
+	assert.Equal(t, map[string]string{
+		"fast":  "gpt-4o-mini",
+		"smart": "gpt-4o",
+	}, processModelRouting(mr))
+
+	assert.Equal(t, []reporter.PolicyFieldStatus{
+		{Field: "ai.modelRouting.routes[1]", Reason: reporter.PolicyFieldReasonIgnored, Message: "not supported by agentgateway"},
+		{Field: "ai.modelRouting.routes[2].models[2]", Reason: reporter.PolicyFieldReasonIgnored, Message: "not supported by agentgateway"},
+		{Field: "ai.modelRouting.routes[3]", Reason: reporter.PolicyFieldReasonIgnored, Message: "not supported by agentgateway"},
+		{Field: "ai.modelRouting.unknownModelResponse", Reason: reporter.PolicyFieldReasonIgnored, Message: "not supported by agentgateway"},
+	}, unsupportedTrafficPolicyFields(&v1alpha1.TrafficPolicy{
+		Spec: v1alpha1.TrafficPolicySpec{AI: &v1alpha1.AIPolicy{ModelRouting: mr}},
+	}))
+
+	assert.Nil(t, processModelRouting(&v1alpha1.AIModelRouting{
+		Routes: []v1alpha1.AIModelRoute{{Models: []string{"gpt-*"}, Model: ptr.To("gpt-4o")}},
+	}))
+}
+
+func TestProcessGuardRegexPII(t *testing.T) {
+	pii := &v1alpha1.PIIDetection{
+		Entities: []v1alpha1.PIIEntity{
+			{Type: v1alpha1.PIIEntityPerson},
+			{Type: v1alpha1.PIIEntityNationalID, Action: ptr.To(v1alpha1.PIIActionReject)},
+			{Type: v1alpha1.PIIEntityIBAN, Action: ptr.To(v1alpha1.PIIActionHash)},
+			{Type: v1alpha1.PIIEntityAPIKey},
+		},
+	}
+
+	rules := processGuardRegex(nil, pii, nil)
+	require.NotNil(t, rules)
+	assert.Equal(t, api.PolicySpec_Ai_REJECT, rules.GetAction().GetKind())
+	require.Len(t, rules.GetRules(), 3)
+	assert.Equal(t, api.PolicySpec_Ai_SSN, rules.GetRules()[0].GetBuiltin())
+	assert.Equal(t, "IBAN", rules.GetRules()[1].GetRegex().GetName())
+	assert.Equal(t, "API_KEY", rules.GetRules()[2].GetRegex().GetName())
+
+	// a rejecting entity is never downgraded to the masking action of the regexes
+	regex := &v1alpha1.Regex{
+		Builtins: []v1alpha1.BuiltIn{v1alpha1.EMAIL},
+		Action:   ptr.To(v1alpha1.MASK),
+	}
+	rules = processGuardRegex(regex, pii, nil)
+	assert.Equal(t, api.PolicySpec_Ai_REJECT, rules.GetAction().GetKind())
+	require.Len(t, rules.GetRules(), 4)
+	assert.Equal(t, api.PolicySpec_Ai_EMAIL, rules.GetRules()[0].GetBuiltin())
+
+	assert.Equal(t, []reporter.PolicyFieldStatus{
+		{Field: "ai.promptGuard.request.regex.action", Reason: reporter.PolicyFieldReasonIgnored, Message: "a single action applies to the regexes and the PII entities with agentgateway, the REJECT action is applied"},
+		{Field: "ai.promptGuard.request.pii.entities[0]", Reason: reporter.PolicyFieldReasonIgnored, Message: "not supported by agentgateway"},
+		{Field: "ai.promptGuard.request.pii.entities[1].type", Reason: reporter.PolicyFieldReasonIgnored, Message: "agentgateway only matches US Social Security numbers, without validating their checksums"},
+		{Field: "ai.promptGuard.request.pii.entities[2].action", Reason: reporter.PolicyFieldReasonIgnored, Message: "per-entity actions are not supported by agentgateway, the REJECT action is applied"},
+		{Field: "ai.promptGuard.request.pii.entities[3].action", Reason: reporter.PolicyFieldReasonIgnored, Message: "per-entity actions are not supported by agentgateway, the REJECT action is applied"},
+		{Field: "ai.promptGuard.response.pii.entities[0]", Reason: reporter.PolicyFieldReasonIgnored, Message: "not supported by agentgateway"},
+		{Field: "ai.promptGuard.response.pii.entities[1].type", Reason: reporter.PolicyFieldReasonIgnored, Message: "agentgateway only matches US Social Security numbers, without validating their checksums"},
+		{Field: "ai.promptGuard.response.pii.entities[2].action", Reason: reporter.PolicyFieldReasonIgnored, Message: "per-entity actions are not supported by agentgateway, the REJECT action is applied"},
+		{Field: "ai.promptGuard.response.pii.entities[3].action", Reason: reporter.PolicyFieldReasonIgnored, Message: "per-entity actions are not supported by agentgateway, the REJECT action is applied"},
+	}, unsupportedTrafficPolicyFields(&v1alpha1.TrafficPolicy{
+		Spec: v1alpha1.TrafficPolicySpec{
+			AI: &v1alpha1.AIPolicy{
+				PromptGuard: &v1alpha1.AIPromptGuard{
+					Request:  &v1alpha1.PromptguardRequest{Regex: regex, PII: pii},
+					Response: &v1alpha1.PromptguardResponse{PII: pii},
+				},
+			},
+		},
+	}))
+
+	// a person entity does not make the prompt guard reject
+	rules = processGuardRegex(nil, &v1alpha1.PIIDetection{
+		Entities: []v1alpha1.PIIEntity{
+			{Type: v1alpha1.PIIEntityPerson, Action: ptr.To(v1alpha1.PIIActionReject)},
+			{Type: v1alpha1.PIIEntityAPIKey},
+		},
+	}, nil)
+	assert.Equal(t, api.PolicySpec_Ai_MASK, rules.GetAction().GetKind())
+
+	assert.Nil(t, processGuardRegex(nil, nil, nil))
+}
+
+func TestProcessMCPPolicy(t *testing.T) {
+	tp := &v1alpha1.TrafficPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "mcp"},
+		Spec: v1alpha1.TrafficPolicySpec{
+			MCP: &v1alpha1.MCPPolicy{
+				Authorization: []v1alpha1.MCPAuthorizationRule{
+					{
+						Tools:   []v1alpha1.MCPNameMatch{{Value: "echo"}},
+						Prompts: []v1alpha1.MCPNameMatch{{Type: ptr.To(v1alpha1.MCPNameMatchRegularExpression), Value: "summarize_.*"}},
+					},
+					{
+						Action: v1alpha1.AuthorizationPolicyActionDeny,
+						Target: ptr.To[gwv1.SectionName]("github"),
+						Tools:  []v1alpha1.MCPNameMatch{{Type: ptr.To(v1alpha1.MCPNameMatchRegularExpression), Value: "delete_.*"}},
+						From: &v1alpha1.MCPCallerMatch{
+							NotClaims: []v1alpha1.MCPClaimMatch{{Name: "groups", Values: []string{"admin"}}},
+							Headers:   []gwv1.HTTPHeaderMatch{{Name: "X-Tenant", Value: "acme"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	target := &api.PolicyTarget{Kind: &api.PolicyTarget_Backend{Backend: "default/mcp-backend"}}
+	mcpBackend := &v1alpha1.MCP{
+		Targets: []v1alpha1.McpTargetSelector{
+			{Name: "github", ToolPrefix: ptr.To("gh"), Static: &v1alpha1.McpTarget{Host: "github.default.svc", Port: 8080}},
+		},
+	}
+
+	policies, err := processMCPPolicy(tp, "trafficpolicy/default/mcp/mcp-backend", target, mcpBackend)
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	authz := policies[0].Policy.GetSpec().GetMcpAuthorization()
+	require.NotNil(t, authz)
+	assert.Equal(t, []string{
+		`(has(mcp.tool) && (mcp.tool.name == "echo")) || (has(mcp.prompt) && (mcp.prompt.name.matches("^(?:summarize_.*)$")))`,
+	}, authz.GetAllow())
+	assert.Equal(t, []string{
+		`((has(mcp.tool) && (mcp.tool.name.matches("^(?:delete_.*)$")) && mcp.tool.target == "gh")) && ` +
+			`!("groups" in jwt && (type(jwt["groups"]) == list ? jwt["groups"].exists(v, v in ["admin"]) : jwt["groups"] in ["admin"])) && ` +
+			`("x-tenant" in request.headers && request.headers["x-tenant"] == "acme")`,
+	}, authz.GetDeny())
+	for _, expr := range append(authz.GetAllow(), authz.GetDeny()...) {
+		_, iss := celEnv.Parse(expr)
+		require.NoError(t, iss.Err(), expr)
+	}
+
+	tp.Spec.MCP.Authorization[0].Tools[0] = v1alpha1.MCPNameMatch{Type: ptr.To(v1alpha1.MCPNameMatchRegularExpression), Value: "echo("}
+	_, err = processMCPPolicy(tp, "trafficpolicy/default/mcp/mcp-backend", target, mcpBackend)
+	require.ErrorContains(t, err, "authorization[0]: invalid regular expression")
+}
+
+func TestTrafficPolicyAcceptedConditions(t *testing.T) {
+	tp := &v1alpha1.TrafficPolicy{}
+	ignored := []reporter.PolicyFieldStatus{
+		{Field: "cors", Reason: reporter.PolicyFieldReasonIgnored, Message: "not supported by agentgateway"},
+	}
+	invalid := append([]reporter.PolicyFieldStatus{
+		{Field: "rbac", Reason: reporter.PolicyFieldReasonInvalid, Message: "invalid rbac"},
+	}, ignored...)
+
+	tests := []struct {
+		name                  string
+		translated            bool
+		fieldStatuses         []reporter.PolicyFieldStatus
+		err                   error
+		wantAccepted          metav1.ConditionStatus
+		wantPartiallyAccepted string
+	}{
+		{
+			name:         "accepted",
+			translated:   true,
+			wantAccepted: metav1.ConditionTrue,
+		},
+		{
+			name:                  "accepted with ignored fields",
+			translated:            true,
+			fieldStatuses:         ignored,
+			wantAccepted:          metav1.ConditionTrue,
+			wantPartiallyAccepted: string(v1alpha1.PolicyReasonFieldsIgnored),
+		},
+		{
+			name:                  "accepted with invalid fields",
+			translated:            true,
+			fieldStatuses:         invalid,
+			err:                   errors.New("invalid rbac"),
+			wantAccepted:          metav1.ConditionTrue,
+			wantPartiallyAccepted: string(v1alpha1.PolicyReasonFieldsInvalid),
+		},
+		{
+			name:          "nothing translated",
+			fieldStatuses: ignored,
+			wantAccepted:  metav1.ConditionFalse,
+		},
+		{
+			name:          "not accepted",
+			fieldStatuses: invalid,
+			err:           errors.New("invalid rbac"),
+			wantAccepted:  metav1.ConditionFalse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conds := trafficPolicyAcceptedConditions(tp, tt.translated, tt.fieldStatuses, tt.err)
+
+			accepted := meta.FindStatusCondition(conds, string(v1alpha1.PolicyConditionAccepted))
+			require.NotNil(t, accepted)
+			assert.Equal(t, tt.wantAccepted, accepted.Status)
+			partiallyAccepted := meta.FindStatusCondition(conds, string(v1alpha1.PolicyConditionPartiallyAccepted))
+			if tt.wantPartiallyAccepted == "" {
+				assert.Nil(t, partiallyAccepted)
+				return
+			}
+			require.NotNil(t, partiallyAccepted)
+			assert.Equal(t, tt.wantPartiallyAccepted, partiallyAccepted.Reason)
+		})
+	}
+}
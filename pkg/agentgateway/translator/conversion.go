@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"log"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -110,6 +111,7 @@ func ConvertHTTPRouteToAgw(ctx RouteContext, r gwv1.HTTPRouteRule,
 		}
 	}
 	res.Backends = backends
+	applyAIFailoverRetries(ctx, &r, obj.Namespace, res)
 
 	res.Hostnames = convertHostnames(obj.Spec.Hostnames)
 
@@ -577,6 +579,73 @@ func buildAgwHTTPDestination(
 	return res, invalidBackendErr, nil
 }
 
+// applyAIFailoverRetries retries the requests sent to AI backends with failover configured, when the
+// provider responds with one of the status codes of the failover triggers. agentgateway evicts the rate
+// limited providers until their Retry-After, so the retries are sent to the other providers of the backend.
+// The retries configured on the route rule take precedence.
+func applyAIFailoverRetries(ctx RouteContext, r *gwv1.HTTPRouteRule, ns string, res *api.Route) {
+	if r.Retry != nil {
+		return
+	}
+	var retry *api.Retry
+	for _, to := range r.BackendRefs {
+		ref := normalizeReference(to.Group, to.Kind, wellknown.ServiceGVK)
+		if ref.GroupKind() != wellknown.BackendGVK.GroupKind() {
+			continue
+		}
+		backend := ptr.Flatten(krt.FetchOne(ctx.Krt, ctx.Backends, krt.FilterKey(ns+"/"+string(to.Name))))
+		if backend == nil || backend.Spec.AI == nil || backend.Spec.AI.Failover == nil {
+			continue
+		}
+		providers := 0
+		for _, group := range backend.Spec.AI.PriorityGroups {
+			providers += len(group.Providers)
+		}
+		if providers < 2 {
+			// there is no other provider to fail over to
+			continue
+		}
+		if retry == nil {
+			retry = &api.Retry{}
+		}
+		for _, code := range aiFailoverStatusCodes(backend.Spec.AI.Failover) {
+			if !slices.Contains(retry.RetryStatusCodes, code) {
+				retry.RetryStatusCodes = append(retry.RetryStatusCodes, code)
+			}
+		}
+		retry.Attempts = max(retry.Attempts, int32(providers-1)) //nolint:gosec // G115: at most 32 groups of 32 providers
+	}
+	if retry == nil {
+		return
+	}
+	if res.TrafficPolicy == nil {
+		res.TrafficPolicy = &api.TrafficPolicy{}
+	}
+	res.TrafficPolicy.Retry = retry
+}
+
+// aiFailoverStatusCodes returns the status codes of the provider responses that trigger a failover,
+// defaulting to the RateLimited and ServerError triggers.
+func aiFailoverStatusCodes(failover *v1alpha1.AIFailover) []int32 {
+	triggers := failover.Triggers
+	if len(triggers) == 0 {
+		triggers = []v1alpha1.AIFailoverTrigger{v1alpha1.AIFailoverTriggerRateLimited, v1alpha1.AIFailoverTriggerServerError}
+	}
+	var codes []int32
+	for _, trigger := range triggers {
+		switch trigger {
+		case v1alpha1.AIFailoverTriggerRateLimited:
+			codes = append(codes, http.StatusTooManyRequests)
+		case v1alpha1.AIFailoverTriggerServerError:
+			codes = append(codes, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout)
+		case v1alpha1.AIFailoverTriggerTimeout:
+			// the providers that cannot be reached or do not respond in time fail with a 503 or a 504
+			codes = append(codes, http.StatusServiceUnavailable, http.StatusGatewayTimeout)
+		}
+	}
+	return codes
+}
+
 func buildAgwDestination(
 	ctx RouteContext,
 	to gwv1.HTTPBackendRef,
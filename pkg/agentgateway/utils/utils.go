@@ -1,6 +1,12 @@
 package utils
 
-import "fmt"
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
 
 // SingularLLMProviderSubBackendName is the name of the sub-backend for singular LLM providers.
 // If the Backend is ns/foo, the sub-backend will be ns/foo/backend
@@ -33,6 +39,13 @@ func InternalMCPStaticBackendName(backendNamespace, backendName, targetName stri
 	return backendNamespace + "/" + backendName + "/" + targetName
 }
 
+// InternalMCPStaticTargetName returns the name of the internal MCP target corresponding to the
+// specified static target, which prefixes the names of its tools when the backend has multiple targets.
+// Format: toolPrefix when set, otherwise targetName
+func InternalMCPStaticTargetName(target v1alpha1.McpTargetSelector) string {
+	return ptr.Deref(target.ToolPrefix, string(target.Name))
+}
+
 // InternalBackendName returns the name of the internal Backend corresponding to the
 // specified backend and target.
 // Format: backendNamespace/backendName when targetName is empty, otherwise backendNamespace/backendName/targetName
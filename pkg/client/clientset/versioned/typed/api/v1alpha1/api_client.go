@@ -19,6 +19,7 @@ type GatewayV1alpha1Interface interface {
 	GatewayExtensionsGetter
 	GatewayParametersGetter
 	HTTPListenerPoliciesGetter
+	TCPPoliciesGetter
 	TrafficPoliciesGetter
 }
 
@@ -51,6 +52,10 @@ func (c *GatewayV1alpha1Client) HTTPListenerPolicies(namespace string) HTTPListe
 	return newHTTPListenerPolicies(c, namespace)
 }
 
+func (c *GatewayV1alpha1Client) TCPPolicies(namespace string) TCPPolicyInterface {
+	return newTCPPolicies(c, namespace)
+}
+
 func (c *GatewayV1alpha1Client) TrafficPolicies(namespace string) TrafficPolicyInterface {
 	return newTrafficPolicies(c, namespace)
 }
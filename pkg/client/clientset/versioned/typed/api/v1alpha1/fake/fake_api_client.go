@@ -37,6 +37,10 @@ func (c *FakeGatewayV1alpha1) HTTPListenerPolicies(namespace string) v1alpha1.HT
 	return newFakeHTTPListenerPolicies(c, namespace)
 }
 
+func (c *FakeGatewayV1alpha1) TCPPolicies(namespace string) v1alpha1.TCPPolicyInterface {
+	return newFakeTCPPolicies(c, namespace)
+}
+
 func (c *FakeGatewayV1alpha1) TrafficPolicies(namespace string) v1alpha1.TrafficPolicyInterface {
 	return newFakeTrafficPolicies(c, namespace)
 }
@@ -14,4 +14,6 @@ type GatewayParametersExpansion interface{}
 
 type HTTPListenerPolicyExpansion interface{}
 
+type TCPPolicyExpansion interface{}
+
 type TrafficPolicyExpansion interface{}
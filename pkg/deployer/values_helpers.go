@@ -41,7 +41,19 @@ func GetPortsValues(gw *ir.Gateway, gwp *v1alpha1.GatewayParameters) []HelmPort
 			logger.Error("skipping port", "gateway", gw.ResourceName(), "error", err)
 			continue
 		}
-		gwPorts = AppendPortValue(gwPorts, listenerPort, portName, gwp)
+		protocol := corev1.ProtocolTCP
+		if l.Protocol == gwv1.UDPProtocolType {
+			protocol = corev1.ProtocolUDP
+			portName = listener.GenerateUdpListenerName(l)
+		}
+		gwPorts = AppendPortValue(gwPorts, listenerPort, portName, protocol, gwp)
+
+		// HTTPS listeners serving HTTP/3 also need the UDP port of their companion QUIC listener
+		if l.Protocol == gwv1.HTTPSProtocolType &&
+			ir.Http3ForListener(string(l.Name), l.AttachedPolicies, gw.AttachedHttpPolicies) != nil {
+			quicPortName := listener.GenerateQuicListenerName(uint32(l.Port)) //nolint:gosec // G115: Gateway listener port is int32, always positive, safe to convert to uint32
+			gwPorts = AppendPortValue(gwPorts, listenerPort, quicPortName, corev1.ProtocolUDP, gwp)
+		}
 	}
 
 	// Add ports from GatewayParameters.Service.Ports
@@ -57,7 +69,7 @@ func GetPortsValues(gw *ir.Gateway, gwp *v1alpha1.GatewayParameters) []HelmPort
 				},
 			}
 			portName := listener.GenerateListenerName(l)
-			gwPorts = AppendPortValue(gwPorts, portValue, portName, gwp)
+			gwPorts = AppendPortValue(gwPorts, portValue, portName, corev1.ProtocolTCP, gwp)
 		}
 	}
 
@@ -78,13 +90,13 @@ func SanitizePortName(name string) string {
 	return str
 }
 
-func AppendPortValue(gwPorts []HelmPort, port int32, name string, gwp *v1alpha1.GatewayParameters) []HelmPort {
-	if slices.IndexFunc(gwPorts, func(p HelmPort) bool { return *p.Port == port }) != -1 {
+func AppendPortValue(gwPorts []HelmPort, port int32, name string, protocol corev1.Protocol, gwp *v1alpha1.GatewayParameters) []HelmPort {
+	// the same port may be exposed once per protocol, e.g. for HTTPS and HTTP/3
+	if slices.IndexFunc(gwPorts, func(p HelmPort) bool { return *p.Port == port && ptr.Deref(p.Protocol, "") == string(protocol) }) != -1 {
 		return gwPorts
 	}
 
 	portName := SanitizePortName(name)
-	protocol := "TCP"
 
 	// Search for static NodePort set from the GatewayParameters spec
 	// If not found the default value of `nil` will not render anything.
@@ -100,7 +112,7 @@ func AppendPortValue(gwPorts []HelmPort, port int32, name string, gwp *v1alpha1.
 		Port:       &port,
 		TargetPort: &port,
 		Name:       &portName,
-		Protocol:   &protocol,
+		Protocol:   ptr.To(string(protocol)),
 		NodePort:   nodePort,
 	})
 }
@@ -1,9 +1,18 @@
 package deployer
 
 import (
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 func TestComponentLogLevelsToString(t *testing.T) {
@@ -56,3 +65,62 @@ func TestComponentLogLevelsToString(t *testing.T) {
 		})
 	}
 }
+
+type fakeHttp3Policy struct {
+	listeners []string
+}
+
+func (p *fakeHttp3Policy) CreationTime() time.Time { return time.Time{} }
+
+func (p *fakeHttp3Policy) Equals(in any) bool { return false }
+
+func (p *fakeHttp3Policy) Http3(listenerName string) (*ir.Http3Settings, bool) {
+	if !slices.Contains(p.listeners, listenerName) {
+		return nil, true
+	}
+	return &ir.Http3Settings{}, true
+}
+
+func TestGetPortsValuesHttp3(t *testing.T) {
+	gw := &ir.Gateway{
+		Listeners: []ir.Listener{
+			{Listener: gwv1.Listener{Name: "http", Protocol: gwv1.HTTPProtocolType, Port: 8080}},
+			{Listener: gwv1.Listener{Name: "https", Protocol: gwv1.HTTPSProtocolType, Port: 8443}},
+			{Listener: gwv1.Listener{Name: "https-internal", Protocol: gwv1.HTTPSProtocolType, Port: 9443}},
+		},
+		AttachedHttpPolicies: ir.AttachedPolicies{
+			Policies: map[schema.GroupKind][]ir.PolicyAtt{
+				wellknown.HTTPListenerPolicyGVK.GroupKind(): {{
+					PolicyIr: &fakeHttp3Policy{listeners: []string{"https"}},
+				}},
+			},
+		},
+	}
+
+	got := GetPortsValues(gw, &v1alpha1.GatewayParameters{})
+
+	want := []HelmPort{
+		{Port: ptr.To[int32](8080), TargetPort: ptr.To[int32](8080), Name: ptr.To("listener-8080"), Protocol: ptr.To("TCP")},
+		{Port: ptr.To[int32](8443), TargetPort: ptr.To[int32](8443), Name: ptr.To("listener-8443"), Protocol: ptr.To("TCP")},
+		{Port: ptr.To[int32](8443), TargetPort: ptr.To[int32](8443), Name: ptr.To("quic-8443"), Protocol: ptr.To("UDP")},
+		{Port: ptr.To[int32](9443), TargetPort: ptr.To[int32](9443), Name: ptr.To("listener-9443"), Protocol: ptr.To("TCP")},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestGetPortsValuesUdp(t *testing.T) {
+	gw := &ir.Gateway{
+		Listeners: []ir.Listener{
+			{Listener: gwv1.Listener{Name: "dns-tcp", Protocol: gwv1.TCPProtocolType, Port: 5353}},
+			{Listener: gwv1.Listener{Name: "dns", Protocol: gwv1.UDPProtocolType, Port: 5353}},
+		},
+	}
+
+	got := GetPortsValues(gw, &v1alpha1.GatewayParameters{})
+
+	want := []HelmPort{
+		{Port: ptr.To[int32](5353), TargetPort: ptr.To[int32](5353), Name: ptr.To("listener-5353"), Protocol: ptr.To("TCP")},
+		{Port: ptr.To[int32](5353), TargetPort: ptr.To[int32](5353), Name: ptr.To("udp-5353"), Protocol: ptr.To("UDP")},
+	}
+	assert.Equal(t, want, got)
+}
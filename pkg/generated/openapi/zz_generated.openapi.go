@@ -17,9 +17,17 @@ import (
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIBackend":                                 schema_kgateway_v2_api_v1alpha1_AIBackend(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIEmbedding":                               schema_kgateway_v2_api_v1alpha1_AIEmbedding(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIFailover":                                schema_kgateway_v2_api_v1alpha1_AIFailover(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIModelRoute":                              schema_kgateway_v2_api_v1alpha1_AIModelRoute(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIModelRouting":                            schema_kgateway_v2_api_v1alpha1_AIModelRouting(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPolicy":                                  schema_kgateway_v2_api_v1alpha1_AIPolicy(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptEnrichment":                        schema_kgateway_v2_api_v1alpha1_AIPromptEnrichment(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptGuard":                             schema_kgateway_v2_api_v1alpha1_AIPromptGuard(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCache":                           schema_kgateway_v2_api_v1alpha1_AISemanticCache(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCachePartitionKey":               schema_kgateway_v2_api_v1alpha1_AISemanticCachePartitionKey(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIUnknownModelResponse":                    schema_kgateway_v2_api_v1alpha1_AIUnknownModelResponse(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIVectorStore":                             schema_kgateway_v2_api_v1alpha1_AIVectorStore(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AWSGuardrailConfig":                        schema_kgateway_v2_api_v1alpha1_AWSGuardrailConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AccessLog":                                 schema_kgateway_v2_api_v1alpha1_AccessLog(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AccessLogFilter":                           schema_kgateway_v2_api_v1alpha1_AccessLogFilter(ref),
@@ -32,9 +40,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AnthropicConfig":                           schema_kgateway_v2_api_v1alpha1_AnthropicConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AnyValue":                                  schema_kgateway_v2_api_v1alpha1_AnyValue(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AuthHeader":                                schema_kgateway_v2_api_v1alpha1_AuthHeader(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsAssumeRole":                             schema_kgateway_v2_api_v1alpha1_AwsAssumeRole(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsAuth":                                   schema_kgateway_v2_api_v1alpha1_AwsAuth(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsBackend":                                schema_kgateway_v2_api_v1alpha1_AwsBackend(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsLambda":                                 schema_kgateway_v2_api_v1alpha1_AwsLambda(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsWebIdentity":                            schema_kgateway_v2_api_v1alpha1_AwsWebIdentity(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureAuth":                                 schema_kgateway_v2_api_v1alpha1_AzureAuth(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureBackend":                              schema_kgateway_v2_api_v1alpha1_AzureBackend(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureEntraIDAuth":                          schema_kgateway_v2_api_v1alpha1_AzureEntraIDAuth(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureOpenAIConfig":                         schema_kgateway_v2_api_v1alpha1_AzureOpenAIConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Backend":                                   schema_kgateway_v2_api_v1alpha1_Backend(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.BackendConfigPolicy":                       schema_kgateway_v2_api_v1alpha1_BackendConfigPolicy(ref),
@@ -89,9 +102,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GatewayParametersList":                     schema_kgateway_v2_api_v1alpha1_GatewayParametersList(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GatewayParametersSpec":                     schema_kgateway_v2_api_v1alpha1_GatewayParametersSpec(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GatewayParametersStatus":                   schema_kgateway_v2_api_v1alpha1_GatewayParametersStatus(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GcpAuth":                                   schema_kgateway_v2_api_v1alpha1_GcpAuth(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GcpBackend":                                schema_kgateway_v2_api_v1alpha1_GcpBackend(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GeminiConfig":                              schema_kgateway_v2_api_v1alpha1_GeminiConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GracefulShutdownSpec":                      schema_kgateway_v2_api_v1alpha1_GracefulShutdownSpec(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GrpcStatusFilter":                          schema_kgateway_v2_api_v1alpha1_GrpcStatusFilter(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HTTP3":                                     schema_kgateway_v2_api_v1alpha1_HTTP3(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HTTPListenerPolicy":                        schema_kgateway_v2_api_v1alpha1_HTTPListenerPolicy(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HTTPListenerPolicyList":                    schema_kgateway_v2_api_v1alpha1_HTTPListenerPolicyList(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HTTPListenerPolicySpec":                    schema_kgateway_v2_api_v1alpha1_HTTPListenerPolicySpec(ref),
@@ -125,7 +141,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelector":                 schema_kgateway_v2_api_v1alpha1_LocalPolicyTargetSelector(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelectorWithSectionName":  schema_kgateway_v2_api_v1alpha1_LocalPolicyTargetSelectorWithSectionName(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalRateLimitPolicy":                      schema_kgateway_v2_api_v1alpha1_LocalRateLimitPolicy(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalityFailover":                          schema_kgateway_v2_api_v1alpha1_LocalityFailover(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCP":                                       schema_kgateway_v2_api_v1alpha1_MCP(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPAuthorizationRule":                      schema_kgateway_v2_api_v1alpha1_MCPAuthorizationRule(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPCallerMatch":                            schema_kgateway_v2_api_v1alpha1_MCPCallerMatch(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPClaimMatch":                             schema_kgateway_v2_api_v1alpha1_MCPClaimMatch(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPNameMatch":                              schema_kgateway_v2_api_v1alpha1_MCPNameMatch(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPPolicy":                                 schema_kgateway_v2_api_v1alpha1_MCPPolicy(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSelector":                               schema_kgateway_v2_api_v1alpha1_McpSelector(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTarget":                                 schema_kgateway_v2_api_v1alpha1_McpTarget(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTargetSelector":                         schema_kgateway_v2_api_v1alpha1_McpTargetSelector(ref),
@@ -142,6 +164,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenTelemetryAccessLogService":             schema_kgateway_v2_api_v1alpha1_OpenTelemetryAccessLogService(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenTelemetryTracingConfig":                schema_kgateway_v2_api_v1alpha1_OpenTelemetryTracingConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OutlierDetection":                          schema_kgateway_v2_api_v1alpha1_OutlierDetection(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PIIDetection":                              schema_kgateway_v2_api_v1alpha1_PIIDetection(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PIIEntity":                                 schema_kgateway_v2_api_v1alpha1_PIIEntity(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PathOverride":                              schema_kgateway_v2_api_v1alpha1_PathOverride(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Pod":                                       schema_kgateway_v2_api_v1alpha1_Pod(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PolicyAncestorStatus":                      schema_kgateway_v2_api_v1alpha1_PolicyAncestorStatus(ref),
@@ -161,6 +185,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimitDescriptorEntryGeneric":           schema_kgateway_v2_api_v1alpha1_RateLimitDescriptorEntryGeneric(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimitPolicy":                           schema_kgateway_v2_api_v1alpha1_RateLimitPolicy(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimitProvider":                         schema_kgateway_v2_api_v1alpha1_RateLimitProvider(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RedisVectorStore":                          schema_kgateway_v2_api_v1alpha1_RedisVectorStore(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Regex":                                     schema_kgateway_v2_api_v1alpha1_Regex(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RegexMatch":                                schema_kgateway_v2_api_v1alpha1_RegexMatch(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ResourceDetector":                          schema_kgateway_v2_api_v1alpha1_ResourceDetector(ref),
@@ -173,6 +198,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SelfManagedGateway":                        schema_kgateway_v2_api_v1alpha1_SelfManagedGateway(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Service":                                   schema_kgateway_v2_api_v1alpha1_Service(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ServiceAccount":                            schema_kgateway_v2_api_v1alpha1_ServiceAccount(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ShadowMode":                                schema_kgateway_v2_api_v1alpha1_ShadowMode(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SingleAuthToken":                           schema_kgateway_v2_api_v1alpha1_SingleAuthToken(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SlowStart":                                 schema_kgateway_v2_api_v1alpha1_SlowStart(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SourceIP":                                  schema_kgateway_v2_api_v1alpha1_SourceIP(ref),
@@ -181,9 +207,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StatusCodeFilter":                          schema_kgateway_v2_api_v1alpha1_StatusCodeFilter(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StringMatcher":                             schema_kgateway_v2_api_v1alpha1_StringMatcher(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPKeepalive":                              schema_kgateway_v2_api_v1alpha1_TCPKeepalive(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPPolicy":                                 schema_kgateway_v2_api_v1alpha1_TCPPolicy(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPPolicyList":                             schema_kgateway_v2_api_v1alpha1_TCPPolicyList(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPPolicySpec":                             schema_kgateway_v2_api_v1alpha1_TCPPolicySpec(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPRBAC":                                   schema_kgateway_v2_api_v1alpha1_TCPRBAC(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPRBACRule":                               schema_kgateway_v2_api_v1alpha1_TCPRBACRule(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLS":                                       schema_kgateway_v2_api_v1alpha1_TLS(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLSFiles":                                  schema_kgateway_v2_api_v1alpha1_TLSFiles(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLSParameters":                             schema_kgateway_v2_api_v1alpha1_TLSParameters(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLSSDS":                                    schema_kgateway_v2_api_v1alpha1_TLSSDS(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Timeouts":                                  schema_kgateway_v2_api_v1alpha1_Timeouts(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TokenBucket":                               schema_kgateway_v2_api_v1alpha1_TokenBucket(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Tracing":                                   schema_kgateway_v2_api_v1alpha1_Tracing(ref),
@@ -195,6 +227,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TransformationPolicy":                      schema_kgateway_v2_api_v1alpha1_TransformationPolicy(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.UpgradeConfig":                             schema_kgateway_v2_api_v1alpha1_UpgradeConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.VertexAIConfig":                            schema_kgateway_v2_api_v1alpha1_VertexAIConfig(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.WAF":                                       schema_kgateway_v2_api_v1alpha1_WAF(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.WAFRuleExclusion":                          schema_kgateway_v2_api_v1alpha1_WAFRuleExclusion(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.WAFRuleSet":                                schema_kgateway_v2_api_v1alpha1_WAFRuleSet(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Webhook":                                   schema_kgateway_v2_api_v1alpha1_Webhook(ref),
 		"k8s.io/api/apps/v1.ControllerRevision":                                                      schema_k8sio_api_apps_v1_ControllerRevision(ref),
 		"k8s.io/api/apps/v1.ControllerRevisionList":                                                  schema_k8sio_api_apps_v1_ControllerRevisionList(ref),
@@ -657,11 +692,172 @@ func schema_kgateway_v2_api_v1alpha1_AIBackend(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"failover": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failover configures how requests fail over from failing or rate limited providers to the other providers of the backend. Providers failing with server errors or timeouts are ejected, and requests fail over to the next priority group once all the providers of a group are ejected. Rate limited requests are retried on the other providers, after the delay of the Retry-After header of the rate limited response.\n\nNote: With agentgateway, the requests failing with the status codes of the triggers are retried on the other providers, and the failing providers are evicted by the built-in failover of agentgateway: rate limited providers are evicted until the time of their Retry-After header, and the healthy providers with the lowest latency are preferred. The consecutiveFailures, ejectionDuration and loadBalancing fields are not supported with agentgateway, and a Backend setting them is reported as partially accepted.\n\nExample configuration failing over rate limited requests and balancing across the providers with the lowest latency: ```yaml failover:\n  triggers:\n  - RateLimited\n  - ServerError\n  ejectionDuration: 1m\n  loadBalancing: LeastLatency\n```",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIFailover"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIFailover", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LLMProvider", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PriorityGroup"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AIEmbedding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIEmbedding configures the embedding model of the semantic cache.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"openai": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Use the OpenAI embeddings API. The model defaults to `text-embedding-3-small`.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenAIConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenAIConfig"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AIFailover(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIFailover configures the failover between the providers of an AI backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"triggers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Triggers are the provider responses that fail a request over to the other providers. Defaults to RateLimited and ServerError.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"consecutiveFailures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsecutiveFailures is the number of consecutive server errors or timeouts before a provider is ejected. Defaults to 5. A 429 response does not eject the provider: the rate limited request is retried on another provider instead, so a rate limited provider keeps receiving new requests.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ejectionDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EjectionDuration is the base time a provider is ejected for. The real time is equal to the base time multiplied by the number of times the provider has been ejected. Defaults to 30s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"loadBalancing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadBalancing is the algorithm used to balance requests across the providers of a priority group. Defaults to RoundRobin.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AIModelRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIModelRoute routes the requests for a set of models.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"models": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Models lists the model names matched by this route. A `*` in a name matches any sequence of characters, for example `gpt-4o*` matches `gpt-4o` and `gpt-4o-mini`. Note: Wildcards are not supported with agentgateway.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider is the name of the provider, in the priority groups of the AI backend, that the matched requests are sent to. If not specified, the requests are load balanced across the providers of the backend. Note: This field is not supported with agentgateway.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"model": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Model is the model name sent to the provider in place of the requested model. A model configured on the provider takes precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"models"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AIModelRouting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIModelRouting configures how requests are routed based on the `model` field of the request body.\n\nThe following example sends the requests for the `gpt-4o` models to the `azure-gpt-4o` provider, serves the `fast` alias with `gpt-4o-mini`, and rejects the requests for any other model. ```yaml modelRouting:\n\n\troutes:\n\t- models: [\"gpt-4o\", \"gpt-4o-2024-*\"]\n\t  provider: azure-gpt-4o\n\t- models: [\"fast\"]\n\t  provider: openai\n\t  model: gpt-4o-mini\n\tunknownModelResponse:\n\t  statusCode: 404\n\n```",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes maps the model names requested by clients to providers and models. The routes are evaluated in order, and the first route matching the requested model is used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIModelRoute"),
+									},
+								},
+							},
+						},
+					},
+					"unknownModelResponse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnknownModelResponse configures the response returned to the client when the requested model does not match any route. If not specified, these requests are sent to the backend unchanged. Note: This field is not supported with agentgateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIUnknownModelResponse"),
+						},
+					},
 				},
+				Required: []string{"routes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LLMProvider", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PriorityGroup"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIModelRoute", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIUnknownModelResponse"},
 	}
 }
 
@@ -700,16 +896,34 @@ func schema_kgateway_v2_api_v1alpha1_AIPolicy(ref common.ReferenceCallback) comm
 					},
 					"routeType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The type of route to the LLM provider API. Currently, `CHAT` and `CHAT_STREAMING` are supported. Note: This field is not applicable when using agentgateway",
+							Description: "The type of route to the LLM provider API, which determines how the requests and responses are parsed for prompt guards and token accounting, and which API of the LLM provider requests are sent to. `CHAT` and `CHAT_STREAMING` are supported by all the providers. `COMPLETIONS`, `EMBEDDINGS`, `RESPONSES` and `IMAGES` are supported by the OpenAI and Azure OpenAI providers. Use `AUTO` to detect the route type from the request path, such as `/v1/embeddings`, when a route serves several APIs. Note: This field is not applicable when using agentgateway",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"tokenRateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit the number of LLM tokens consumed by requests, counting both the prompt and the completion tokens. The token counts are parsed from the responses of the LLM provider, so a request is charged for its tokens once its response completes, and later requests are rejected while the budget is exhausted.\n\nThe local token bucket is shared by all the requests the policy applies to. Use the global rate limit with descriptors, such as a header, a JWT claim or the client IP, to limit the tokens per client.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimit"),
+						},
+					},
+					"modelRouting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Route requests to the providers of the AI backend based on the model requested by the client, rewrite the model names, and reject requests for unknown models. This allows a single OpenAI-compatible endpoint to front several providers and models. The providers of an AI backend of different types, e.g. Azure OpenAI and Vertex AI, are sent the requests through their OpenAI-compatible API. Note: With agentgateway, only routes mapping a model name without wildcards to another model are supported.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIModelRouting"),
+						},
+					},
+					"semanticCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache the responses of the LLM provider, and serve the cached response to requests with a prompt that is similar to the prompt of a cached request, which saves the latency and the cost of the request. Note: This field is not applicable when using agentgateway",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCache"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptEnrichment", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptGuard", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.FieldDefault"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIModelRouting", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptEnrichment", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptGuard", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCache", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.FieldDefault", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimit"},
 	}
 }
 
@@ -783,6 +997,133 @@ func schema_kgateway_v2_api_v1alpha1_AIPromptGuard(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_AISemanticCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AISemanticCache configures the semantic caching of the responses of the LLM provider. The prompt of each request is converted to a vector with an embedding model, and the vector store is searched for the response of a request with a similar prompt. Only the successful responses of `CHAT` requests that are not streamed are cached.\n\nThe following example caches the responses in Redis for an hour, and serves them to the requests whose prompt embedding has a cosine similarity of at least 0.9 with a cached one. ```yaml semanticCache:\n\n\tstore:\n\t  redis:\n\t    backendRef:\n\t      name: redis\n\t      port: 6379\n\tembedding:\n\t  openai:\n\t    authToken:\n\t      kind: SecretRef\n\t      secretRef:\n\t        name: openai-secret\n\t    model: text-embedding-3-small\n\tsimilarityThreshold: 90\n\tttl: 1h\n\n```",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"store": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Store is the vector store that holds the embeddings of the cached prompts and their responses.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIVectorStore"),
+						},
+					},
+					"embedding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Embedding is the model used to convert the prompts to vectors.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIEmbedding"),
+						},
+					},
+					"similarityThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SimilarityThreshold is the minimum cosine similarity, as a percentage, between the embeddings of the prompt of a request and of a cached prompt for the cached response to be served. Defaults to 95.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is the duration for which a response is cached. Defaults to 1h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"partitionKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The cached responses are partitioned by policy, route and backend, so that they are only served to the requests of the route and backend they were cached for. PartitionKey additionally partitions the cached responses by a value of the request, such as a header or a JWT claim identifying the user or the tenant. The requests without this value are not cached.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCachePartitionKey"),
+						},
+					},
+					"disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable the semantic cache. Can be used to disable the semantic cache for the routes of a policy applied at a higher level in the config hierarchy.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PolicyDisable"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIEmbedding", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCachePartitionKey", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIVectorStore", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PolicyDisable", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AISemanticCachePartitionKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AISemanticCachePartitionKey is the value of the request that partitions the cached responses.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Header is the name of the request header whose value partitions the cached responses.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jwtClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JWTClaim is the claim of the validated JWT of the request whose value partitions the cached responses. The claim is read from the JWT payload that the JWT authentication filter stores in the `payload` key of its dynamic metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AIUnknownModelResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIUnknownModelResponse configures the response returned for requests to unknown models.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A custom response message to return to the client. If not specified, defaults to \"The requested model is not supported\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"statusCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The status code to return to the client. Defaults to 404.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AIVectorStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIVectorStore configures the vector store of the semantic cache.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"redis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Redis stores the embeddings and the responses in Redis, using the vector search of the Redis Query Engine, which is available in Redis Stack and Redis 8.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RedisVectorStore"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RedisVectorStore"},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_AWSGuardrailConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1407,37 +1748,95 @@ func schema_kgateway_v2_api_v1alpha1_AuthHeader(ref common.ReferenceCallback) co
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_AwsAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_AwsAssumeRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AwsAuth specifies the authentication method to use for the backend.",
+				Description: "AwsAssumeRole configures the AssumeRole API call.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"roleARN": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies the authentication method to use for the backend.",
+							Description: "RoleARN is the ARN of the role to assume.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
+					"externalID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef references a Kubernetes Secret containing the AWS credentials. The Secret must have keys \"accessKey\", \"secretKey\", and optionally \"sessionToken\".",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "ExternalID is the external ID expected by the trust policy of the role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"roleSessionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleSessionName is the name of the role session.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sessionDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionDuration is the duration of the role session. Defaults to the maximum session duration of the role.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"roleARN"},
 			},
-			VendorExtensible: spec.VendorExtensible{
-				Extensions: spec.Extensions{
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AwsAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AwsAuth specifies the authentication method to use for the backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies the authentication method to use for the backend. With an agentgateway-based Gateway, the WebIdentity and PodIdentity types use the default AWS credential chain of the proxy.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references a Kubernetes Secret containing the AWS credentials. The Secret must have keys \"accessKey\", \"secretKey\", and optionally \"sessionToken\".",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"webIdentity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebIdentity configures the role to assume with the web identity token of the proxy. When omitted, the role ARN and the token file are read from the AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE environment variables of the proxy, as set by the EKS Pod Identity Webhook for a service account annotated with eks.amazonaws.com/role-arn. NOTE: This field is only supported with an Envoy-based Gateway. An agentgateway-based Gateway does not accept a Backend that sets it, and always reads the web identity from the environment of the proxy.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsWebIdentity"),
+						},
+					},
+					"assumeRole": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AssumeRole configures a role to assume using the credentials obtained with the authentication method, e.g. to access a backend in another AWS account. NOTE: This field is only supported with an Envoy-based Gateway. An agentgateway-based Gateway does not accept a Backend that sets it.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsAssumeRole"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
 					"x-kubernetes-unions": []interface{}{
 						map[string]interface{}{
 							"discriminator": "type",
 							"fields-to-discriminateBy": map[string]interface{}{
-								"secretRef": "SecretRef",
+								"assumeRole":  "AssumeRole",
+								"secretRef":   "SecretRef",
+								"webIdentity": "WebIdentity",
 							},
 						},
 					},
@@ -1445,7 +1844,7 @@ func schema_kgateway_v2_api_v1alpha1_AwsAuth(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsAssumeRole", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsWebIdentity", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -1543,6 +1942,159 @@ func schema_kgateway_v2_api_v1alpha1_AwsLambda(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_AwsWebIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AwsWebIdentity configures the AssumeRoleWithWebIdentity API call. NOTE: An explicit web identity configuration is only supported with an Envoy-based Gateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"roleARN": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleARN is the ARN of the role to assume.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenPath is the path of the web identity token file in the proxy container. Defaults to the path of the token projected for IRSA, /var/run/secrets/eks.amazonaws.com/serviceaccount/token.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"roleSessionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleSessionName is the name of the role session.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"roleARN"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AzureAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureAuth specifies the authentication method to use for an Azure backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies the authentication method to use for the backend.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references a Kubernetes Secret containing the function key in the \"functionKey\" key when type is FunctionKey, or the client secret in the \"clientSecret\" key when type is EntraID.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"entraID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EntraID configures the Microsoft Entra ID access tokens when type is EntraID.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureEntraIDAuth"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
+					"x-kubernetes-unions": []interface{}{
+						map[string]interface{}{
+							"discriminator": "type",
+							"fields-to-discriminateBy": map[string]interface{}{
+								"entraID":   "EntraID",
+								"secretRef": "SecretRef",
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureEntraIDAuth", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AzureBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureBackend is the configuration of an Azure Function App.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host is the hostname of the Function App, e.g. \"my-app.azurewebsites.net\". Requests are sent to the host on port 443 using TLS, and their host header is rewritten to it.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auth specifies the authentication method to use for the Function App. When omitted, requests are sent without credentials, which is suitable for functions with the anonymous authorization level.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureAuth"),
+						},
+					},
+				},
+				Required: []string{"host"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureAuth"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AzureEntraIDAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureEntraIDAuth configures the Microsoft Entra ID access tokens of an Azure backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TenantID is the ID of the Entra ID tenant issuing the tokens.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientID is the client ID of the Entra ID application requesting the tokens.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope is the scope of the tokens, which is the application ID URI of the Function App registration followed by \"/.default\", e.g. \"api://my-function-app/.default\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clientID", "scope"},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_AzureOpenAIConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1734,7 +2286,7 @@ func schema_kgateway_v2_api_v1alpha1_BackendConfigPolicySpec(ref common.Referenc
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BackendConfigPolicySpec defines the desired state of BackendConfigPolicy.",
+				Description: "BackendConfigPolicySpec defines the desired state of BackendConfigPolicy.\n\nA Gateway API XBackendTrafficPolicy can target the same backends, as it configures the retry budget and the session persistence, which a BackendConfigPolicy does not configure. The load balancer of the BackendConfigPolicy picks the host of the first request of a session, and the session persistence then pins the following requests to that host while it is healthy. The session persistence of an HTTPRoute rule takes precedence over the one of an XBackendTrafficPolicy. XBackendTrafficPolicy is not supported with agentgateway.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"targetRefs": {
@@ -1814,6 +2366,12 @@ func schema_kgateway_v2_api_v1alpha1_BackendConfigPolicySpec(ref common.Referenc
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LoadBalancer"),
 						},
 					},
+					"localityFailover": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalityFailover configures the priority of the endpoints of the backend based on their labels, to keep the traffic close to the proxy, e.g. in the same zone, and only fail over to farther endpoints when the closer ones are unhealthy. It takes precedence over the locality load balancer settings of an Istio DestinationRule and the traffic distribution of the Service.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalityFailover"),
+						},
+					},
 					"healthCheck": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HealthCheck contains the options necessary to configure the health check.",
@@ -1830,7 +2388,7 @@ func schema_kgateway_v2_api_v1alpha1_BackendConfigPolicySpec(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CommonHttpProtocolOptions", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HealthCheck", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Http1ProtocolOptions", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Http2ProtocolOptions", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LoadBalancer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReference", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelector", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OutlierDetection", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPKeepalive", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLS", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CommonHttpProtocolOptions", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HealthCheck", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Http1ProtocolOptions", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Http2ProtocolOptions", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LoadBalancer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReference", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelector", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalityFailover", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OutlierDetection", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPKeepalive", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLS", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1927,6 +2485,18 @@ func schema_kgateway_v2_api_v1alpha1_BackendSpec(ref common.ReferenceCallback) c
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCP"),
 						},
 					},
+					"gcp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gcp is the Google Cloud Run and Cloud Functions backend configuration. The Gcp backend type is only supported with envoy-based gateways, it is not supported in agentgateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GcpBackend"),
+						},
+					},
+					"azure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Azure is the Azure Functions backend configuration. The Azure backend type is only supported with envoy-based gateways, it is not supported in agentgateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureBackend"),
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
@@ -1938,7 +2508,9 @@ func schema_kgateway_v2_api_v1alpha1_BackendSpec(ref common.ReferenceCallback) c
 							"fields-to-discriminateBy": map[string]interface{}{
 								"ai":                  "AI",
 								"aws":                 "Aws",
+								"azure":               "Azure",
 								"dynamicForwardProxy": "DynamicForwardProxy",
+								"gcp":                 "Gcp",
 								"mcp":                 "MCP",
 								"static":              "Static",
 							},
@@ -1948,7 +2520,7 @@ func schema_kgateway_v2_api_v1alpha1_BackendSpec(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIBackend", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsBackend", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.DynamicForwardProxyBackend", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCP", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StaticBackend"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIBackend", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AwsBackend", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureBackend", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.DynamicForwardProxyBackend", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GcpBackend", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCP", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StaticBackend"},
 	}
 }
 
@@ -3831,6 +4403,85 @@ func schema_kgateway_v2_api_v1alpha1_GatewayParametersStatus(ref common.Referenc
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_GcpAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GcpAuth specifies how the ID tokens of a GCP backend are obtained.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies how the ID tokens are obtained.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references a Kubernetes Secret containing the JSON key of the Google service account in the \"serviceAccountKey\" key.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
+					"x-kubernetes-unions": []interface{}{
+						map[string]interface{}{
+							"discriminator": "type",
+							"fields-to-discriminateBy": map[string]interface{}{
+								"secretRef": "SecretRef",
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_GcpBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GcpBackend is the configuration of a Google Cloud Run service or Cloud Function that requires authentication.\n\nRequests are authenticated with a Google-signed ID token of a Google service account with the Cloud Run Invoker or Cloud Functions Invoker role, see Auth.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host is the hostname of the Cloud Run service or Cloud Function, e.g. \"my-service-abcdefghij-uc.a.run.app\" or \"us-central1-my-project.cloudfunctions.net\". Requests are sent to the host on port 443 using TLS, and their host header is rewritten to it.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"audience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Audience is the audience of the ID token. Defaults to \"https://<host>\", which is the audience expected by Cloud Run services and Cloud Functions unless a custom audience is configured on the service.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auth specifies how the ID tokens are obtained. When omitted, the ID tokens are fetched from the metadata server, as with the WorkloadIdentity type.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GcpAuth"),
+						},
+					},
+				},
+				Required: []string{"host"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GcpAuth"},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_GeminiConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3929,23 +4580,59 @@ func schema_kgateway_v2_api_v1alpha1_GrpcStatusFilter(ref common.ReferenceCallba
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_HTTPListenerPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_HTTP3(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPListenerPolicy is intended to be used for configuring the Envoy `HttpConnectionManager` and any other config or policy that should map 1-to-1 with a given HTTP listener, such as the Envoy health check HTTP filter. Currently these policies can only be applied per `Gateway` but support for `Listener` attachment may be added in the future. See https://github.com/kgateway-dev/kgateway/issues/11786 for more details.",
+				Description: "HTTP3 configures HTTP/3 over QUIC for HTTPS listeners.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"listeners": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Listeners restricts HTTP/3 to the named HTTPS listeners of the targeted Gateways. If unset, HTTP/3 is enabled on all HTTPS listeners.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"altSvcMaxAge": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "AltSvcMaxAge is how long clients may remember that HTTP/3 is available, advertised as the max age of the alt-svc response header. Defaults to 24h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_HTTPListenerPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPListenerPolicy is intended to be used for configuring the Envoy `HttpConnectionManager` and any other config or policy that should map 1-to-1 with a given HTTP listener, such as the Envoy health check HTTP filter. Currently these policies can only be applied per `Gateway` but support for `Listener` attachment may be added in the future. See https://github.com/kgateway-dev/kgateway/issues/11786 for more details.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -4145,11 +4832,17 @@ func schema_kgateway_v2_api_v1alpha1_HTTPListenerPolicySpec(ref common.Reference
 							Format:      "",
 						},
 					},
+					"http3": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP3 enables HTTP/3 over QUIC on the HTTPS listeners of the targeted Gateways. Each enabled listener gets a companion UDP listener on the same port that shares its TLS configuration, the proxy Service exposes the UDP port, and responses advertise HTTP/3 with the alt-svc header.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HTTP3"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AccessLog", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.EnvoyHealthCheck", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReference", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelector", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Tracing", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.UpgradeConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AccessLog", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.EnvoyHealthCheck", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HTTP3", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReference", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelector", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Tracing", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.UpgradeConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -5325,6 +6018,49 @@ func schema_kgateway_v2_api_v1alpha1_LocalRateLimitPolicy(ref common.ReferenceCa
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_LocalityFailover(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalityFailover configures the priorities of the endpoints of a backend. See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/priority) for more details.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"failoverPriority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailoverPriority is the ordered list of the labels used to prioritize the endpoints. The endpoints whose values match the ones of the proxy for all the labels have the highest priority, and the endpoints have a lower priority the earlier the first label that does not match is in the list. A label can be suffixed with `=<value>` to match the endpoints against the value rather than against the label of the proxy. The endpoints have the topology.kubernetes.io/region and topology.kubernetes.io/zone labels of their node. For example, `[topology.kubernetes.io/region, topology.kubernetes.io/zone]` prefers the endpoints in the zone of the proxy, then the ones in its region, then all the others.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"overprovisioningFactor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OverprovisioningFactor is the factor, in percent, applied to the ratio of healthy endpoints of a priority to compute the share of the traffic it receives. A priority receives all the traffic as long as the ratio multiplied by the factor is at least 100%, and the remaining traffic fails over to the next priority otherwise. Defaults to 140, i.e. the traffic starts to fail over when less than about 71% of the endpoints are healthy. Higher values keep more of the traffic close to the proxy at the cost of a higher load on the closer endpoints. See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/overprovisioning) for more details.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"minEndpoints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinEndpoints is the minimum number of ready endpoints the backend must have for the failover priority to be applied. Backends with fewer endpoints load balance across all of them, as keeping the traffic close to the proxy would overload the few closer endpoints. Defaults to 1. Unlike the min_cluster_size of the Envoy zone aware load balancing, the threshold is applied by the control plane when it assigns the priorities, to the endpoints of the backend rather than to the ones of the proxy, and it defaults to 1 rather than 6. The Envoy zone aware load balancing is not used as it requires the proxy to know the endpoints of its own deployment, and only balances the traffic across the zones of the highest priority. See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/zone_aware) for more details.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"failoverPriority"},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_MCP(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5363,212 +6099,456 @@ func schema_kgateway_v2_api_v1alpha1_MCP(ref common.ReferenceCallback) common.Op
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_McpSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_MCPAuthorizationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "McpSelector defines the selector logic to search for MCP targets.",
+				Description: "MCPAuthorizationRule allows or denies the access of a set of callers to a set of MCP tools, prompts and resources. A rule matches an item if the caller and the item match the rule.\n\nThe following example only allows the callers whose JWT has the `admin` group to call the tools of the `github` target whose name starts with `delete_`. ```yaml mcp:\n\n\tauthorization:\n\t- action: Deny\n\t  target: github\n\t  tools:\n\t  - type: RegularExpression\n\t    value: \"delete_.*\"\n\t  from:\n\t    notClaims:\n\t    - name: groups\n\t      values: [\"admin\"]\n\n```",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"namespace": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the label selector in which namespace the MCP targets are searched for.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+							Description: "Action defines whether the rule allows or denies the access to the matched items. If unspecified, the default is \"Allow\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"service": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Service is the label selector in which services the MCP targets are searched for.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+							Description: "Target restricts the rule to the items of the target of the MCP backend with this name. If unspecified, the rule matches the items of all the targets.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tools matches the tools by name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPNameMatch"),
+									},
+								},
+							},
+						},
+					},
+					"prompts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prompts matches the prompts by name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPNameMatch"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources matches the resources by URI.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPNameMatch"),
+									},
+								},
+							},
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From matches the callers. If unspecified, the rule matches all the callers.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPCallerMatch"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPCallerMatch", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPNameMatch"},
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_McpTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_MCPCallerMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "McpTarget defines a single MCP target configuration.",
+				Description: "MCPCallerMatch matches the callers of an MCP backend by the claims of their validated JWT and by their request headers. A caller matches if all the conditions are satisfied.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"host": {
+					"claims": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Host is the hostname or IP address of the MCP target.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Claims matches the callers whose JWT has all the claims.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPClaimMatch"),
+									},
+								},
+							},
 						},
 					},
-					"port": {
+					"notClaims": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Port is the port number of the MCP target.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "NotClaims matches the callers whose JWT has none of the claims.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPClaimMatch"),
+									},
+								},
+							},
 						},
 					},
-					"path": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Path is the URL path of the MCP target endpoint. Defaults to \"/sse\" for SSE protocol or \"/mcp\" for StreamableHTTP protocol if not specified.",
-							Type:        []string{"string"},
-							Format:      "",
+					"headers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
 						},
-					},
-					"protocol": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Protocol is the protocol to use for the connection to the MCP target.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Headers matches the callers whose requests have all the headers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/gateway-api/apis/v1.HTTPHeaderMatch"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"host", "port"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPClaimMatch", "sigs.k8s.io/gateway-api/apis/v1.HTTPHeaderMatch"},
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_McpTargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_MCPClaimMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "McpTargetSelector defines the MCP target to use for this backend.",
+				Description: "MCPClaimMatch matches a claim of the validated JWT of the caller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the MCP target.",
+							Description: "Name is the name of the claim.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"selector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Selector is the selector to use to select the MCP targets. Note: Policies must target the resource selected by the target and not the name of the selector-based target on the Backend resource.",
-							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSelector"),
-						},
-					},
-					"static": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Static is the static MCP target to use. Policies can target static backends by targeting the Backend resource and using sectionName to target the specific static target by name.",
-							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTarget"),
+							Description: "Values matches the claims equal to one of the values. For a claim that is a list, such as `groups`, the claim matches if it contains one of the values.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"name", "values"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSelector", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTarget"},
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_Message(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_MCPNameMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "An entry for a message to prepend or append to each prompt.",
+				Description: "MCPNameMatch matches the name of an MCP tool or prompt, or the URI of an MCP resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"role": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Role of the message. The available roles depend on the backend LLM provider model, such as `SYSTEM` or `USER` in the OpenAI API.",
-							Default:     "",
+							Description: "Type specifies how to match the value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"content": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "String content of the message.",
+							Description: "Value is the name, or the RE2 regular expression the whole name must match.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"role", "content"},
+				Required: []string{"value"},
 			},
 		},
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_MetadataKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_MCPPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MetadataKey provides a way to retrieve values from Metadata using a key and a path.",
+				Description: "MCPPolicy configures the access of the callers to the tools, prompts and resources of an MCP backend. The tools, prompts and resources a caller is not allowed to access are also filtered from the `tools/list`, `prompts/list` and `resources/list` responses. The policy must target an MCP Backend, it is ignored for the other targets. NOTE: This field is only supported with an agentgateway-based Gateway.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The key name of the Metadata from which to retrieve the Struct",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"path": {
+					"authorization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The path used to retrieve a specific Value from the Struct. This can be either a prefix or a full path, depending on the use case",
+							Description: "Authorization configures the rules that allow or deny the access to the MCP tools, prompts and resources. An item is denied if it matches a Deny rule. Otherwise, it is allowed if there are no Allow rules, or if it matches an Allow rule.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MetadataPathSegment"),
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPAuthorizationRule"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"key", "path"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MetadataPathSegment"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPAuthorizationRule"},
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_MetadataNamespaces(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_McpSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MetadataNamespaces configures which metadata namespaces to use. See [envoy docs](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/http/ext_proc/v3/ext_proc.proto#envoy-v3-api-msg-extensions-filters-http-ext-proc-v3-metadataoptions-metadatanamespaces) for specifics.",
+				Description: "McpSelector defines the selector logic to search for MCP targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"typed": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Namespace is the label selector in which namespace the MCP targets are searched for.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"untyped": {
+					"service": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
+							Description: "Service is the label selector in which services the MCP targets are searched for.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_McpTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "McpTarget defines a single MCP target configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host is the hostname or IP address of the MCP target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port number of the MCP target.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the URL path of the MCP target endpoint. Defaults to \"/sse\" for SSE protocol or \"/mcp\" for StreamableHTTP protocol if not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is the protocol to use for the connection to the MCP target.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"host", "port"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_McpTargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "McpTargetSelector defines the MCP target to use for this backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the MCP target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"toolPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolPrefix is the prefix of the names of the tools, prompts and resources of the target, which are exposed as `<toolPrefix>_<name>` when the backend has multiple targets. This avoids collisions between the tools with the same name of different targets. If unspecified, the name of the target is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is the selector to use to select the MCP targets. Note: Policies must target the resource selected by the target and not the name of the selector-based target on the Backend resource.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSelector"),
+						},
+					},
+					"static": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Static is the static MCP target to use. Policies can target static backends by targeting the Backend resource and using sectionName to target the specific static target by name.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTarget"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpSelector", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.McpTarget"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_Message(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "An entry for a message to prepend or append to each prompt.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role of the message. The available roles depend on the backend LLM provider model, such as `SYSTEM` or `USER` in the OpenAI API.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "String content of the message.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"role", "content"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_MetadataKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MetadataKey provides a way to retrieve values from Metadata using a key and a path.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The key name of the Metadata from which to retrieve the Struct",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The path used to retrieve a specific Value from the Struct. This can be either a prefix or a full path, depending on the use case",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MetadataPathSegment"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"key", "path"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MetadataPathSegment"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_MetadataNamespaces(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MetadataNamespaces configures which metadata namespaces to use. See [envoy docs](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/http/ext_proc/v3/ext_proc.proto#envoy-v3-api-msg-extensions-filters-http-ext-proc-v3-metadataoptions-metadatanamespaces) for specifics.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"typed": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"untyped": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
 								},
 							},
 						},
@@ -5955,6 +6935,73 @@ func schema_kgateway_v2_api_v1alpha1_OutlierDetection(ref common.ReferenceCallba
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_PIIDetection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PIIDetection configures the detection of personally identifiable information (PII) and secrets. Unlike the built-in regexes, the entities are detected with named entity recognition and with the validation of the checksums of the identifiers, which reduces the false positives.\n\nThe following example rejects the prompts containing API keys, and hashes the IBANs and the names of persons in the prompts. ```yaml promptGuard:\n\n\trequest:\n\t  pii:\n\t    entities:\n\t    - type: API_KEY\n\t      action: REJECT\n\t    - type: IBAN\n\t      action: HASH\n\t    - type: PERSON\n\t      action: HASH\n\n```\n\nNote: With agentgateway, the `PERSON` and `LOCATION` entities and the `HASH` action are not supported, `NATIONAL_ID` only matches US Social Security numbers, the identifiers are matched without validating their checksums, and the detections are not counted in the `pii_detected` metric. A single action applies to all the entities and the regexes of the prompt guard, which is `REJECT` if the regex or any entity rejects, and otherwise `MASK`. These differences are reported on the status of the policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"entities": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The types of entities to detect, and the action to take on each.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PIIEntity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"entities"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PIIEntity"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_PIIEntity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PIIEntity configures the action taken on a type of PII entity.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The type of entity to detect.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The action to take when the entity is detected. Defaults to `MASK`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_PathOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6364,7 +7411,7 @@ func schema_kgateway_v2_api_v1alpha1_PromptguardRequest(ref common.ReferenceCall
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PromptguardRequest defines the prompt guards to apply to requests sent by the client. Multiple prompt guard configurations can be set, and they will be executed in the following order: webhook → regex → moderation for requests, where each step can reject the request and stop further processing.",
+				Description: "PromptguardRequest defines the prompt guards to apply to requests sent by the client. Multiple prompt guard configurations can be set, and they will be executed in the following order: webhook → regex → pii → moderation for requests, where each step can reject the request and stop further processing.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"customResponse": {
@@ -6391,11 +7438,17 @@ func schema_kgateway_v2_api_v1alpha1_PromptguardRequest(ref common.ReferenceCall
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Moderation"),
 						},
 					},
+					"pii": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Detect personally identifiable information (PII) and secrets in the prompts.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PIIDetection"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CustomResponse", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Moderation", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Regex", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Webhook"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CustomResponse", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Moderation", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PIIDetection", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Regex", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Webhook"},
 	}
 }
 
@@ -6403,7 +7456,7 @@ func schema_kgateway_v2_api_v1alpha1_PromptguardResponse(ref common.ReferenceCal
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PromptguardResponse configures the response that the prompt guard applies to responses returned by the LLM provider. Both webhook and regex can be set, they will be executed in the following order: webhook → regex, where each step can reject the request and stop further processing. Note: This is not yet supported for agentgateway.",
+				Description: "PromptguardResponse configures the response that the prompt guard applies to responses returned by the LLM provider. Multiple prompt guard configurations can be set, and they will be executed in the following order: webhook → regex → pii, where each step can reject the request and stop further processing. Note: This is not yet supported for agentgateway.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"regex": {
@@ -6418,11 +7471,17 @@ func schema_kgateway_v2_api_v1alpha1_PromptguardResponse(ref common.ReferenceCal
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Webhook"),
 						},
 					},
-				},
+					"pii": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Detect personally identifiable information (PII) and secrets in the responses. A response rejected by the PII detection is replaced with a 403 error. The status code of a streamed response may already have been sent to the client, in which case the connection is closed.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PIIDetection"),
+						},
+					},
+				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Regex", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Webhook"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PIIDetection", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Regex", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Webhook"},
 	}
 }
 
@@ -6597,6 +7656,13 @@ func schema_kgateway_v2_api_v1alpha1_RateLimitDescriptorEntry(ref common.Referen
 							Format:      "",
 						},
 					},
+					"jwtClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JWTClaim specifies the claim of the validated JWT of the request to extract the descriptor value from. With Envoy, the claim is read from the JWT payload that the JWT authentication filter stores in the `payload` key of its dynamic metadata. With agentgateway, the claim is read from the validated JWT. This field must be specified when Type is JWTClaim.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
@@ -6723,6 +7789,42 @@ func schema_kgateway_v2_api_v1alpha1_RateLimitProvider(ref common.ReferenceCallb
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_RedisVectorStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RedisVectorStore configures a Redis server used as a vector store.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backendRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackendRef references the Redis server, such as a Kubernetes Service or a static Backend.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/gateway-api/apis/v1.BackendObjectReference"),
+						},
+					},
+					"index": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the search index of the cached prompts, which is created if it does not exist. Routes with different embedding models must use different indexes. Defaults to `kgateway-semantic-cache.<namespace>.<name>`, with the namespace and the name of the policy, so that each policy has its own index.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The password used to authenticate with the Redis server. If not specified, the connection is not authenticated.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SingleAuthToken"),
+						},
+					},
+				},
+				Required: []string{"backendRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SingleAuthToken", "sigs.k8s.io/gateway-api/apis/v1.BackendObjectReference"},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_Regex(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7157,6 +8259,39 @@ func schema_kgateway_v2_api_v1alpha1_ServiceAccount(ref common.ReferenceCallback
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_ShadowMode(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShadowMode configures which fields of a TrafficPolicy are evaluated without being enforced.\n\nIn shadow mode:\n  - RBAC rules are configured as Envoy shadow rules. The decision is recorded in the\n    `shadow_` prefixed RBAC stats and in the `envoy.filters.http.rbac` dynamic metadata\n    (`shadow_effective_policy_id` and `shadow_engine_result`), which can be used in access logs.\n  - RateLimit decisions are computed and counted in the rate limit stats but requests over the\n    limit are not rejected. Local rate limits add the `x-kgateway-ratelimit-shadow: limited`\n    request header to requests that would have been limited.\n\nExtAuth does not support shadow mode since the authorization server denials are always enforced. A policy with extAuth that is in shadow mode for all its fields is not accepted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fields": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Fields restricts shadow mode to the listed fields of the policy. If unset or empty, all fields that support shadow mode are evaluated in shadow mode.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_SingleAuthToken(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7325,112 +8460,369 @@ func schema_kgateway_v2_api_v1alpha1_StatusCodeFilter(ref common.ReferenceCallba
 				Properties: map[string]spec.Schema{
 					"op": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value to compare against.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"op"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_StringMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Specifies the way to match a string.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"exact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The input string must match exactly the string specified here. Example: abc matches the value abc",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The input string must have the prefix specified here. Note: empty prefix is not allowed, please use regex instead. Example: abc matches the value abc.xyz",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suffix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The input string must have the suffix specified here. Note: empty prefix is not allowed, please use regex instead. Example: abc matches the value xyz.abc",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"contains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The input string must contain the substring specified here. Example: abc matches the value xyz.abc.def",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"safeRegex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The input string must match the Google RE2 regular expression specified here. See https://github.com/google/re2/wiki/Syntax for the syntax.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ignoreCase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, indicates the exact/prefix/suffix/contains matching should be case insensitive. This has no effect on the regex match. For example, the matcher data will match both input string Data and data if this option is set to true.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"ignoreCase"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_TCPKeepalive(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/address.proto#envoy-v3-api-msg-config-core-v3-tcpkeepalive) for more details.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keepAliveProbes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum number of keep-alive probes to send before dropping the connection.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"keepAliveTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of seconds a connection needs to be idle before keep-alive probes start being sent.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"keepAliveInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of seconds between keep-alive probes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_TCPPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TCPPolicy configures connection-level policies, such as network RBAC, connection rate limiting and connection timeouts, for the traffic of the targeted TCPRoute and TLSRoute resources. NOTE: TCPPolicy is only supported with an Envoy-based Gateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPPolicySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/gateway-api/apis/v1.PolicyStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/gateway-api/apis/v1.PolicyStatus"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_TCPPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPPolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_TCPPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TCPPolicySpec defines the desired state of a TCP policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targetRefs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetRefs specifies the target resources by reference to attach the policy to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReference"),
+									},
+								},
+							},
+						},
+					},
+					"targetSelectors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetSelectors specifies the target selectors to select resources to attach the policy to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelector"),
+									},
+								},
+							},
+						},
+					},
+					"rbac": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RBAC allows or denies connections based on the client address, the requested SNI and the principal of the client certificate. It is enforced by the Envoy network RBAC filter before the connection is proxied to the backends.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPRBAC"),
 						},
 					},
-					"value": {
+					"connectionRateLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value to compare against.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "ConnectionRateLimit limits the rate at which new connections are accepted by each Envoy instance. Connections exceeding the limit are closed immediately.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TokenBucket"),
+						},
+					},
+					"idleTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleTimeout is the amount of time a connection may have no data sent or received in either direction before it is closed. Envoy's default of 1h applies if unset; a value of 0s disables the idle timeout.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxConnectionDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnectionDuration is the maximum duration of a connection, after which it is closed regardless of activity. If unset, connections have no maximum duration.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
-				Required: []string{"op"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReference", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelector", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPRBAC", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TokenBucket", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_StringMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_TCPRBAC(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Specifies the way to match a string.",
+				Description: "TCPRBAC defines the network-level role-based access control for connections.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"exact": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The input string must match exactly the string specified here. Example: abc matches the value abc",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"prefix": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The input string must have the prefix specified here. Note: empty prefix is not allowed, please use regex instead. Example: abc matches the value abc.xyz",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"suffix": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The input string must have the suffix specified here. Note: empty prefix is not allowed, please use regex instead. Example: abc matches the value xyz.abc",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"contains": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The input string must contain the substring specified here. Example: abc matches the value xyz.abc.def",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"safeRegex": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The input string must match the Google RE2 regular expression specified here. See https://github.com/google/re2/wiki/Syntax for the syntax.",
+							Description: "Action defines whether connections matching any of the rules are allowed or denied. With the Allow action, connections that match none of the rules are denied; with the Deny action, connections that match none of the rules are allowed. If unspecified, the default is \"Allow\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ignoreCase": {
+					"rules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If true, indicates the exact/prefix/suffix/contains matching should be case insensitive. This has no effect on the regex match. For example, the matcher data will match both input string Data and data if this option is set to true.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Rules is the list of rules to match connections against. A connection matches the policy if it matches any of the rules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPRBACRule"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"ignoreCase"},
+				Required: []string{"rules"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPRBACRule"},
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_TCPKeepalive(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_TCPRBACRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/address.proto#envoy-v3-api-msg-config-core-v3-tcpkeepalive) for more details.",
+				Description: "TCPRBACRule matches a connection when all of its specified fields match. Within a single field, matching any one of the listed values is sufficient.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"keepAliveProbes": {
+					"sourceCIDRs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Maximum number of keep-alive probes to send before dropping the connection.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "SourceCIDRs matches the address of the downstream peer, e.g. \"10.0.0.0/8\" or \"2001:db8::/32\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"keepAliveTime": {
+					"snis": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of seconds a connection needs to be idle before keep-alive probes start being sent.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "SNIs matches the server name requested by the client in the TLS ClientHello. A leading \"*.\" matches any subdomain, e.g. \"*.example.com\" matches \"foo.example.com\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"keepAliveInterval": {
+					"principals": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of seconds between keep-alive probes.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Principals matches the identity of the client certificate presented over mTLS, that is its URI SAN, or its subject if there is no URI SAN, e.g. \"spiffe://cluster.local/ns/default/sa/client\". It only matches when the listener terminates TLS and validates client certificates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -7452,6 +8844,12 @@ func schema_kgateway_v2_api_v1alpha1_TLS(ref common.ReferenceCallback) common.Op
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLSFiles"),
 						},
 					},
+					"sds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SDS fetches the client certificate and the trusted CA from an SDS server, allowing the certificates to be rotated without updating the policy.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLSSDS"),
+						},
+					},
 					"wellKnownCACertificates": {
 						SchemaProps: spec.SchemaProps{
 							Description: "WellKnownCACertificates specifies whether to use a well-known set of CA certificates for validating the backend's certificate chain. Currently, only the system certificate pool is supported via SDS.",
@@ -7527,7 +8925,7 @@ func schema_kgateway_v2_api_v1alpha1_TLS(ref common.ReferenceCallback) common.Op
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLSFiles", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLSParameters", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLSFiles", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLSParameters", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TLSSDS", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -7615,6 +9013,43 @@ func schema_kgateway_v2_api_v1alpha1_TLSParameters(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_TLSSDS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TLSSDS references the secrets served by an SDS server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificateSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateSecretName is the name of the SDS secret holding the client certificate and key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"validationContextSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValidationContextSecretName is the name of the SDS secret holding the trusted CA used to validate the backend's certificate.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterName is the name of the Envoy cluster serving SDS. The cluster must be defined in the proxy's bootstrap configuration. If unset, defaults to the SDS server deployed alongside the proxy, which requires the Istio integration to be enabled.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"certificateSecretName", "validationContextSecretName"},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_Timeouts(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7986,11 +9421,36 @@ func schema_kgateway_v2_api_v1alpha1_TrafficPolicySpec(ref common.ReferenceCallb
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RBAC"),
 						},
 					},
+					"mcp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCP configures the access of the callers to the tools, prompts and resources of the targeted MCP backends. NOTE: This field is only supported with an agentgateway-based Gateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPPolicy"),
+						},
+					},
+					"waf": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WAF configures a Web Application Firewall for the policy targets. NOTE: This field is only supported with an Envoy-based Gateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.WAF"),
+						},
+					},
+					"destinationRuleSubset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DestinationRuleSubset selects a named subset of the Istio DestinationRule that applies to the backends of the targeted routes. To split traffic between subsets of the same Service, reference a TrafficPolicy per subset from the HTTPRoute backendRefs using an ExtensionRef filter. Backends with no subsets in their DestinationRule are not affected, while requests to a backend whose DestinationRule does not define the selected subset fail, as with Istio. This field is only applicable to route targets and requires the Istio integration to be enabled. NOTE: This field is only supported with an Envoy-based Gateway.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shadowMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShadowMode marks the policy, or a subset of its fields, as evaluated but not enforced. This is useful to roll out a new policy and observe its decisions through metrics and access logs before enforcing it. NOTE: This field is only supported with an Envoy-based Gateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ShadowMode"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Buffer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CSRFPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CorsPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtAuthPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtProcPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HeaderModifiers", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReferenceWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelectorWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RBAC", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimit", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Retry", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Timeouts", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TransformationPolicy"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Buffer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CSRFPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CorsPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtAuthPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtProcPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HeaderModifiers", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReferenceWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelectorWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MCPPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RBAC", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimit", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Retry", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ShadowMode", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Timeouts", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TransformationPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.WAF"},
 	}
 }
 
@@ -8204,6 +9664,126 @@ func schema_kgateway_v2_api_v1alpha1_VertexAIConfig(ref common.ReferenceCallback
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_WAF(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WAF configures a Web Application Firewall for the policy targets. The WAF is backed by a Coraza (ModSecurity SecLang compatible) Wasm filter, so that OWASP Core Rule Set (CRS) style rule sets can be used as is.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ruleSets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuleSets is the list of rule sets evaluated by the WAF, in order. Each rule set is loaded from a ConfigMap in the same namespace as the policy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.WAFRuleSet"),
+									},
+								},
+							},
+						},
+					},
+					"paranoiaLevel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParanoiaLevel sets the OWASP CRS blocking paranoia level. Higher levels enable more rules, at the cost of more false positives. If unset, the paranoia level configured in the rule sets is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ruleExclusions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuleExclusions removes rules from the rule sets, for example to suppress false positives on a given route.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.WAFRuleExclusion"),
+									},
+								},
+							},
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode controls whether the WAF blocks the requests matching its rules or only logs them in the audit log. Defaults to Enforce.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable the WAF for the policy targets, overriding any WAF configured at a less specific level.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PolicyDisable"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PolicyDisable", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.WAFRuleExclusion", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.WAFRuleSet"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_WAFRuleExclusion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WAFRuleExclusion removes rules from the WAF rule sets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID removes the rule with the given ID, or the rules in the given inclusive range of IDs, e.g. \"942100\" or \"942100-942999\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tag removes the rules with the given tag, e.g. \"attack-sqli\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_WAFRuleSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WAFRuleSet references SecLang directives stored in a ConfigMap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef references the ConfigMap that contains the directives.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the key of the ConfigMap that contains the directives. If unset, the directives in all the keys of the ConfigMap are loaded, in lexicographical order of the keys.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"configMapRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_Webhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
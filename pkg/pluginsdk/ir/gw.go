@@ -12,6 +12,7 @@ import (
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 )
 
 var VirtualBuiltInGK = schema.GroupKind{
@@ -85,6 +86,16 @@ type PolicyAtt struct {
 	// policies are preferred during a merge conflict or when ordering policies during a merge.
 	PrecedenceWeight int32
 
+	// GlobalPolicyTier is the tier of a policy attached from the global policy namespace.
+	// Policies in the Override tier are preferred over all other policies attached to the same target,
+	// while policies in the Default tier are only used for fields not set by any other policy.
+	// Empty for policies that are not attached from the global policy namespace.
+	GlobalPolicyTier apiannotations.GlobalPolicyTierValue
+
+	// FieldStatuses describes the fields of the policy that are not applied.
+	// It is used to report the policy as partially accepted.
+	FieldStatuses []reporter.PolicyFieldStatus
+
 	// MergeOrigins maps field names in the PolicyIr to their original source in the merged PolicyAtt.
 	// It can be used to determine which PolicyAtt a merged field came from.
 	// Only relevant to policy merging and does not contribute to KRT events
@@ -144,7 +155,9 @@ func (c PolicyAtt) Equals(in PolicyAtt) bool {
 		ptrEquals(c.PolicyRef, in.PolicyRef) &&
 		c.InheritedPolicyPriority == in.InheritedPolicyPriority &&
 		c.HierarchicalPriority == in.HierarchicalPriority &&
-		c.PrecedenceWeight == in.PrecedenceWeight
+		c.PrecedenceWeight == in.PrecedenceWeight &&
+		c.GlobalPolicyTier == in.GlobalPolicyTier &&
+		slices.Equal(c.FieldStatuses, in.FieldStatuses)
 }
 
 func ptrEquals[T comparable](a, b *T) bool {
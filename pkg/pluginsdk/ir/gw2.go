@@ -1,6 +1,8 @@
 package ir
 
 import (
+	"time"
+
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -57,6 +59,8 @@ type ListenerIR struct {
 
 	HttpFilterChain []HttpFilterChainIR
 	TcpFilterChain  []TcpIR
+	// UdpProxy is set for UDP listeners, which have no filter chains.
+	UdpProxy *UdpIR
 
 	PolicyAncestorRef gwv1.ParentReference
 
@@ -111,11 +115,25 @@ type HttpFilterChainIR struct {
 	AttachedPolicies        AttachedPolicies
 	AttachedNetworkPolicies AttachedPolicies
 	CustomHTTPFilters       []CustomEnvoyFilter
+	// Http3 is set when HTTP/3 is enabled on the filter chain, in which case it is also served
+	// by a companion QUIC listener on the same port.
+	Http3 *Http3Settings
 }
 
 type TcpIR struct {
 	FilterChainCommon
 	BackendRefs []BackendRefIR
+	// AttachedPolicies are the policies attached to the TCPRoute or TLSRoute of the filter chain.
+	AttachedPolicies AttachedPolicies
+}
+
+// UdpIR proxies the datagrams of a UDP listener to the backends of its route.
+// UDP listeners have no filter chains to match on, so a listener proxies to a single route.
+type UdpIR struct {
+	Name        string
+	BackendRefs []BackendRefIR
+	// IdleTimeout is the duration after which an idle session is closed, nil to use the default.
+	IdleTimeout *time.Duration
 }
 
 // this is 1:1 with envoy deployments
@@ -0,0 +1,50 @@
+package ir
+
+import (
+	"time"
+)
+
+// Http3Settings are the settings of HTTP/3 for an HTTPS listener.
+type Http3Settings struct {
+	// AltSvcMaxAge is the max age advertised in the alt-svc response header.
+	AltSvcMaxAge time.Duration
+}
+
+func (s *Http3Settings) Equals(in *Http3Settings) bool {
+	if s == nil || in == nil {
+		return s == in
+	}
+	return s.AltSvcMaxAge == in.AltSvcMaxAge
+}
+
+// Http3PolicyIR is implemented by the IR of listener policies that can enable HTTP/3 on HTTPS listeners.
+type Http3PolicyIR interface {
+	// Http3 returns the HTTP/3 settings for the named listener, or nil if HTTP/3 is disabled for it.
+	// The second return value is false if the policy does not configure HTTP/3 at all.
+	Http3(listenerName string) (*Http3Settings, bool)
+}
+
+// Http3ForListener returns the HTTP/3 settings of the named listener as configured by the first
+// policy that configures HTTP/3, with the policies ordered from high to low priority.
+// It returns nil if HTTP/3 is not enabled on the listener.
+// Both the translator and the deployer use it, so the companion QUIC listener and the UDP port
+// exposed on the proxy Service stay in sync.
+func Http3ForListener(listenerName string, policies ...AttachedPolicies) *Http3Settings {
+	for _, attached := range policies {
+		for _, gk := range attached.ApplyOrderedGroupKinds() {
+			for _, pol := range attached.Policies[gk] {
+				if len(pol.Errors) > 0 {
+					continue
+				}
+				p, ok := pol.PolicyIr.(Http3PolicyIR)
+				if !ok {
+					continue
+				}
+				if settings, configured := p.Http3(listenerName); configured {
+					return settings
+				}
+			}
+		}
+	}
+	return nil
+}
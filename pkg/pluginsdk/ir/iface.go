@@ -12,6 +12,7 @@ import (
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -40,6 +41,9 @@ type RouteConfigContext struct {
 	FilterChainName   string
 	TypedFilterConfig TypedFilterConfigMap
 	GatewayContext    GatewayContext
+	// IgnoredFields is set by the plugin to the fields of the policy that are not applicable at this level,
+	// which are reported on the status of the policies the fields originate from
+	IgnoredFields []reporter.PolicyFieldStatus
 }
 
 type VirtualHostContext struct {
@@ -47,6 +51,9 @@ type VirtualHostContext struct {
 	FilterChainName   string
 	TypedFilterConfig TypedFilterConfigMap
 	GatewayContext    GatewayContext
+	// IgnoredFields is set by the plugin to the fields of the policy that are not applicable at this level,
+	// which are reported on the status of the policies the fields originate from
+	IgnoredFields []reporter.PolicyFieldStatus
 }
 
 type TypedFilterConfigMap map[string]proto.Message
@@ -90,12 +97,20 @@ type RouteBackendContext struct {
 	GatewayContext  GatewayContext
 	FilterChainName string
 	Backend         *BackendObjectIR
+	// In is the route rule the backend is referenced by
+	In HttpRouteRuleMatchIR
 	// TypedFilterConfig will be output on the Route or WeightedCluster level after all plugins have run
 	TypedFilterConfig       TypedFilterConfigMap
 	RequestHeadersToAdd     []*envoycorev3.HeaderValueOption
 	RequestHeadersToRemove  []string
 	ResponseHeadersToAdd    []*envoycorev3.HeaderValueOption
 	ResponseHeadersToRemove []string
+	// MetadataMatch will be output on the WeightedCluster level, or on the Route level
+	// if the route has a single backend, to select a subset of the backend endpoints
+	MetadataMatch *envoycorev3.Metadata
+	// IgnoredFields is set by the plugin to the fields of the policy that are not applicable at this level,
+	// which are reported on the status of the policies the fields originate from
+	IgnoredFields []reporter.PolicyFieldStatus
 }
 
 type RouteContext struct {
@@ -109,6 +124,16 @@ type RouteContext struct {
 	ListenerPort uint32
 
 	InheritedPolicyPriority apiannotations.InheritedPolicyPriorityValue
+
+	// IgnoredFields is set by the plugin to the fields of the policy that are not applicable at this level,
+	// which are reported on the status of the policies the fields originate from
+	IgnoredFields []reporter.PolicyFieldStatus
+}
+
+type TcpRouteContext struct {
+	FilterChainName string
+	Policy          PolicyIR
+	GatewayContext  GatewayContext
 }
 
 type HcmContext struct {
@@ -184,6 +209,20 @@ type ProxyTranslationPass interface {
 	ResourcesToAdd() Resources
 }
 
+// TcpRouteTranslationPass is optionally implemented by a ProxyTranslationPass whose policies can be
+// attached to TCPRoutes and TLSRoutes. Policies of other kinds attached to these routes are not accepted.
+type TcpRouteTranslationPass interface {
+	// called once per TCP filter chain if SupportsPolicyMerge returns false, otherwise this is called only
+	// once on the value returned by MergePolicies.
+	// Applies policy for a TCPRoute or TLSRoute that has a policy attached via a targetRef.
+	// The output configures the tcp_proxy of the filter chain, and the returned network filters are
+	// added before the tcp_proxy of the same filter chain.
+	ApplyForTcpRoute(
+		pCtx *TcpRouteContext,
+		out *envoytcp.TcpProxy,
+	) ([]plugins.StagedNetworkFilter, error)
+}
+
 type AgentgatewayRouteContext struct {
 	Rule *gwv1.HTTPRouteRule
 }
@@ -296,6 +335,14 @@ type PolicyWrapper struct {
 	// Policies with higher weight implies higher priority, and are evaluated before policies with lower weight.
 	// By default, policies have a weight of 0.
 	PrecedenceWeight int32
+
+	// GlobalPolicyTier is the tier of the policy when it is defined in the global policy namespace.
+	// It is ignored for policies defined in any other namespace.
+	GlobalPolicyTier apiannotations.GlobalPolicyTierValue
+
+	// FieldStatuses describes the fields of the policy that are not applied, e.g., because they are
+	// not applicable to the targeted kinds. It is used to report the policy as partially accepted.
+	FieldStatuses []reporter.PolicyFieldStatus
 }
 
 func (c PolicyWrapper) ResourceName() string {
@@ -333,7 +380,8 @@ func (c PolicyWrapper) Equals(in PolicyWrapper) bool {
 		return false
 	}
 
-	return versionEquals(c.Policy, in.Policy) && c.PolicyIR.Equals(in.PolicyIR) && c.PrecedenceWeight == in.PrecedenceWeight
+	return versionEquals(c.Policy, in.Policy) && c.PolicyIR.Equals(in.PolicyIR) && c.PrecedenceWeight == in.PrecedenceWeight &&
+		c.GlobalPolicyTier == in.GlobalPolicyTier && slices.Equal(c.FieldStatuses, in.FieldStatuses)
 }
 
 var ErrNotAttachable = fmt.Errorf("policy is not attachable to this object")
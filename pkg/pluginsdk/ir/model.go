@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"maps"
+	"slices"
 
 	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	"istio.io/istio/pkg/kube/krt"
@@ -102,6 +103,9 @@ type EndpointsForBackend struct {
 	Hostname             string
 	// Inherited from the backend object
 	TrafficDistribution wellknown.TrafficDistribution
+	// FailoverPriority is the ordered list of the endpoint labels used to prioritize the endpoints,
+	// in the same format as the failoverPriority of an Istio DestinationRule. It takes precedence over TrafficDistribution.
+	FailoverPriority []string
 
 	LbEpsEqualityHash uint64
 	upstreamHash      uint64
@@ -158,6 +162,7 @@ func (e EndpointsForBackend) EmptyCopy() EndpointsForBackend {
 		UpstreamResourceName: e.UpstreamResourceName,
 		Port:                 e.Port,
 		Hostname:             e.Hostname,
+		FailoverPriority:     e.FailoverPriority,
 		LbEpsEqualityHash:    e.upstreamHash,
 		upstreamHash:         e.upstreamHash,
 	}
@@ -199,5 +204,6 @@ func (c EndpointsForBackend) ResourceName() string {
 }
 
 func (c EndpointsForBackend) Equals(in EndpointsForBackend) bool {
-	return c.UpstreamResourceName == in.UpstreamResourceName && c.ClusterName == in.ClusterName && c.Port == in.Port && c.LbEpsEqualityHash == in.LbEpsEqualityHash && c.Hostname == in.Hostname
+	return c.UpstreamResourceName == in.UpstreamResourceName && c.ClusterName == in.ClusterName && c.Port == in.Port && c.LbEpsEqualityHash == in.LbEpsEqualityHash && c.Hostname == in.Hostname &&
+		slices.Equal(c.FailoverPriority, in.FailoverPriority)
 }
@@ -1,6 +1,8 @@
 package ir
 
 import (
+	"time"
+
 	"istio.io/istio/pkg/kube/krt"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -210,3 +212,37 @@ func (c *TlsRouteIR) GetHostnames() []string {
 }
 
 var _ Route = &TlsRouteIR{}
+
+type UdpRouteIR struct {
+	ObjectSource     `json:",inline"`
+	SourceObject     *gwv1alpha2.UDPRoute
+	ParentRefs       []gwv1.ParentReference
+	AttachedPolicies AttachedPolicies
+	Backends         []BackendRefIR
+
+	// IdleTimeout is the duration after which an idle UDP session is closed,
+	// nil to use the default.
+	IdleTimeout *time.Duration
+}
+
+func (c *UdpRouteIR) GetParentRefs() []gwv1.ParentReference {
+	return c.ParentRefs
+}
+
+func (c *UdpRouteIR) GetSourceObject() metav1.Object {
+	return c.SourceObject
+}
+
+func (c UdpRouteIR) ResourceName() string {
+	return c.ObjectSource.ResourceName()
+}
+
+func (c UdpRouteIR) Equals(in UdpRouteIR) bool {
+	return c.ObjectSource == in.ObjectSource &&
+		versionEquals(c.SourceObject, in.SourceObject) &&
+		c.AttachedPolicies.Equals(in.AttachedPolicies) &&
+		backendsEqual(c.Backends, in.Backends) &&
+		ptrEquals(c.IdleTimeout, in.IdleTimeout)
+}
+
+var _ Route = &UdpRouteIR{}
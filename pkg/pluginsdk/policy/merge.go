@@ -45,6 +45,10 @@ type MergeOptions struct {
 	// Merge strategy to use
 	// Defaults to AugmentedMerge
 	Strategy MergeStrategy
+
+	// SameHierarchy is true when the policies being merged belong to the same hierarchy in the config tree,
+	// i.e., there is no parent->child relationship between them
+	SameHierarchy bool
 }
 
 func ToInternalMergeStrategy(s string) MergeStrategy {
@@ -125,11 +129,30 @@ func groupPoliciesByHierarchicalPriority(policies []ir.PolicyAtt) map[int][]ir.P
 	return groups
 }
 
+// partitionPoliciesByGlobalTier splits the given policies into the policies attached from the global
+// policy namespace in the Override tier, policies without a tier, and policies in the Default tier,
+// preserving the relative order of the policies within each partition.
+func partitionPoliciesByGlobalTier(policies []ir.PolicyAtt) (overrides, regular, defaults []ir.PolicyAtt) {
+	for _, policy := range policies {
+		switch policy.GlobalPolicyTier {
+		case apiannotations.GlobalPolicyTierOverride:
+			overrides = append(overrides, policy)
+		case apiannotations.GlobalPolicyTierDefault:
+			defaults = append(defaults, policy)
+		default:
+			regular = append(regular, policy)
+		}
+	}
+	return overrides, regular, defaults
+}
+
 // mergePolicies merges the given policy ordered from high to low priority (both hierarchically
 // and within the same hierarchy) based on the constraints defined per PolicyAtt.
 //
-// It first merges policies that belong to the same hierarchy in the config tree, and then
-// merges the result of the merged policy per hierarchy into a single policy.
+// Policies attached from the global policy namespace with a GlobalPolicyTier are merged separately
+// from the remaining policies: the Override tier is preferred over all other policies and the Default
+// tier only contributes fields that are not set by any other policy. Fields are never deep merged across
+// tiers, so a field set by an Override tier policy cannot be overridden or augmented by other policies.
 func MergePolicies[T any](
 	policies []ir.PolicyAtt,
 	mergeFn func(*T, *T, *ir.AttachedPolicyRef, ir.MergeOrigins, MergeOptions, ir.MergeOrigins, string),
@@ -145,6 +168,34 @@ func MergePolicies[T any](
 		return out
 	}
 
+	overrides, regular, defaults := partitionPoliciesByGlobalTier(policies)
+	if len(overrides) == 0 && len(defaults) == 0 {
+		return mergeHierarchies(policies, mergeFn, mergeSettingsJSON)
+	}
+
+	// tiers are ordered from high to low priority
+	mergedByTier := make([]ir.PolicyAtt, 0, 3)
+	for _, tier := range [][]ir.PolicyAtt{overrides, regular, defaults} {
+		if len(tier) == 0 {
+			continue
+		}
+		mergedByTier = append(mergedByTier, mergeHierarchies(tier, mergeFn, mergeSettingsJSON))
+	}
+	if len(mergedByTier) == 1 {
+		return mergedByTier[0]
+	}
+	// tiers are shallow merged in priority order, so mergeSettings does not apply and we pass an empty string
+	return merge(mergedByTier, true, mergeFn, "")
+}
+
+// mergeHierarchies first merges policies that belong to the same hierarchy in the config tree, and then
+// merges the result of the merged policy per hierarchy into a single policy.
+func mergeHierarchies[T any](
+	policies []ir.PolicyAtt,
+	mergeFn func(*T, *T, *ir.AttachedPolicyRef, ir.MergeOrigins, MergeOptions, ir.MergeOrigins, string),
+	mergeSettingsJSON string,
+) ir.PolicyAtt {
+	var out ir.PolicyAtt
 	policiesByHierarchy := groupPoliciesByHierarchicalPriority(policies)
 	if len(policiesByHierarchy) == 0 {
 		return out
@@ -190,7 +241,8 @@ func merge[T any](
 		}
 
 		mergeOpts := MergeOptions{
-			Strategy: GetMergeStrategy(policies[i].InheritedPolicyPriority, sameHierarchy),
+			Strategy:      GetMergeStrategy(policies[i].InheritedPolicyPriority, sameHierarchy),
+			SameHierarchy: sameHierarchy,
 		}
 
 		mergeFn(merged, p2, p2Ref, policies[i].MergeOrigins, mergeOpts, out.MergeOrigins, mergeSettingsJSON)
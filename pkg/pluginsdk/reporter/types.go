@@ -1,14 +1,21 @@
 package reporter
 
 import (
+	"fmt"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwxv1alpha1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 )
 
 const (
 	PolicyAcceptedMsg = "Policy accepted"
 
+	PolicyPartiallyAcceptedMsg = "Policy accepted, but the following fields are not applied"
+
 	PolicyInvalidMsg = "Policy is invalid"
 
 	PolicyConflictWithHigherPriorityMsg = "Policy conflicts with higher priority policy"
@@ -19,6 +26,12 @@ const (
 
 	PolicyOverriddenMsg = "Overridden due to conflict with higher priority policy in target(s)"
 
+	PolicyGlobalOverrideMsg = "Attached from the global policy namespace as an Override policy"
+
+	PolicyGlobalDefaultMsg = "Attached from the global policy namespace as a Default policy"
+
+	PolicyOverriddenByGlobalMsg = "One or more fields are enforced by an Override policy in the global policy namespace"
+
 	// RouteRuleDroppedReason is used with the Accepted=False condition when the route rule is dropped.
 	RouteRuleDroppedReason = "RouteRuleDropped"
 
@@ -51,6 +64,18 @@ const (
 	// PolicyAttachmentStateOverridden indicates that the policy conflicts with higher priority policies
 	// and was fully overridden
 	PolicyAttachmentStateOverridden
+
+	// PolicyAttachmentStateGlobalOverride indicates that the policy was attached from the global policy
+	// namespace in the Override tier
+	PolicyAttachmentStateGlobalOverride
+
+	// PolicyAttachmentStateGlobalDefault indicates that the policy was attached from the global policy
+	// namespace in the Default tier
+	PolicyAttachmentStateGlobalDefault
+
+	// PolicyAttachmentStateOverriddenByGlobal indicates that the policy conflicts with a policy attached
+	// from the global policy namespace in the Override tier
+	PolicyAttachmentStateOverriddenByGlobal
 )
 
 // Has checks if the existing state has the given state
@@ -58,6 +83,51 @@ func (a PolicyAttachmentState) Has(b PolicyAttachmentState) bool {
 	return a&b != 0
 }
 
+// PolicyFieldReason is the reason a policy field is not applied
+type PolicyFieldReason string
+
+const (
+	// PolicyFieldReasonIgnored indicates that the field is not applicable to the targeted resources or data plane
+	PolicyFieldReasonIgnored PolicyFieldReason = "Ignored"
+
+	// PolicyFieldReasonInvalid indicates that the field is invalid
+	PolicyFieldReasonInvalid PolicyFieldReason = "Invalid"
+)
+
+// PolicyFieldStatus describes a field of a policy that is not applied
+type PolicyFieldStatus struct {
+	// Field is the name of the field as used in the policy spec, e.g., "timeouts"
+	Field string
+	// Reason is the reason the field is not applied
+	Reason PolicyFieldReason
+	// Message is a human readable explanation of why the field is not applied
+	Message string
+}
+
+func (s PolicyFieldStatus) String() string {
+	return fmt.Sprintf("%s (%s: %s)", s.Field, s.Reason, s.Message)
+}
+
+// PartiallyAcceptedCondition returns the PartiallyAccepted condition describing the given fields that are not applied.
+// The reason is FieldsInvalid if any field is invalid, and FieldsIgnored otherwise.
+func PartiallyAcceptedCondition(fields []PolicyFieldStatus, observedGeneration int64) PolicyCondition {
+	reason := v1alpha1.PolicyReasonFieldsIgnored
+	msgs := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f.Reason == PolicyFieldReasonInvalid {
+			reason = v1alpha1.PolicyReasonFieldsInvalid
+		}
+		msgs = append(msgs, f.String())
+	}
+	return PolicyCondition{
+		Type:               string(v1alpha1.PolicyConditionPartiallyAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(reason),
+		Message:            PolicyPartiallyAcceptedMsg + ": " + strings.Join(msgs, "; "),
+		ObservedGeneration: observedGeneration,
+	}
+}
+
 type PolicyCondition struct {
 	Type               string
 	Status             metav1.ConditionStatus
@@ -103,6 +173,9 @@ type AncestorRefReporter interface {
 	SetAttachmentState(
 		state PolicyAttachmentState,
 	)
+	// AddFieldStatuses records fields of the policy that are not applied for the ancestor, which
+	// are reported with the PartiallyAccepted condition when the policy is accepted.
+	AddFieldStatuses(fields ...PolicyFieldStatus)
 }
 
 type PolicyReporter interface {
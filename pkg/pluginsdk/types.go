@@ -48,6 +48,10 @@ type (
 type PolicyPlugin struct {
 	Name                      string
 	NewGatewayTranslationPass func(tctx ir.GwTranslationCtx, reporter reporter.Reporter) ir.ProxyTranslationPass
+	// ApplyBackendPoliciesToRoutes makes the gateway translation pass also apply the policies
+	// attached to a backend object (e.g. a Service) to every route backend referencing it,
+	// by invoking ApplyForRouteBackend with them.
+	ApplyBackendPoliciesToRoutes bool
 
 	// Backend processing for envoy proxy
 	ProcessBackend            ProcessBackend
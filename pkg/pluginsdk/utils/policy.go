@@ -9,6 +9,7 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 )
@@ -59,6 +60,19 @@ func TargetRefsToPolicyRefsWithSectionName(
 	return refs
 }
 
+func TargetRefsToPolicyRefsV1(targetRefs []v1.LocalPolicyTargetReference) []ir.PolicyRef {
+	refs := make([]ir.PolicyRef, 0, len(targetRefs))
+	for _, targetRef := range targetRefs {
+		refs = append(refs, ir.PolicyRef{
+			Group: string(targetRef.Group),
+			Kind:  string(targetRef.Kind),
+			Name:  string(targetRef.Name),
+		})
+	}
+
+	return refs
+}
+
 func TargetRefsToPolicyRefsWithSectionNameV1(targetRefs []v1.LocalPolicyTargetReferenceWithSectionName) []ir.PolicyRef {
 	refs := make([]ir.PolicyRef, 0, len(targetRefs))
 	for _, targetRef := range targetRefs {
@@ -102,3 +116,24 @@ func ParsePrecedenceWeightAnnotation(
 	}
 	return int32(weight), nil
 }
+
+// ParseGlobalPolicyTierAnnotation parses the global policy tier from the annotations of a policy in the given namespace.
+// An empty value is returned when the annotation is not set, or when the policy is not in the global policy namespace
+// as the annotation is ignored there.
+func ParseGlobalPolicyTierAnnotation(
+	annotations map[string]string,
+	namespace string,
+	globalPolicyNamespace string,
+) (apiannotations.GlobalPolicyTierValue, error) {
+	val, ok := annotations[apiannotations.GlobalPolicyTier]
+	if !ok || globalPolicyNamespace == "" || namespace != globalPolicyNamespace {
+		return "", nil
+	}
+	switch v := apiannotations.GlobalPolicyTierValue(val); v {
+	case apiannotations.GlobalPolicyTierOverride, apiannotations.GlobalPolicyTierDefault:
+		return v, nil
+	default:
+		return "", fmt.Errorf("invalid value for annotation %s: %s; must be one of %s or %s",
+			apiannotations.GlobalPolicyTier, val, apiannotations.GlobalPolicyTierOverride, apiannotations.GlobalPolicyTierDefault)
+	}
+}
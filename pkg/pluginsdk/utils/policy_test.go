@@ -103,3 +103,75 @@ func TestParsePrecedenceWeightAnnotation(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGlobalPolicyTierAnnotation(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		namespace   string
+		expected    apiannotations.GlobalPolicyTierValue
+		expectError bool
+	}{
+		{
+			name:        "No annotation",
+			annotations: map[string]string{},
+			namespace:   "kgateway-system",
+			expected:    "",
+		},
+		{
+			name: "Override tier",
+			annotations: map[string]string{
+				apiannotations.GlobalPolicyTier: "Override",
+			},
+			namespace: "kgateway-system",
+			expected:  apiannotations.GlobalPolicyTierOverride,
+		},
+		{
+			name: "Default tier",
+			annotations: map[string]string{
+				apiannotations.GlobalPolicyTier: "Default",
+			},
+			namespace: "kgateway-system",
+			expected:  apiannotations.GlobalPolicyTierDefault,
+		},
+		{
+			name: "Invalid tier",
+			annotations: map[string]string{
+				apiannotations.GlobalPolicyTier: "override",
+			},
+			namespace:   "kgateway-system",
+			expectError: true,
+		},
+		{
+			name: "Ignored outside of the global policy namespace",
+			annotations: map[string]string{
+				apiannotations.GlobalPolicyTier: "Override",
+			},
+			namespace: "default",
+			expected:  "",
+		},
+		{
+			name: "Invalid tier ignored outside of the global policy namespace",
+			annotations: map[string]string{
+				apiannotations.GlobalPolicyTier: "override",
+			},
+			namespace: "default",
+			expected:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+			tier, err := ParseGlobalPolicyTierAnnotation(tt.annotations, tt.namespace, "kgateway-system")
+
+			if tt.expectError {
+				a.Error(err)
+				a.Empty(tier)
+				return
+			}
+			a.NoError(err)
+			a.Equal(tt.expected, tier)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 type AncestorRefReport struct {
 	Conditions      []metav1.Condition
 	AttachmentState reporter.PolicyAttachmentState
+	FieldStatuses   []reporter.PolicyFieldStatus
 }
 
 type PolicyReport struct {
@@ -46,6 +47,14 @@ func (prr *AncestorRefReport) SetAttachmentState(
 	prr.AttachmentState |= state
 }
 
+func (prr *AncestorRefReport) AddFieldStatuses(fields ...reporter.PolicyFieldStatus) {
+	for _, f := range fields {
+		if !slices.Contains(prr.FieldStatuses, f) {
+			prr.FieldStatuses = append(prr.FieldStatuses, f)
+		}
+	}
+}
+
 func (r *statusReporter) Policy(key reporter.PolicyKey, observedGeneration int64) reporter.PolicyReporter {
 	pr := r.report.policy(key)
 	if pr == nil {
@@ -135,6 +144,7 @@ func (r *ReportMap) BuildPolicyStatus(
 
 		// Build and append the Attached Condition.Type
 		existingConditions := addAttachmentCondition(parentStatusReport)
+		existingConditions = addPartiallyAcceptedCondition(parentStatusReport, existingConditions)
 
 		finalConditions := make([]metav1.Condition, 0, len(existingConditions))
 		for _, pCondition := range existingConditions {
@@ -147,8 +157,13 @@ func (r *ReportMap) BuildPolicyStatus(
 			meta.SetStatusCondition(&finalConditions, pCondition)
 		}
 		// If there are conditions on the route that are not owned by our reporter, include
-		// them in the final list of conditions to preserve conditions we do not own
+		// them in the final list of conditions to preserve conditions we do not own.
+		// The PartiallyAccepted condition is owned by our reporter but only reported when
+		// some fields are not applied, so it must not be preserved once all fields are applied.
 		for _, condition := range currentParentRefConditions {
+			if condition.Type == string(v1alpha1.PolicyConditionPartiallyAccepted) {
+				continue
+			}
 			if meta.FindStatusCondition(finalConditions, condition.Type) == nil {
 				finalConditions = append(finalConditions, condition)
 			}
@@ -232,6 +247,26 @@ func addMissingAncestorRefConditions(report *AncestorRefReport) {
 	}
 }
 
+// addPartiallyAcceptedCondition adds the PartiallyAccepted condition describing the fields that are
+// not applied to the conditions if the policy is accepted.
+func addPartiallyAcceptedCondition(report *AncestorRefReport, conditions []metav1.Condition) []metav1.Condition {
+	if len(report.FieldStatuses) == 0 ||
+		!meta.IsStatusConditionTrue(conditions, string(v1alpha1.PolicyConditionAccepted)) {
+		return conditions
+	}
+
+	// avoid modifying the existing Conditions on the report
+	existing := slices.Clone(conditions)
+	c := reporter.PartiallyAcceptedCondition(report.FieldStatuses, 0)
+	meta.SetStatusCondition(&existing, metav1.Condition{
+		Type:    c.Type,
+		Status:  c.Status,
+		Reason:  c.Reason,
+		Message: c.Message,
+	})
+	return existing
+}
+
 func addAttachmentCondition(report *AncestorRefReport) []metav1.Condition {
 	if report.AttachmentState == reporter.PolicyAttachmentStatePending {
 		// no attachment state set, return the conditions as is
@@ -267,5 +302,28 @@ func addAttachmentCondition(report *AncestorRefReport) []metav1.Condition {
 		})
 	}
 
+	// surface the effective origin of the attachment when global policy tiers are involved
+	if origin := globalPolicyOriginMessage(report.AttachmentState); origin != "" {
+		if cond := meta.FindStatusCondition(existing, string(v1alpha1.PolicyConditionAttached)); cond != nil {
+			cond.Message = cond.Message + "; " + origin
+		}
+	}
+
 	return existing
 }
+
+// globalPolicyOriginMessage returns a message describing how global policy tiers affected the attachment
+// for the given state, or an empty string if no global policy tier was involved.
+func globalPolicyOriginMessage(state reporter.PolicyAttachmentState) string {
+	var msgs []string
+	if state.Has(reporter.PolicyAttachmentStateGlobalOverride) {
+		msgs = append(msgs, reporter.PolicyGlobalOverrideMsg)
+	}
+	if state.Has(reporter.PolicyAttachmentStateGlobalDefault) {
+		msgs = append(msgs, reporter.PolicyGlobalDefaultMsg)
+	}
+	if state.Has(reporter.PolicyAttachmentStateOverriddenByGlobal) {
+		msgs = append(msgs, reporter.PolicyOverriddenByGlobalMsg)
+	}
+	return strings.Join(msgs, "; ")
+}
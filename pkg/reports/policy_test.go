@@ -6,6 +6,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -408,3 +409,62 @@ func TestPolicyStatusReport(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyStatusReportPartiallyAccepted(t *testing.T) {
+	key := reporter.PolicyKey{
+		Group:     "example.com",
+		Kind:      "Policy",
+		Namespace: "default",
+		Name:      "example",
+	}
+	ancestorRef := gwv1.ParentReference{
+		Group:     ptr.To(gwv1.Group("gateway.networking.k8s.io")),
+		Kind:      ptr.To(gwv1.Kind("Gateway")),
+		Namespace: ptr.To(gwv1.Namespace("default")),
+		Name:      gwv1.ObjectName("gw-1"),
+	}
+	fields := []reporter.PolicyFieldStatus{
+		{Field: "timeouts", Reason: reporter.PolicyFieldReasonIgnored, Message: "only applicable to HTTPRoute targets"},
+		{Field: "rbac", Reason: reporter.PolicyFieldReasonInvalid, Message: "invalid CEL expression"},
+	}
+
+	translate := func(accepted metav1.ConditionStatus, fields []reporter.PolicyFieldStatus) ReportMap {
+		rm := NewReportMap()
+		r := NewReporter(&rm).Policy(key, 1).AncestorRef(ancestorRef)
+		r.SetCondition(reporter.PolicyCondition{
+			Type:    string(v1alpha1.PolicyConditionAccepted),
+			Status:  accepted,
+			Reason:  string(v1alpha1.PolicyReasonValid),
+			Message: reporter.PolicyAcceptedMsg,
+		})
+		// fields reported by several translation passes are reported once
+		r.AddFieldStatuses(fields...)
+		r.AddFieldStatuses(fields...)
+		r.SetAttachmentState(reporter.PolicyAttachmentStateAttached)
+		return rm
+	}
+
+	a := assert.New(t)
+
+	rm := translate(metav1.ConditionTrue, fields)
+	status := rm.BuildPolicyStatus(t.Context(), key, "example-controller", gwv1.PolicyStatus{})
+	a.Len(status.Ancestors, 1)
+	cond := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(v1alpha1.PolicyConditionPartiallyAccepted))
+	a.NotNil(cond)
+	a.Equal(metav1.ConditionTrue, cond.Status)
+	a.Equal(string(v1alpha1.PolicyReasonFieldsInvalid), cond.Reason)
+	a.Equal(reporter.PolicyPartiallyAcceptedMsg+": timeouts (Ignored: only applicable to HTTPRoute targets); rbac (Invalid: invalid CEL expression)", cond.Message)
+
+	// the condition is removed once all fields are applied
+	rm = translate(metav1.ConditionTrue, nil)
+	status = rm.BuildPolicyStatus(t.Context(), key, "example-controller", *status)
+	a.Len(status.Ancestors, 1)
+	a.Nil(meta.FindStatusCondition(status.Ancestors[0].Conditions, string(v1alpha1.PolicyConditionPartiallyAccepted)))
+	a.NotNil(meta.FindStatusCondition(status.Ancestors[0].Conditions, string(v1alpha1.PolicyConditionAccepted)))
+
+	// the condition is not reported for a policy that is not accepted
+	rm = translate(metav1.ConditionFalse, fields)
+	status = rm.BuildPolicyStatus(t.Context(), key, "example-controller", gwv1.PolicyStatus{})
+	a.Len(status.Ancestors, 1)
+	a.Nil(meta.FindStatusCondition(status.Ancestors[0].Conditions, string(v1alpha1.PolicyConditionPartiallyAccepted)))
+}
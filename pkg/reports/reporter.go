@@ -22,6 +22,7 @@ type ReportMap struct {
 	GRPCRoutes   map[types.NamespacedName]*RouteReport
 	TCPRoutes    map[types.NamespacedName]*RouteReport
 	TLSRoutes    map[types.NamespacedName]*RouteReport
+	UDPRoutes    map[types.NamespacedName]*RouteReport
 	Policies     map[reporter.PolicyKey]*PolicyReport
 }
 
@@ -65,6 +66,7 @@ func NewReportMap() ReportMap {
 		GRPCRoutes:   make(map[types.NamespacedName]*RouteReport),
 		TCPRoutes:    make(map[types.NamespacedName]*RouteReport),
 		TLSRoutes:    make(map[types.NamespacedName]*RouteReport),
+		UDPRoutes:    make(map[types.NamespacedName]*RouteReport),
 		Policies:     make(map[reporter.PolicyKey]*PolicyReport),
 	}
 }
@@ -131,6 +133,7 @@ func (r *ReportMap) newListenerSetReport(listenerSet *gwxv1alpha1.XListenerSet)
 // * HTTPRoute
 // * TCPRoute
 // * TLSRoute
+// * UDPRoute
 // * GRPCRoute
 func (r *ReportMap) route(obj metav1.Object) *RouteReport {
 	key := key(obj)
@@ -142,6 +145,8 @@ func (r *ReportMap) route(obj metav1.Object) *RouteReport {
 		return r.TCPRoutes[key]
 	case *gwv1alpha2.TLSRoute:
 		return r.TLSRoutes[key]
+	case *gwv1alpha2.UDPRoute:
+		return r.UDPRoutes[key]
 	case *gwv1.GRPCRoute:
 		return r.GRPCRoutes[key]
 	default:
@@ -164,6 +169,8 @@ func (r *ReportMap) newRouteReport(obj metav1.Object) *RouteReport {
 		r.TCPRoutes[key] = rr
 	case *gwv1alpha2.TLSRoute:
 		r.TLSRoutes[key] = rr
+	case *gwv1alpha2.UDPRoute:
+		r.UDPRoutes[key] = rr
 	case *gwv1.GRPCRoute:
 		r.GRPCRoutes[key] = rr
 	default:
@@ -234,7 +234,7 @@ func (r *ReportMap) BuildListenerSetStatus(ctx context.Context, ls gwxv1a1.XList
 // along with the newly built kgw status per ReportMap, sorted in deterministic fashion.
 // If the ReportMap does not have a RouteReport for the given route, e.g. because it did not encounter
 // the route during translation, or the object is an unsupported route kind, nil is returned.
-// Supported route types are: HTTPRoute, TCPRoute, TLSRoute, GRPCRoute
+// Supported route types are: HTTPRoute, TCPRoute, TLSRoute, UDPRoute, GRPCRoute
 func (r *ReportMap) BuildRouteStatus(
 	ctx context.Context,
 	obj client.Object,
@@ -273,6 +273,12 @@ func (r *ReportMap) BuildRouteStatus(
 		if len(parentRefs) == 0 {
 			parentRefs = append(parentRefs, routeReport.parentRefs()...)
 		}
+	case *gwv1a2.UDPRoute:
+		existingStatus = route.Status.RouteStatus
+		parentRefs = append(parentRefs, route.Spec.ParentRefs...)
+		if len(parentRefs) == 0 {
+			parentRefs = append(parentRefs, routeReport.parentRefs()...)
+		}
 	case *gwv1.GRPCRoute:
 		existingStatus = route.Status.RouteStatus
 		parentRefs = append(parentRefs, route.Spec.ParentRefs...)
@@ -0,0 +1,73 @@
+import json
+import re
+from dataclasses import dataclass, field
+from typing import List, Optional
+
+
+@dataclass
+class ModelRoute:
+    models: List[str] = field(default_factory=list)
+    provider: Optional[str] = None
+    model: Optional[str] = None
+    _patterns: List[re.Pattern] = field(default_factory=list, repr=False)
+
+    def __post_init__(self):
+        self._patterns = [
+            re.compile(
+                "^" + ".*".join(re.escape(part) for part in model.split("*")) + "$"
+            )
+            for model in self.models
+        ]
+
+    def matches(self, model: str) -> bool:
+        return any(pattern.match(model) for pattern in self._patterns)
+
+    @staticmethod
+    def from_json(data: dict) -> "ModelRoute":
+        return ModelRoute(
+            models=data.get("models", []),
+            provider=data.get("provider"),
+            model=data.get("model"),
+        )
+
+
+@dataclass
+class UnknownModelResponse:
+    message: Optional[str] = "The requested model is not supported"
+    status_code: Optional[int] = 404
+
+    @staticmethod
+    def from_json(data: dict) -> "UnknownModelResponse":
+        return UnknownModelResponse(
+            message=data.get("message", "The requested model is not supported"),
+            status_code=data.get("statusCode", 404),
+        )
+
+
+@dataclass
+class ModelRouting:
+    routes: List[ModelRoute] = field(default_factory=list)
+    unknown_model_response: Optional[UnknownModelResponse] = None
+
+    def route(self, model: str) -> ModelRoute | None:
+        """
+        route returns the first route matching the requested model, or None if the
+        model does not match any route.
+        """
+        for route in self.routes:
+            if route.matches(model):
+                return route
+        return None
+
+
+def model_routing_from_json(data: str) -> ModelRouting:
+    routing_data = json.loads(data)
+
+    unknown_model_response = None
+    if (unknown := routing_data.get("unknownModelResponse")) is not None:
+        unknown_model_response = UnknownModelResponse.from_json(unknown)
+
+    return ModelRouting(
+        routes=[ModelRoute.from_json(r) for r in routing_data.get("routes", [])],
+        unknown_model_response=unknown_model_response,
+    )
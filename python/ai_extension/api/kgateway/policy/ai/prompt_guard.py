@@ -134,18 +134,58 @@ class Moderation:
         return Moderation()
 
 
+class PIIEntityType(Enum):
+    PERSON = "PERSON"
+    LOCATION = "LOCATION"
+    NATIONAL_ID = "NATIONAL_ID"
+    IBAN = "IBAN"
+    API_KEY = "API_KEY"
+
+
+class PIIAction(Enum):
+    MASK = "MASK"
+    HASH = "HASH"
+    REJECT = "REJECT"
+
+
+@dataclass
+class PIIEntity:
+    type: PIIEntityType
+    action: PIIAction = PIIAction.MASK
+
+    @staticmethod
+    def from_json(data: dict) -> "PIIEntity":
+        return PIIEntity(
+            type=PIIEntityType(data["type"]),
+            action=PIIAction(data.get("action", "MASK")),
+        )
+
+
+@dataclass
+class PIIDetection:
+    entities: List[PIIEntity] = field(default_factory=list)
+
+    @staticmethod
+    def from_json(data: dict) -> "PIIDetection":
+        return PIIDetection(
+            entities=[PIIEntity.from_json(e) for e in data.get("entities", [])]
+        )
+
+
 @dataclass
 class PromptguardRequest:
     custom_response: Optional[CustomResponse] = None
     regex: Optional[Regex] = None
     webhook: Optional[Webhook] = None
     moderation: Optional[Moderation] = None
+    pii: Optional[PIIDetection] = None
 
 
 @dataclass
 class PromptguardResponse:
     regex: Optional[Regex] = None
     webhook: Optional[Webhook] = None
+    pii: Optional[PIIDetection] = None
 
 
 def resp_from_json(data: str) -> PromptguardResponse:
@@ -162,9 +202,15 @@ def resp_from_json(data: str) -> PromptguardResponse:
     if webhook_data:
         webhook = Webhook.from_json(webhook_data)
 
+    pii_data = response_data.get("pii")
+    pii = None
+    if pii_data:
+        pii = PIIDetection.from_json(pii_data)
+
     return PromptguardResponse(
         regex=regex,
         webhook=webhook,
+        pii=pii,
     )
 
 
@@ -191,9 +237,15 @@ def req_from_json(data: str) -> PromptguardRequest:
     if regex_data:
         regex = Regex.from_json(regex_data)
 
+    pii_data = request_data.get("pii")
+    pii = None
+    if pii_data:
+        pii = PIIDetection.from_json(pii_data)
+
     return PromptguardRequest(
         custom_response=custom_response,
         regex=regex,
         webhook=webhook,
         moderation=moderation,
+        pii=pii,
     )
@@ -0,0 +1,72 @@
+import json
+from dataclasses import dataclass, field
+from typing import Optional
+
+
+@dataclass
+class RedisVectorStore:
+    host: str
+    port: int
+    index: str = "kgateway-semantic-cache"
+    password: Optional[str] = None
+
+    @staticmethod
+    def from_json(data: dict) -> "RedisVectorStore":
+        return RedisVectorStore(
+            host=data.get("host", ""),
+            port=data.get("port", 6379),
+            index=data.get("index", "kgateway-semantic-cache"),
+            password=data.get("password"),
+        )
+
+
+@dataclass
+class OpenAIEmbedding:
+    auth_token: str
+    model: str = "text-embedding-3-small"
+
+    @staticmethod
+    def from_json(data: dict) -> "OpenAIEmbedding":
+        return OpenAIEmbedding(
+            auth_token=data.get("authToken", ""),
+            model=data.get("model", "text-embedding-3-small"),
+        )
+
+
+@dataclass
+class Embedding:
+    openai: Optional[OpenAIEmbedding] = None
+
+    @staticmethod
+    def from_json(data: dict) -> "Embedding":
+        openai = None
+        if (openai_data := data.get("openai")) is not None:
+            openai = OpenAIEmbedding.from_json(openai_data)
+        return Embedding(openai=openai)
+
+
+@dataclass
+class SemanticCacheConfig:
+    redis: Optional[RedisVectorStore] = None
+    embedding: Embedding = field(default_factory=Embedding)
+    similarity_threshold: float = 0.95
+    ttl_seconds: int = 3600
+    # Whether the cached responses are partitioned by a value of the request, such as a header
+    # or a JWT claim. The requests without this value are not cached.
+    partition_by_key: bool = False
+
+
+def semantic_cache_from_json(data: str) -> SemanticCacheConfig:
+    cache_data = json.loads(data)
+
+    redis = None
+    if (redis_data := cache_data.get("redis")) is not None:
+        redis = RedisVectorStore.from_json(redis_data)
+
+    return SemanticCacheConfig(
+        redis=redis,
+        embedding=Embedding.from_json(cache_data.get("embedding", {})),
+        similarity_threshold=cache_data.get("similarityThreshold", 0.95),
+        ttl_seconds=cache_data.get("ttlSeconds", 3600),
+        partition_by_key=cache_data.get("partitionByKey", False),
+    )
@@ -22,6 +22,15 @@ ANTHROPIC_LLM_STR: Final[str] = "anthropic"
 GEMINI_LLM_STR: Final[str] = "gemini"
 VERTEX_AI_LLM_STR: Final[str] = "vertex-ai"
 
+# Route types from the x-route-type header value
+CHAT_ROUTE_TYPE: Final[str] = "CHAT"
+COMPLETIONS_ROUTE_TYPE: Final[str] = "COMPLETIONS"
+EMBEDDINGS_ROUTE_TYPE: Final[str] = "EMBEDDINGS"
+RESPONSES_ROUTE_TYPE: Final[str] = "RESPONSES"
+IMAGES_ROUTE_TYPE: Final[str] = "IMAGES"
+# AUTO_ROUTE_TYPE detects the route type from the request path
+AUTO_ROUTE_TYPE: Final[str] = "AUTO"
+
 
 @dataclass
 class TokensDetails:
@@ -721,6 +730,563 @@ class OpenAI(Provider):
             return StreamChunkDataType.INVALID
 
 
+class OpenAICompletions(OpenAI):
+    """
+    OpenAICompletions handles the legacy text completions API (/v1/completions), where
+    the request has a "prompt" and the response "choices" have a "text".
+    """
+
+    def has_function_call_finish_reason(self, body: dict) -> bool:
+        return False
+
+    def get_num_tokens_from_body(self, body: dict) -> int:
+        return num_tokens_from_input(body.get("prompt", ""))
+
+    def iterate_str_req_messages(self, body: dict, cb: Callable[[str, str], str]):
+        iterate_str_input(body, "prompt", "user", cb)
+
+    def iterate_str_resp_messages(self, body: dict, cb: Callable[[str, str], str]):
+        for choice in body.get("choices", []):
+            if isinstance(choice.get("text"), str):
+                choice["text"] = cb("assistant", choice["text"])
+
+    def all_req_content(self, body: dict) -> str:
+        return "\n".join(str_inputs(body.get("prompt", "")))
+
+    def construct_request_webhook_request_body(
+        self, body: dict
+    ) -> webhook_api.PromptMessages:
+        return input_webhook_request_body(body, "prompt")
+
+    def update_request_body_from_webhook(
+        self, original_body: dict, webhook_modified_messages: webhook_api.PromptMessages
+    ):
+        update_input_from_webhook(original_body, "prompt", webhook_modified_messages)
+
+    def construct_response_webhook_request_body(
+        self, body: dict
+    ) -> webhook_api.ResponseChoices:
+        response_choices = webhook_api.ResponseChoices()
+        for choice in body.get("choices", []):
+            response_choices.choices.append(
+                webhook_api.ResponseChoice(
+                    message=webhook_api.Message(
+                        role="assistant", content=choice.get("text", "")
+                    )
+                )
+            )
+        return response_choices
+
+    def update_response_body_from_webhook(
+        self,
+        original_body: dict,
+        webhook_modified_messages: webhook_api.ResponseChoices,
+    ):
+        choices = original_body.get("choices", [])
+        if len(choices) != len(webhook_modified_messages.choices):
+            logger.error(
+                "webhook modified messages do not match the original choices array size!"
+            )
+            return
+
+        for i, modified_content in enumerate(webhook_modified_messages.choices):
+            if "text" in choices[i]:
+                choices[i]["text"] = modified_content.message.content
+
+    def extract_contents_from_resp_chunk(self, json_data) -> List[bytes] | None:
+        if json_data is None:
+            return None
+
+        contents: List[bytes] = []
+        for choice in json_data.get("choices", []):
+            if isinstance(choice.get("text"), str):
+                index = choice.get("index", 0)
+                contents.extend([b""] * (index + 1 - len(contents)))
+                contents[index] = choice["text"].encode("utf-8")
+
+        if len(contents) == 0:
+            return None
+
+        return contents
+
+    def update_stream_resp_contents(self, json_data, choice_index: int, content: bytes):
+        if json_data is None or not self.has_choice_index(json_data, choice_index):
+            logger.warning(
+                f"update_stream_resp_contents() called but does not have choice_index: {choice_index} content: {content}"
+            )
+            return None
+
+        for choice in json_data["choices"]:
+            if choice.get("index", 0) == choice_index and "text" in choice:
+                choice["text"] = content.decode("utf-8")
+
+    def get_stream_resp_chunk_type(
+        self, json_data: Dict[str, Any]
+    ) -> StreamChunkDataType:
+        choices = json_data.get("choices", [])
+        if len(choices) == 0:
+            if json_data.get("usage", None) is not None:
+                return StreamChunkDataType.LAST_USAGE
+            return StreamChunkDataType.UNKNOWN
+
+        has_content = choices[0].get("text", "") != ""
+        if choices[0].get("finish_reason") is not None:
+            if has_content:
+                return StreamChunkDataType.FINISH
+            return StreamChunkDataType.FINISH_NO_CONTENT
+        return StreamChunkDataType.NORMAL_TEXT
+
+
+class OpenAIEmbeddings(OpenAI):
+    """
+    OpenAIEmbeddings handles the embeddings API (/v1/embeddings), where the request
+    has an "input" and the response has no text, so response guards do not apply.
+    """
+
+    def has_function_call_finish_reason(self, body: dict) -> bool:
+        return False
+
+    def get_num_tokens_from_body(self, body: dict) -> int:
+        return num_tokens_from_input(body.get("input", ""))
+
+    def iterate_str_req_messages(self, body: dict, cb: Callable[[str, str], str]):
+        iterate_str_input(body, "input", "user", cb)
+
+    def iterate_str_resp_messages(self, body: dict, cb: Callable[[str, str], str]):
+        # embeddings have no text content
+        pass
+
+    def all_req_content(self, body: dict) -> str:
+        return "\n".join(str_inputs(body.get("input", "")))
+
+    def construct_request_webhook_request_body(
+        self, body: dict
+    ) -> webhook_api.PromptMessages:
+        return input_webhook_request_body(body, "input")
+
+    def update_request_body_from_webhook(
+        self, original_body: dict, webhook_modified_messages: webhook_api.PromptMessages
+    ):
+        update_input_from_webhook(original_body, "input", webhook_modified_messages)
+
+    def construct_response_webhook_request_body(
+        self, body: dict
+    ) -> webhook_api.ResponseChoices:
+        return webhook_api.ResponseChoices()
+
+    def update_response_body_from_webhook(
+        self,
+        original_body: dict,
+        webhook_modified_messages: webhook_api.ResponseChoices,
+    ):
+        pass
+
+
+class OpenAIResponses(OpenAI):
+    """
+    OpenAIResponses handles the OpenAI Responses API (/v1/responses), where the request
+    has "instructions" and an "input" of messages, and the response has an "output" of items.
+    The streaming response is a sequence of typed events, the text is streamed by the
+    response.output_text.delta events and the usage is sent by the response.completed event.
+    """
+
+    def tokens(self, jsn: dict) -> Tokens:
+        usage = jsn.get("usage")
+        if usage is None and isinstance(jsn.get("response"), dict):
+            # streaming events hold the response, the usage is only set on the response.completed event
+            usage = jsn["response"].get("usage")
+        return tokens_from_input_output_usage(usage)
+
+    def create_usage_json(self, tokens: Tokens) -> Dict[str, Any]:
+        usage: Dict[str, Any] = {
+            "input_tokens": tokens.prompt,
+            "output_tokens": tokens.completion,
+            "total_tokens": tokens.total_tokens(),
+        }
+        if tokens.prompt_details is not None:
+            usage["input_tokens_details"] = {
+                "cached_tokens": tokens.prompt_details.cached
+            }
+        if tokens.completion_details is not None:
+            usage["output_tokens_details"] = {
+                "reasoning_tokens": tokens.completion_details.reasoning
+            }
+        return usage
+
+    def update_stream_resp_usage_token(self, json_data: Dict[str, Any], tokens: Tokens):
+        if isinstance(json_data.get("response"), dict):
+            json_data["response"]["usage"] = self.create_usage_json(tokens)
+        else:
+            json_data["usage"] = self.create_usage_json(tokens)
+
+    def get_model_resp(self, body_jsn: dict) -> str:
+        if isinstance(body_jsn.get("response"), dict):
+            return body_jsn["response"].get("model", "")
+        return body_jsn.get("model", "")
+
+    def get_attributes_for_response_body(self, body: dict) -> Attributes:
+        tokens = self.tokens(body)
+        return {
+            gen_ai_attributes.GEN_AI_RESPONSE_ID: body.get("id", ""),
+            gen_ai_attributes.GEN_AI_RESPONSE_MODEL: self.get_model_resp(body),
+            gen_ai_attributes.GEN_AI_RESPONSE_FINISH_REASONS: body.get("status", ""),
+            gen_ai_attributes.GEN_AI_USAGE_INPUT_TOKENS: tokens.prompt,
+            gen_ai_attributes.GEN_AI_USAGE_OUTPUT_TOKENS: tokens.completion,
+        }
+
+    def has_function_call_finish_reason(self, body: dict) -> bool:
+        for item in body.get("output", []):
+            if item.get("type") == "function_call":
+                return True
+        # streaming events hold the output item being added
+        if isinstance(body.get("item"), dict):
+            return body["item"].get("type") == "function_call"
+        return False
+
+    def get_num_tokens_from_body(self, body: dict) -> int:
+        return sum(
+            num_tokens_from_input(text)
+            for _, text in self._req_texts(body, lambda role, text: text)
+        )
+
+    def iterate_str_req_messages(self, body: dict, cb: Callable[[str, str], str]):
+        self._req_texts(body, cb)
+
+    def iterate_str_resp_messages(self, body: dict, cb: Callable[[str, str], str]):
+        for item in body.get("output", []):
+            if item.get("type") != "message":
+                continue
+            for content in item.get("content", []):
+                if content.get("type") == "output_text":
+                    content["text"] = cb(item.get("role", "assistant"), content["text"])
+
+    def all_req_content(self, body: dict) -> str:
+        return "\n".join(
+            f"role: {role}:\n{text}"
+            for role, text in self._req_texts(body, lambda role, text: text)
+        )
+
+    def construct_request_webhook_request_body(
+        self, body: dict
+    ) -> webhook_api.PromptMessages:
+        prompt_messages = webhook_api.PromptMessages()
+        for role, text in self._req_texts(body, lambda role, text: text):
+            prompt_messages.messages.append(
+                webhook_api.Message(role=role, content=text)
+            )
+        return prompt_messages
+
+    def update_request_body_from_webhook(
+        self, original_body: dict, webhook_modified_messages: webhook_api.PromptMessages
+    ):
+        num_texts = len(self._req_texts(original_body, lambda role, text: text))
+        if num_texts != len(webhook_modified_messages.messages):
+            logger.error(
+                "webhook modified messages do not match the original messages array size!"
+            )
+            return
+
+        modified = iter(webhook_modified_messages.messages)
+        self._req_texts(original_body, lambda role, text: next(modified).content)
+
+    def construct_response_webhook_request_body(
+        self, body: dict
+    ) -> webhook_api.ResponseChoices:
+        response_choices = webhook_api.ResponseChoices()
+
+        def append_choice(role: str, text: str) -> str:
+            response_choices.choices.append(
+                webhook_api.ResponseChoice(
+                    message=webhook_api.Message(role=role, content=text)
+                )
+            )
+            return text
+
+        self.iterate_str_resp_messages(body, append_choice)
+        return response_choices
+
+    def update_response_body_from_webhook(
+        self,
+        original_body: dict,
+        webhook_modified_messages: webhook_api.ResponseChoices,
+    ):
+        num_texts = len(self.construct_response_webhook_request_body(original_body).choices)
+        if num_texts != len(webhook_modified_messages.choices):
+            logger.error(
+                "webhook modified messages do not match the original choices array size!"
+            )
+            return
+
+        modified = iter(webhook_modified_messages.choices)
+        self.iterate_str_resp_messages(
+            original_body, lambda role, text: next(modified).message.content
+        )
+
+    def _req_texts(
+        self, body: dict, cb: Callable[[str, str], str]
+    ) -> List[tuple[str, str]]:
+        """
+        _req_texts calls cb with the role and text of the instructions and of each input message,
+        replaces the text with the return value of cb, and returns the roles and original texts.
+        """
+        texts: List[tuple[str, str]] = []
+        if isinstance(body.get("instructions"), str):
+            texts.append(("system", body["instructions"]))
+            body["instructions"] = cb("system", body["instructions"])
+
+        if isinstance(body.get("input"), str):
+            texts.append(("user", body["input"]))
+            body["input"] = cb("user", body["input"])
+            return texts
+
+        for item in body.get("input", []):
+            if "role" not in item:
+                # function call outputs and other items have no message
+                continue
+            role = item["role"]
+            if isinstance(item.get("content"), str):
+                texts.append((role, item["content"]))
+                item["content"] = cb(role, item["content"])
+            elif isinstance(item.get("content"), list):
+                for content in item["content"]:
+                    if content.get("type") in ("input_text", "output_text"):
+                        texts.append((role, content["text"]))
+                        content["text"] = cb(role, content["text"])
+        return texts
+
+    def extract_contents_from_resp_chunk(self, json_data) -> List[bytes] | None:
+        if json_data is None or json_data.get("type") != "response.output_text.delta":
+            return None
+
+        # the output index of the message is used as the choice index
+        index = json_data.get("output_index", 0)
+        contents: List[bytes] = [b""] * (index + 1)
+        contents[index] = json_data.get("delta", "").encode("utf-8")
+        return contents
+
+    def has_choice_index(
+        self, json_data: Dict[str, Any] | None, choice_index: int
+    ) -> bool:
+        if json_data is None or json_data.get("type") != "response.output_text.delta":
+            return False
+
+        return json_data.get("output_index", 0) == choice_index
+
+    def update_stream_resp_contents(self, json_data, choice_index: int, content: bytes):
+        if not self.has_choice_index(json_data, choice_index):
+            logger.warning(
+                f"update_stream_resp_contents() called but does not have choice_index: {choice_index} content: {content}"
+            )
+            return None
+
+        # NOTE: the response.output_text.done and response.completed events repeat the full
+        # text of the response, only the deltas are modified by the response guards.
+        json_data["delta"] = content.decode("utf-8")
+
+    def is_streaming_response_completed(self, chunk: StreamChunkData) -> bool:
+        if chunk.type == StreamChunkDataType.DONE:
+            return True
+
+        return chunk.json_data is not None and chunk.json_data.get("type") in (
+            "response.completed",
+            "response.failed",
+            "response.incomplete",
+        )
+
+    def get_stream_resp_chunk_type(
+        self, json_data: Dict[str, Any]
+    ) -> StreamChunkDataType:
+        event_type = json_data.get("type", "")
+        if event_type == "response.output_text.delta":
+            return StreamChunkDataType.NORMAL_TEXT
+        if event_type == "response.completed":
+            return StreamChunkDataType.LAST_USAGE
+        return StreamChunkDataType.UNKNOWN
+
+
+class OpenAIImages(OpenAI):
+    """
+    OpenAIImages handles the image generation API (/v1/images/generations), where the
+    request has a "prompt" and the response "data" may have a "revised_prompt".
+    """
+
+    def tokens(self, jsn: dict) -> Tokens:
+        # only the gpt-image models report the usage
+        return tokens_from_input_output_usage(jsn.get("usage"))
+
+    def get_attributes_for_response_body(self, body: dict) -> Attributes:
+        tokens = self.tokens(body)
+        return {
+            gen_ai_attributes.GEN_AI_RESPONSE_MODEL: self.get_model_resp(body),
+            gen_ai_attributes.GEN_AI_USAGE_INPUT_TOKENS: tokens.prompt,
+            gen_ai_attributes.GEN_AI_USAGE_OUTPUT_TOKENS: tokens.completion,
+        }
+
+    def has_function_call_finish_reason(self, body: dict) -> bool:
+        return False
+
+    def get_num_tokens_from_body(self, body: dict) -> int:
+        return num_tokens_from_input(body.get("prompt", ""))
+
+    def iterate_str_req_messages(self, body: dict, cb: Callable[[str, str], str]):
+        iterate_str_input(body, "prompt", "user", cb)
+
+    def iterate_str_resp_messages(self, body: dict, cb: Callable[[str, str], str]):
+        for image in body.get("data", []):
+            if isinstance(image.get("revised_prompt"), str):
+                image["revised_prompt"] = cb("assistant", image["revised_prompt"])
+
+    def all_req_content(self, body: dict) -> str:
+        return "\n".join(str_inputs(body.get("prompt", "")))
+
+    def construct_request_webhook_request_body(
+        self, body: dict
+    ) -> webhook_api.PromptMessages:
+        return input_webhook_request_body(body, "prompt")
+
+    def update_request_body_from_webhook(
+        self, original_body: dict, webhook_modified_messages: webhook_api.PromptMessages
+    ):
+        update_input_from_webhook(original_body, "prompt", webhook_modified_messages)
+
+    def construct_response_webhook_request_body(
+        self, body: dict
+    ) -> webhook_api.ResponseChoices:
+        response_choices = webhook_api.ResponseChoices()
+        for image in body.get("data", []):
+            response_choices.choices.append(
+                webhook_api.ResponseChoice(
+                    message=webhook_api.Message(
+                        role="assistant", content=image.get("revised_prompt", "")
+                    )
+                )
+            )
+        return response_choices
+
+    def update_response_body_from_webhook(
+        self,
+        original_body: dict,
+        webhook_modified_messages: webhook_api.ResponseChoices,
+    ):
+        images = original_body.get("data", [])
+        if len(images) != len(webhook_modified_messages.choices):
+            logger.error(
+                "webhook modified messages do not match the original data array size!"
+            )
+            return
+
+        for i, modified_content in enumerate(webhook_modified_messages.choices):
+            if "revised_prompt" in images[i]:
+                images[i]["revised_prompt"] = modified_content.message.content
+
+
+def provider_for_route_type(route_type: str) -> Provider:
+    """
+    provider_for_route_type returns the OpenAI compatible provider parsing the
+    requests and responses of the route type.
+    """
+    if route_type == COMPLETIONS_ROUTE_TYPE:
+        return OpenAICompletions()
+    if route_type == EMBEDDINGS_ROUTE_TYPE:
+        return OpenAIEmbeddings()
+    if route_type == RESPONSES_ROUTE_TYPE:
+        return OpenAIResponses()
+    if route_type == IMAGES_ROUTE_TYPE:
+        return OpenAIImages()
+    return OpenAI()
+
+
+def route_type_from_path(path: str) -> str:
+    """
+    route_type_from_path detects the route type from the path of the request, such as
+    /v1/embeddings. Returns the CHAT route type for unknown paths.
+    """
+    path = path.split("?", 1)[0].rstrip("/")
+    if path.endswith("/chat/completions"):
+        return CHAT_ROUTE_TYPE
+    if path.endswith("/completions"):
+        return COMPLETIONS_ROUTE_TYPE
+    if path.endswith("/embeddings"):
+        return EMBEDDINGS_ROUTE_TYPE
+    if path.endswith("/responses"):
+        return RESPONSES_ROUTE_TYPE
+    if path.endswith("/images/generations"):
+        return IMAGES_ROUTE_TYPE
+    return CHAT_ROUTE_TYPE
+
+
+def str_inputs(value: str | list) -> List[str]:
+    """
+    str_inputs returns the texts of a prompt or input field, which can be a string or a list
+    of strings. Lists of token ids are not text and are skipped.
+    """
+    if isinstance(value, str):
+        return [value]
+    if isinstance(value, list):
+        return [v for v in value if isinstance(v, str)]
+    return []
+
+
+def iterate_str_input(body: dict, key: str, role: str, cb: Callable[[str, str], str]):
+    value = body.get(key)
+    if isinstance(value, str):
+        body[key] = cb(role, value)
+    elif isinstance(value, list):
+        for idx, v in enumerate(value):
+            if isinstance(v, str):
+                value[idx] = cb(role, v)
+
+
+def input_webhook_request_body(body: dict, key: str) -> webhook_api.PromptMessages:
+    prompt_messages = webhook_api.PromptMessages()
+    for text in str_inputs(body.get(key, "")):
+        prompt_messages.messages.append(webhook_api.Message(role="user", content=text))
+    return prompt_messages
+
+
+def update_input_from_webhook(
+    body: dict, key: str, webhook_modified_messages: webhook_api.PromptMessages
+):
+    if len(str_inputs(body.get(key, ""))) != len(webhook_modified_messages.messages):
+        logger.error(
+            "webhook modified messages do not match the original %s array size!", key
+        )
+        return
+
+    modified = iter(webhook_modified_messages.messages)
+    iterate_str_input(body, key, "user", lambda role, text: next(modified).content)
+
+
+def tokens_from_input_output_usage(usage: dict | None) -> Tokens:
+    """
+    tokens_from_input_output_usage returns the Tokens of the usage reported by the Responses
+    and image generation APIs, which use input and output tokens instead of prompt and completion tokens.
+    """
+    if usage is None:
+        return Tokens()
+
+    prompt_details = None
+    if (details := usage.get("input_tokens_details")) is not None:
+        prompt_details = TokensDetails(
+            cached=details.get("cached_tokens", 0),
+            text=details.get("text_tokens", 0),
+            image=details.get("image_tokens", 0),
+        )
+
+    completion_details = None
+    if (details := usage.get("output_tokens_details")) is not None:
+        completion_details = TokensDetails(
+            reasoning=details.get("reasoning_tokens", 0),
+        )
+
+    return Tokens(
+        completion=int(usage.get("output_tokens", 0)),
+        prompt=int(usage.get("input_tokens", 0)),
+        prompt_details=prompt_details,
+        completion_details=completion_details,
+    )
+
+
 class Anthropic(OpenAI):
     def get_attributes_for_response_body(self, body: dict) -> Attributes:
         # TODO Add output type once we support more type.
@@ -1388,3 +1954,20 @@ def num_tokens_from_messages(messages: list[dict]) -> int:
 
     num_tokens += 3  # every reply is primed with <|start|>assistant<|message|>
     return num_tokens
+
+
+def num_tokens_from_input(value: str | list) -> int:
+    """
+    num_tokens_from_input counts the tokens of a prompt or input field, which can be a string,
+    a list of strings, or a list of token ids.
+    """
+    encoding = tiktoken.get_encoding("cl100k_base")
+    if isinstance(value, str):
+        return len(encoding.encode(value))
+    if isinstance(value, list):
+        # a list of token ids has one token per item
+        return sum(
+            num_tokens_from_input(v) if isinstance(v, (str, list)) else 1
+            for v in value
+        )
+    return 0
@@ -0,0 +1,67 @@
+import json
+import time
+import threading
+
+from dataclasses import dataclass, field
+from typing import Callable
+
+
+@dataclass
+class TokenBucket:
+    """
+    TokenBucket enforces the local token rate limit of a policy. The bucket is keyed
+    by the policy and its rate limit config, so it is shared by all the requests of
+    the routes the policy applies to, but not by other policies with the same limits.
+    The LLM tokens of a request are consumed once the response has been parsed, so a
+    request is only rejected if the bucket was already empty when it started.
+    """
+
+    max_tokens: int
+    tokens_per_fill: int
+    fill_interval_ms: int
+    clock: Callable[[], float] = time.monotonic
+    _tokens: int = field(init=False)
+    _last_fill: float = field(init=False)
+    _lock: threading.Lock = field(init=False, default_factory=threading.Lock)
+
+    def __post_init__(self):
+        self._tokens = self.max_tokens
+        self._last_fill = self.clock()
+
+    @staticmethod
+    def from_json(config: str) -> "TokenBucket":
+        """
+        from_json creates a TokenBucket from the config set by the control plane in the
+        x-token-ratelimit-config metadata.
+        """
+        obj = json.loads(config)
+        return TokenBucket(
+            max_tokens=obj.get("maxTokens", 0),
+            tokens_per_fill=obj.get("tokensPerFill", 1),
+            fill_interval_ms=obj.get("fillIntervalMs", 0),
+        )
+
+    def _refill(self):
+        if self.fill_interval_ms <= 0:
+            return
+        now = self.clock()
+        fills = int((now - self._last_fill) * 1000 // self.fill_interval_ms)
+        if fills <= 0:
+            return
+        self._tokens = min(self.max_tokens, self._tokens + fills * self.tokens_per_fill)
+        self._last_fill += fills * self.fill_interval_ms / 1000
+
+    def has_tokens(self) -> bool:
+        with self._lock:
+            self._refill()
+            return self._tokens > 0
+
+    def consume(self, tokens: int):
+        """
+        consume removes the tokens of a request from the bucket. The bucket may go
+        negative when a response uses more tokens than were left, which delays the
+        next requests until the debt has been refilled.
+        """
+        with self._lock:
+            self._refill()
+            self._tokens -= tokens
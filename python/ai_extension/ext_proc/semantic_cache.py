@@ -0,0 +1,181 @@
+import asyncio
+import hashlib
+import logging
+import re
+import uuid
+
+import numpy as np
+from openai import AsyncOpenAI as OpenAIClient
+from openai.resources import AsyncEmbeddings
+from redis.asyncio import Redis
+from redis.exceptions import ResponseError
+
+from api.kgateway.policy.ai.semantic_cache import SemanticCacheConfig
+
+logger = logging.getLogger().getChild("kgateway-ai-ext.semantic_cache")
+
+# Characters that must be escaped in the value of a TAG query
+_tag_special_chars = re.compile(r"([^A-Za-z0-9_])")
+
+
+def escape_tag(value: str) -> str:
+    return _tag_special_chars.sub(r"\\\1", value)
+
+
+def partition_tag(partition: str, key: str = "") -> str:
+    """
+    partition_tag returns the tag of the cached responses of the partition, which is the
+    policy, route and backends that the responses are cached for, and of the value of the
+    request partitioning the cache, if any.
+    """
+    return hashlib.sha256(f"{partition}\n{key}".encode("utf-8")).hexdigest()
+
+
+class SemanticCache:
+    """
+    SemanticCache stores the responses of the LLM provider in a Redis vector index, keyed
+    by the embedding of the prompt of the request. A cached response is served to the
+    requests of the same partition and for the same model whose prompt embedding has a
+    cosine similarity with the cached prompt of at least the similarity threshold. The
+    partition is the tag returned by partition_tag.
+    """
+
+    def __init__(
+        self,
+        config: SemanticCacheConfig,
+        redis: Redis | None = None,
+        embeddings: AsyncEmbeddings | None = None,
+    ):
+        if config.redis is None:
+            raise ValueError("Unknown semantic cache store type")
+        if config.embedding.openai is None:
+            raise ValueError("Unknown semantic cache embedding type")
+        self.config = config
+        self._index = config.redis.index
+        self._redis = redis or Redis(
+            host=config.redis.host,
+            port=config.redis.port,
+            password=config.redis.password or None,
+        )
+        self._embeddings = (
+            embeddings
+            or OpenAIClient(api_key=config.embedding.openai.auth_token).embeddings
+        )
+        self._index_ready = False
+        self._index_lock = asyncio.Lock()
+
+    async def embed(self, prompt: str) -> bytes:
+        """
+        embed returns the embedding of the prompt as the float32 vector blob used by Redis.
+        """
+        resp = await self._embeddings.create(
+            input=prompt, model=self.config.embedding.openai.model
+        )
+        return np.array(resp.data[0].embedding, dtype=np.float32).tobytes()
+
+    async def lookup(
+        self, partition: str, model: str, embedding: bytes
+    ) -> bytes | None:
+        """
+        lookup returns the cached response of the most similar prompt of the partition
+        for the model, or None if no cached prompt is similar enough.
+        """
+        await self._ensure_index(embedding)
+        res = await self._redis.execute_command(
+            "FT.SEARCH",
+            self._index,
+            f"(@partition:{{{escape_tag(partition)}}} @model:{{{escape_tag(model)}}})"
+            "=>[KNN 1 @embedding $vec AS distance]",
+            "PARAMS",
+            "2",
+            "vec",
+            embedding,
+            "SORTBY",
+            "distance",
+            "RETURN",
+            "2",
+            "response",
+            "distance",
+            "DIALECT",
+            "2",
+        )
+        # The reply is the number of results followed by the key and the fields of each result
+        if not res or res[0] == 0:
+            return None
+        fields = res[2]
+        values = {
+            _to_str(fields[i]): fields[i + 1] for i in range(0, len(fields) - 1, 2)
+        }
+        # The cosine distance of Redis is 1 - the cosine similarity
+        similarity = 1 - float(values.get("distance", 2))
+        if similarity < self.config.similarity_threshold:
+            logger.debug("semantic cache miss, similarity %f", similarity)
+            return None
+        logger.debug("semantic cache hit, similarity %f", similarity)
+        return values.get("response")
+
+    async def store(
+        self, partition: str, model: str, embedding: bytes, response: bytes
+    ):
+        """
+        store caches the response of the prompt of the partition for the model until
+        the TTL expires.
+        """
+        await self._ensure_index(embedding)
+        key = f"{self._index}:{uuid.uuid4().hex}"
+        async with self._redis.pipeline(transaction=True) as pipe:
+            pipe.hset(
+                key,
+                mapping={
+                    "partition": partition,
+                    "model": model,
+                    "embedding": embedding,
+                    "response": response,
+                },
+            )
+            pipe.expire(key, self.config.ttl_seconds)
+            await pipe.execute()
+
+    async def _ensure_index(self, embedding: bytes):
+        """
+        _ensure_index creates the vector index if it does not exist yet. The dimension of the
+        index is only known once the first embedding has been computed.
+        """
+        if self._index_ready:
+            return
+        async with self._index_lock:
+            if self._index_ready:
+                return
+            try:
+                await self._redis.execute_command("FT.INFO", self._index)
+            except ResponseError:
+                dim = len(embedding) // np.dtype(np.float32).itemsize
+                await self._redis.execute_command(
+                    "FT.CREATE",
+                    self._index,
+                    "ON",
+                    "HASH",
+                    "PREFIX",
+                    "1",
+                    f"{self._index}:",
+                    "SCHEMA",
+                    "partition",
+                    "TAG",
+                    "model",
+                    "TAG",
+                    "embedding",
+                    "VECTOR",
+                    "HNSW",
+                    "6",
+                    "TYPE",
+                    "FLOAT32",
+                    "DIM",
+                    str(dim),
+                    "DISTANCE_METRIC",
+                    "COSINE",
+                )
+            self._index_ready = True
+
+
+def _to_str(value: bytes | str) -> str:
+    return value.decode("utf-8") if isinstance(value, bytes) else value
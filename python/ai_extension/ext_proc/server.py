@@ -27,7 +27,11 @@ from telemetry.tracing import (
     RejectResult,
 )
 from .stream import Handler as StreamHandler
+from .provider import AUTO_ROUTE_TYPE, CHAT_ROUTE_TYPE, route_type_from_path
+from .ratelimit import TokenBucket
+from .semantic_cache import SemanticCache, partition_tag
 from guardrails.regex import RegexRejection
+from guardrails.pii import PIIDetector, PIIRejection
 
 from openai import AsyncOpenAI as OpenAIClient
 from google.protobuf import struct_pb2 as struct_pb2
@@ -36,9 +40,12 @@ from prometheus_client import Counter, Histogram, start_http_server
 from grpc_health.v1 import health
 from grpc_health.v1 import health_pb2_grpc
 
+from api.envoy.config.core.v3 import base_pb2
 from api.envoy.service.ext_proc.v3 import external_processor_pb2
 from api.envoy.service.ext_proc.v3 import external_processor_pb2_grpc
 from api.kgateway.policy.ai import prompt_guard
+from api.kgateway.policy.ai.model_routing import ModelRouting, model_routing_from_json
+from api.kgateway.policy.ai.semantic_cache import semantic_cache_from_json
 from util.proto import (
     extproc_clear_request_body,
     extproc_clear_response_body,
@@ -70,6 +77,14 @@ from opentelemetry.semconv._incubating.attributes import gen_ai_attributes
 from opentelemetry.context import attach, detach
 from opentelemetry.propagate import extract
 
+# ai_provider_lb_key is the endpoint metadata key, in the envoy.lb namespace, holding
+# the name of the provider of the AI backend the endpoint belongs to.
+ai_provider_lb_key: Final[str] = "kgateway.dev/ai-provider"
+
+# semantic_cache_header is the response header set on the responses served from
+# the semantic cache.
+semantic_cache_header: Final[str] = "x-kgateway-semantic-cache"
+
 # Listen address can be a Unix Domain Socket path or an address like [::]:18080
 server_listen_addr = os.getenv("LISTEN_ADDR", "unix-abstract:kgateway-ai-sock")
 unix_addr_prefix: Final[str] = "unix://"
@@ -78,6 +93,9 @@ logger = logging.getLogger().getChild("kgateway-ai-ext.external_processor")
 
 llm_label_name: Final[str] = "llm"
 model_label_name: Final[str] = "model"
+pii_entity_label_name: Final[str] = "entity"
+pii_direction_label_name: Final[str] = "direction"
+pii_action_label_name: Final[str] = "action"
 
 ai_stat_namespace: Final[str] = "ai"
 
@@ -87,6 +105,7 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
     _completion_tokens_ctr: Counter
     _rate_limited_tokens_ctr: Counter
     _exception_raised: Counter
+    _pii_detected_ctr: Counter
     _webhook_req_time_sec: Histogram
     _stats_config: StatsConfig
 
@@ -97,6 +116,11 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
     ):
         self._req_guard: dict[str, list[EntityRecognizer]] = {}
         self._resp_guard: dict[str, list[EntityRecognizer]] = {}
+        self._req_pii: dict[str, PIIDetector] = {}
+        self._resp_pii: dict[str, PIIDetector] = {}
+        self._token_buckets: dict[str, TokenBucket] = {}
+        self._model_routing: dict[str, ModelRouting] = {}
+        self._semantic_caches: dict[str, SemanticCache] = {}
         self._stats_config = stats_config
 
         labels = [llm_label_name, model_label_name]
@@ -126,6 +150,14 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
             ai_stat_namespace,
         )
 
+        self._pii_detected_ctr = Counter(
+            "pii_detected",
+            "PII entities detected by the prompt guards",
+            labels
+            + [pii_entity_label_name, pii_direction_label_name, pii_action_label_name],
+            ai_stat_namespace,
+        )
+
     @contextmanager
     def _set_remote_context(self, servicer_context):
         metadata = servicer_context.invocation_metadata()
@@ -175,6 +207,18 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                                 dict(context.invocation_metadata()),
                                 request.request_headers,
                             )
+                        if (
+                            handler.token_bucket is not None
+                            and not handler.token_bucket.has_tokens()
+                        ):
+                            yield error_response(
+                                prompt_guard.CustomResponse(
+                                    message="token rate limit exceeded",
+                                    status_code=429,
+                                ),
+                                "Token rate limit exceeded",
+                            )
+                            continue
                         try:
                             yield self.handle_request_headers(
                                 request.request_headers, handler
@@ -225,6 +269,9 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                         handler.content_encoding = get_http_header(
                             request.response_headers.headers, "content-encoding"
                         )
+                        handler.resp_status = get_http_header(
+                            request.response_headers.headers, ":status"
+                        )
                         handler.resp.set_headers(
                             (
                                 handler.resp_webhook.forwardHeaders
@@ -258,6 +305,19 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                                 handler,
                                 parent_span,
                             )
+                        except PIIRejection as piiEx:
+                            self.increment_pii_detected(handler)
+                            parent_span.record_exception(piiEx)
+                            # The status code of a streaming response may have already been sent,
+                            # in which case Envoy closes the client connection.
+                            yield error_response(
+                                prompt_guard.CustomResponse(
+                                    message="The response was rejected due to inappropriate content",
+                                    status_code=403,
+                                ),
+                                "Rejected by guardrails PII detection",
+                                piiEx,
+                            )
                         except WebhookException as webHookEx:
                             self.increment_exception_raised(handler)
                             parent_span.record_exception(webHookEx)
@@ -319,6 +379,10 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                     handler.req_regex = recognizers
                     if handler.req_regex is not None:
                         self._req_guard[config_hash] = handler.req_regex
+            if guardrails_obj.pii:
+                if config_hash not in self._req_pii:
+                    self._req_pii[config_hash] = PIIDetector(guardrails_obj.pii)
+                handler.req_pii = self._req_pii[config_hash]
 
         if (guardrails := metadict.get("x-resp-guardrails-config", "")) != "":
             guardrails_obj = prompt_guard.resp_from_json(guardrails)
@@ -334,6 +398,42 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                     handler.resp_regex = recognizers
                     if handler.resp_regex is not None:
                         self._resp_guard[config_hash] = handler.resp_regex
+            if guardrails_obj.pii:
+                if config_hash not in self._resp_pii:
+                    self._resp_pii[config_hash] = PIIDetector(guardrails_obj.pii)
+                handler.resp_pii = self._resp_pii[config_hash]
+
+        if (config := metadict.get("x-token-ratelimit-config", "")) != "":
+            config_hash = metadict.get("x-token-ratelimit-config-hash", "")
+            if config_hash not in self._token_buckets:
+                self._token_buckets[config_hash] = TokenBucket.from_json(config)
+            handler.token_bucket = self._token_buckets[config_hash]
+
+        if (config := metadict.get("x-model-routing-config", "")) != "":
+            config_hash = metadict.get("x-model-routing-config-hash", "")
+            if config_hash not in self._model_routing:
+                self._model_routing[config_hash] = model_routing_from_json(config)
+            handler.model_routing = self._model_routing[config_hash]
+
+        if (config := metadict.get("x-semantic-cache-config", "")) != "":
+            config_hash = metadict.get("x-semantic-cache-config-hash", "")
+            if config_hash not in self._semantic_caches:
+                self._semantic_caches[config_hash] = SemanticCache(
+                    semantic_cache_from_json(config)
+                )
+            handler.semantic_cache = self._semantic_caches[config_hash]
+            # Envoy renders the missing values of the request as an empty string or a dash
+            partition_key = metadict.get("x-semantic-cache-partition-key", "")
+            if handler.semantic_cache.config.partition_by_key and partition_key in (
+                "",
+                "-",
+            ):
+                # the requests without the value partitioning the cache are not cached
+                handler.semantic_cache = None
+            else:
+                handler.semantic_cache_partition = partition_tag(
+                    metadict.get("x-semantic-cache-partition", ""), partition_key
+                )
 
         return handler
 
@@ -345,6 +445,8 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
             headers,
         )
         handler.req.path = get_http_header(headers.headers, ":path")
+        if handler.route_type == AUTO_ROUTE_TYPE:
+            handler.set_route_type(route_type_from_path(handler.req.path))
         auth_header = get_http_header(headers.headers, "authorization").removeprefix(
             "Bearer "
         )
@@ -358,6 +460,10 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                                 "auth_token": struct_pb2.Value(
                                     string_value=auth_header
                                 ),
+                                # used by the transformation to rewrite the path when the route type is detected
+                                "route_type": struct_pb2.Value(
+                                    string_value=handler.route_type
+                                ),
                             }
                         )
                     )
@@ -473,6 +579,37 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                     handler.req_custom_response, "Rejected by guardrails regex", e
                 )
 
+    def handle_request_body_req_pii(
+        self, body: dict, handler: StreamHandler, parent_span: trace.Span
+    ) -> external_processor_pb2.ProcessingResponse | None:
+        with (
+            OtelTracer.get().start_as_current_span(
+                "handle_request_body_req_pii",
+                context=trace.set_span_in_context(parent_span),
+            ) as pii_span
+        ):
+            try:
+                handler.provider.iterate_str_req_messages(
+                    body=body, cb=handler.req_pii_transform
+                )
+                pii_span.set_attribute(ai_attributes.AI_PII_RESULT, RejectResult.PASSED)
+            except PIIRejection as e:
+                pii_span.set_attribute(
+                    ai_attributes.AI_PII_RESULT, RejectResult.REJECTED
+                )
+                pii_span.record_exception(e)
+                pii_span.set_status(
+                    trace.StatusCode.ERROR,
+                    f"Rejected by guardrails PII detection: {str(e)}",
+                )
+                return error_response(
+                    handler.req_custom_response,
+                    "Rejected by guardrails PII detection",
+                    e,
+                )
+            finally:
+                self.increment_pii_detected(handler)
+
     async def handle_request_body(
         self,
         req_body: external_processor_pb2.HttpBody,
@@ -484,6 +621,12 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         handler.req.append(req_body.body)
         if req_body.end_of_stream:
             body_jsn = json.loads(handler.req.body.decode("utf-8"))
+            if handler.model_routing and (
+                unknown_model_resp := self.handle_request_body_model_routing(
+                    body_jsn, handler
+                )
+            ):
+                return unknown_model_resp
             handler.request_model = handler.provider.get_model_req(body_jsn, metadict)
 
             # Check if request is streaming
@@ -525,6 +668,13 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                 ):
                     return req_regex_resp
 
+                if handler.req_pii and (
+                    req_pii_resp := self.handle_request_body_req_pii(
+                        body, handler, parent_span=gen_ai_client_span
+                    )
+                ):
+                    return req_pii_resp
+
                 if handler.req_moderation:
                     with tracer.start_as_current_span(
                         "handle_request_body_req_moderation",
@@ -550,25 +700,42 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                         moderation_span.set_attribute(
                             ai_attributes.AI_MODERATION_FLAGGED, False
                         )
+                if (
+                    handler.semantic_cache
+                    and handler.route_type == CHAT_ROUTE_TYPE
+                    and not handler.req.is_streaming
+                    and (
+                        cached_resp := await self.handle_request_body_semantic_cache(
+                            body, handler, gen_ai_client_span
+                        )
+                    )
+                ):
+                    return cached_resp
                 # currently we only count the prompt token for ratelimiting. So,
                 # this is only set here. If we change to count completion token as well
                 # will need to add those into rate_limited_tokens for stats purpose.
                 handler.rate_limited_tokens = tokens
-            return external_processor_pb2.ProcessingResponse(
-                dynamic_metadata=struct_pb2.Struct(
-                    # increment tokens for rate limiting
-                    fields={
-                        "envoy.ratelimit": struct_pb2.Value(
-                            struct_value=struct_pb2.Struct(
-                                fields={
-                                    "hits_addend": struct_pb2.Value(
-                                        number_value=float(tokens),
-                                    )
-                                }
-                            )
+            dynamic_metadata = struct_pb2.Struct(
+                # increment tokens for rate limiting
+                fields={
+                    "envoy.ratelimit": struct_pb2.Value(
+                        struct_value=struct_pb2.Struct(
+                            fields={
+                                "hits_addend": struct_pb2.Value(
+                                    number_value=float(tokens),
+                                )
+                            }
                         )
-                    },
-                ),
+                    )
+                },
+            )
+            if handler.model_provider:
+                # select the endpoints of the provider with the subset load balancer
+                dynamic_metadata.fields["envoy.lb"].struct_value.fields[
+                    ai_provider_lb_key
+                ].string_value = handler.model_provider
+            return external_processor_pb2.ProcessingResponse(
+                dynamic_metadata=dynamic_metadata,
                 request_body=external_processor_pb2.BodyResponse(
                     response=external_processor_pb2.CommonResponse(
                         body_mutation=external_processor_pb2.BodyMutation(
@@ -581,6 +748,111 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         # If it's not end of stream, clear the body so envoy doesn't forward to upstream.
         return extproc_clear_request_body()
 
+    async def handle_request_body_semantic_cache(
+        self, body: dict, handler: StreamHandler, parent_span: trace.Span
+    ) -> external_processor_pb2.ProcessingResponse | None:
+        """
+        Look up the response of a similar prompt in the semantic cache, and return it
+        as an immediate response on a hit. On a miss, the embedding of the prompt is
+        kept to cache the response. Errors with the cache are logged and the request
+        is sent to the LLM provider.
+        """
+        with OtelTracer.get().start_as_current_span(
+            "handle_request_body_semantic_cache",
+            context=trace.set_span_in_context(parent_span),
+        ) as cache_span:
+            try:
+                embedding = await handler.semantic_cache.embed(
+                    handler.provider.all_req_content(body)
+                )
+                cached = await handler.semantic_cache.lookup(
+                    handler.semantic_cache_partition, handler.request_model, embedding
+                )
+            except Exception as e:
+                cache_span.record_exception(e)
+                logger.error("Error with semantic cache lookup, %s", e)
+                return None
+            cache_span.set_attribute(
+                ai_attributes.AI_SEMANTIC_CACHE_HIT, cached is not None
+            )
+            if cached is None:
+                handler.semantic_cache_embedding = embedding
+                return None
+            return external_processor_pb2.ProcessingResponse(
+                immediate_response=external_processor_pb2.ImmediateResponse(
+                    status=dict(code=map_int_to_grpc_status_code(200)),
+                    headers=external_processor_pb2.HeaderMutation(
+                        set_headers=[
+                            base_pb2.HeaderValueOption(
+                                header=base_pb2.HeaderValue(
+                                    key="content-type",
+                                    raw_value=b"application/json",
+                                )
+                            ),
+                            base_pb2.HeaderValueOption(
+                                header=base_pb2.HeaderValue(
+                                    key=semantic_cache_header,
+                                    raw_value=b"hit",
+                                )
+                            ),
+                        ]
+                    ),
+                    body=cached,
+                    details="semantic_cache_hit",
+                ),
+            )
+
+    async def handle_response_body_semantic_cache(
+        self, body: dict, handler: StreamHandler
+    ):
+        """
+        Cache the response of a request that missed the semantic cache. Errors with the
+        cache are logged, as the response has been received from the LLM provider.
+        """
+        try:
+            await handler.semantic_cache.store(
+                handler.semantic_cache_partition,
+                handler.request_model,
+                handler.semantic_cache_embedding,
+                json.dumps(body).encode("utf-8"),
+            )
+        except Exception as e:
+            logger.error("Error with semantic cache store, %s", e)
+
+    def handle_request_body_model_routing(
+        self, body: dict, handler: StreamHandler
+    ) -> external_processor_pb2.ProcessingResponse | None:
+        """
+        Route the request based on the model requested by the client, rewriting the
+        model of the body and selecting the provider of the backend. Returns an
+        immediate response if the model is unknown and unknown models are rejected.
+        """
+        requested_model = body.get("model", "")
+        route = handler.model_routing.route(requested_model)
+        if route is None:
+            unknown_model_resp = handler.model_routing.unknown_model_response
+            if unknown_model_resp is None:
+                return None
+            return error_response(
+                prompt_guard.CustomResponse(
+                    message=unknown_model_resp.message,
+                    status_code=unknown_model_resp.status_code,
+                ),
+                f"Unknown model {requested_model}",
+            )
+
+        handler.logger.debug(
+            "routing model %s to provider %s and model %s",
+            requested_model,
+            route.provider,
+            route.model,
+        )
+        if route.model:
+            body["model"] = route.model
+        if route.provider:
+            handler.model_provider = route.provider
+        return None
+
     async def handle_response_body_resp_webhook(
         self,
         body: dict,
@@ -640,6 +912,20 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                 body=body, cb=handler.resp_regex_transform
             )
 
+    async def handle_response_body_resp_pii(
+        self, body: dict, handler: StreamHandler, parent_span: trace.Span
+    ) -> None:
+        with OtelTracer.get().start_as_current_span(
+            "handle_response_body_resp_pii",
+            context=trace.set_span_in_context(parent_span),
+        ):
+            try:
+                handler.provider.iterate_str_resp_messages(
+                    body=body, cb=handler.resp_pii_transform
+                )
+            finally:
+                self.increment_pii_detected(handler)
+
     async def handle_response_body(
         self,
         resp_body: external_processor_pb2.HttpBody,
@@ -657,7 +943,11 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                 resp_headers=handler.resp.headers,
                 resp_body=resp_body,
                 parent_span=parent_span,
+                resp_pii_transform=(
+                    handler.resp_pii_transform if handler.resp_pii else None
+                ),
             )
+            self.increment_pii_detected(handler)
             if body is None:
                 handler.logger.debug(
                     "buffering streaming response %s\n", resp_body.body
@@ -774,6 +1064,20 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                                 jsn, handler, non_streaming_span
                             )
 
+                        if handler.resp_pii and not has_function_call_resp:
+                            await self.handle_response_body_resp_pii(
+                                jsn, handler, non_streaming_span
+                            )
+
+                        # Cache the response once the response guards have been applied
+                        if (
+                            handler.semantic_cache_embedding is not None
+                            and handler.resp_status == "200"
+                        ):
+                            await self.handle_response_body_semantic_cache(
+                                jsn, handler
+                            )
+
                         return external_processor_pb2.ProcessingResponse(
                             response_body=external_processor_pb2.BodyResponse(
                                 response=external_processor_pb2.CommonResponse(
@@ -797,6 +1101,8 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         labels[model_label_name] = handler.request_model
 
         tokens = handler.get_tokens()
+        if handler.token_bucket is not None:
+            handler.token_bucket.consume(tokens.total_tokens())
         increment_counter(self._completion_tokens_ctr, labels, tokens.completion)
         increment_counter(self._prompt_tokens_ctr, labels, tokens.prompt)
         increment_counter(
@@ -805,6 +1111,17 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
 
         return handler.build_metadata()
 
+    def increment_pii_detected(self, handler: StreamHandler):
+        labels = handler.extra_labels.copy()
+        labels[llm_label_name] = handler.llm_provider
+        labels[model_label_name] = handler.request_model
+        for direction, entity, action in handler.pii_detected:
+            labels[pii_direction_label_name] = direction
+            labels[pii_entity_label_name] = entity
+            labels[pii_action_label_name] = action
+            increment_counter(self._pii_detected_ctr, labels, 1)
+        handler.pii_detected.clear()
+
     def increment_exception_raised(self, handler: StreamHandler):
         labels = handler.extra_labels.copy()
         labels[llm_label_name] = handler.llm_provider
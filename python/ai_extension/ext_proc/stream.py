@@ -16,19 +16,28 @@ from .provider import (
     GEMINI_LLM_STR,
     VERTEX_AI_LLM_STR,
     OPENAI_LLM_STR,
+    CHAT_ROUTE_TYPE,
+    COMPLETIONS_ROUTE_TYPE,
+    EMBEDDINGS_ROUTE_TYPE,
+    RESPONSES_ROUTE_TYPE,
+    provider_for_route_type,
 )
 
 from google.protobuf import struct_pb2 as struct_pb2
 from api.envoy.service.ext_proc.v3 import external_processor_pb2
 from api.envoy.config.core.v3 import base_pb2 as base_pb2
 from api.kgateway.policy.ai import prompt_guard
+from api.kgateway.policy.ai.model_routing import ModelRouting
 from presidio_analyzer import EntityRecognizer
 from presidio_anonymizer import AnonymizerEngine
 from dataclasses import dataclass, field
 from openai.resources import AsyncModerations
 from ext_proc.streamchunks import StreamChunks
+from ext_proc.ratelimit import TokenBucket
+from ext_proc.semantic_cache import SemanticCache
 from util.http import parse_content_type
 from guardrails.regex import regex_transform
+from guardrails.pii import PIIDetector, pii_transform
 from opentelemetry.semconv._incubating.attributes import gen_ai_attributes
 from opentelemetry.util.types import Attributes
 
@@ -112,13 +121,28 @@ class Handler:
     logger: Logger
     provider: Provider
     llm_provider: str
+    # The type of route set by the AI policy, such as CHAT or EMBEDDINGS
+    route_type: str = CHAT_ROUTE_TYPE
     req_webhook: prompt_guard.Webhook | None = None
     resp_webhook: prompt_guard.Webhook | None = None
     req_regex: list[EntityRecognizer] | None = None
     req_regex_action: prompt_guard.Action = prompt_guard.Action.MASK
+    req_pii: PIIDetector | None = None
     req_moderation: tuple[AsyncModerations, str] | None = None
     req_custom_response: prompt_guard.CustomResponse | None = None
+    token_bucket: TokenBucket | None = None
+    model_routing: ModelRouting | None = None
+    # The name of the provider of the backend selected by the model routing, if any
+    model_provider: str = ""
+    semantic_cache: SemanticCache | None = None
+    # The tag of the partition of the semantic cache the request is looked up in and cached in
+    semantic_cache_partition: str = ""
+    # The embedding of the prompt of a request missing the semantic cache, used to cache its response
+    semantic_cache_embedding: bytes | None = None
     resp_regex: list[EntityRecognizer] | None = None
+    resp_pii: PIIDetector | None = None
+    # The PII entities detected since the last time they were counted, as (direction, entity, action)
+    pii_detected: list[tuple[str, str, str]] = field(default_factory=list)
     anon: AnonymizerEngine = field(default_factory=AnonymizerEngine)
     req: Info = field(default_factory=Info)
     resp: Info = field(default_factory=Info)
@@ -148,6 +172,11 @@ class Handler:
 
     content_encoding = ""
 
+    resp_status = ""
+    """
+    resp_status is the status code of the response from the :status pseudo header
+    """
+
     _is_function_calling_response: bool = False
     """
     This boolean indicate if the response is a function calling response. For non-streaming response, 
@@ -207,8 +236,23 @@ class Handler:
             handler = Handler(
                 logger=sub_logger, provider=OpenAI(), llm_provider=llm_provider
             )
+        handler.set_route_type(metadict.get("x-route-type", CHAT_ROUTE_TYPE))
         return handler
 
+    def set_route_type(self, route_type: str):
+        """
+        set_route_type sets the route type of the request. The requests and responses of the
+        OpenAI compatible providers are parsed according to the route type, the other providers
+        only support chat routes.
+        """
+        self.route_type = route_type
+        if self.llm_provider not in (
+            ANTHROPIC_LLM_STR,
+            GEMINI_LLM_STR,
+            VERTEX_AI_LLM_STR,
+        ):
+            self.provider = provider_for_route_type(route_type)
+
     def build_metadata(self) -> struct_pb2.Struct:
         tokens = self.get_tokens()
         dynamic_meta = struct_pb2.Struct(
@@ -270,6 +314,26 @@ class Handler:
             self.anon,
         )
 
+    def req_pii_transform(self, role: str, content: str) -> str:
+        return pii_transform(
+            content,
+            self.req_pii,
+            self.anon,
+            lambda entity, action: self.pii_detected.append(
+                ("request", entity.value, action.value)
+            ),
+        )
+
+    def resp_pii_transform(self, role: str, content: str) -> str:
+        return pii_transform(
+            content,
+            self.resp_pii,
+            self.anon,
+            lambda entity, action: self.pii_detected.append(
+                ("response", entity.value, action.value)
+            ),
+        )
+
     def get_operation_name(self) -> str:
         """
         Infers and returns the corresponding operation name based on the request path.
@@ -281,6 +345,12 @@ class Handler:
             A string representing the operation name, such as "chat" or "text_completion".
             Returns "generate_content" if no known operation keyword is found in the path.
         """
+        if self.route_type == EMBEDDINGS_ROUTE_TYPE:
+            return "embeddings"
+        if self.route_type == COMPLETIONS_ROUTE_TYPE:
+            return "text_completion"
+        if self.route_type == RESPONSES_ROUTE_TYPE:
+            return "chat"
         path = self.req.path
         if "chat/completion" in path:
             return "chat"
@@ -763,6 +763,7 @@ class StreamChunks:
         anonymizer_engine: AnonymizerEngine,
         parent_span: trace.Span,
         final: bool = False,
+        pii_transform: Callable[[str, str], str] | None = None,
     ) -> int:
         """
         return how many chunks we should pop out from the fifo. 0 means we are just buffering until we get enough.
@@ -830,25 +831,28 @@ class StreamChunks:
                         )
                         # set this to None so it won't get used
                         webhook_modified_contents = None
-                    elif regex:
-                        # we only need to do this if regex is also enabled; otherwise, webhook_modified_contents will be used directly
+                    elif regex or pii_transform:
+                        # we only need to do this if regex or pii is also enabled; otherwise, webhook_modified_contents will be used directly
                         for i, item in enumerate(contents):
                             item.content = webhook_modified_contents[i]
         regex_modified = False
         regex_modified_contents: List[str] = []
-        if regex:
-            # regex_transform can throw RegexRejection exception. Deliberately not catching
-            # it here so it bubbles up all the way to server.Process() so it can construct an
-            # immediate error response there.
+        if regex or pii_transform:
+            # regex_transform and pii_transform can throw RegexRejection exception. Deliberately
+            # not catching it here so it bubbles up all the way to server.Process() so it can
+            # construct an immediate error response there.
             with OtelTracer.get().start_as_current_span(
                 "regex",
                 context=trace.set_span_in_context(parent_span),
             ):
                 for i, item in enumerate(contents):
                     logger.debug(f"regex: choice_index: {i} content: {item.content}")
-                    regex_modified_contents.append(
-                        regex_transform("", item.content, regex, anonymizer_engine)
-                    )
+                    content = item.content
+                    if regex:
+                        content = regex_transform("", content, regex, anonymizer_engine)
+                    if pii_transform:
+                        content = pii_transform("", content)
+                    regex_modified_contents.append(content)
                     if item.content != regex_modified_contents[i]:
                         # as long as there is one choice that got modified, we need to collapse
                         # the chunks for all choices so they are aligned
@@ -908,11 +912,12 @@ class StreamChunks:
         resp_headers: dict[str, str],
         resp_body: external_processor_pb2.HttpBody,
         parent_span: trace.Span,
+        resp_pii_transform: Callable[[str, str], str] | None = None,
     ) -> bytes | None:
         """
         Buffer data for Guardrail. Returns the bytes when the data comes out of the Fifo
         """
-        if resp_webhook is None and resp_regex is None:
+        if resp_webhook is None and resp_regex is None and resp_pii_transform is None:
             # Guardrail feature is not enabled, so no need to buffer
             return resp_body.body
 
@@ -958,6 +963,7 @@ class StreamChunks:
             webhook=resp_webhook,
             anonymizer_engine=anonymizer_engine,
             parent_span=parent_span,
+            pii_transform=resp_pii_transform,
         )
 
         return self.pop_chunks(number_messages_to_remove)
@@ -0,0 +1,181 @@
+import logging
+import threading
+from typing import Callable
+
+from api.kgateway.policy.ai import prompt_guard
+from guardrails.regex import RegexRejection
+from presidio_analyzer import EntityRecognizer, Pattern, PatternRecognizer
+from presidio_analyzer.nlp_engine import NlpArtifacts, SpacyNlpEngine
+from presidio_analyzer.predefined_recognizers import (
+    AuTfnRecognizer,
+    EsNifRecognizer,
+    IbanRecognizer,
+    ItFiscalCodeRecognizer,
+    SpacyRecognizer,
+    UkNhsRecognizer,
+    UsSsnRecognizer,
+)
+from presidio_anonymizer import AnonymizerEngine
+from presidio_anonymizer.entities import OperatorConfig, RecognizerResult
+
+logger = logging.getLogger().getChild("kgateway-ai-ext.guardrails.pii")
+
+# The pattern of the API keys and secrets, kept in sync with the pattern used by agentgateway
+api_key_pattern = (
+    r"\b(?:sk-[A-Za-z0-9_-]{20,}|AKIA[0-9A-Z]{16}|gh[pousr]_[A-Za-z0-9]{36}"
+    r"|xox[abprs]-[A-Za-z0-9-]{10,}|sk_live_[0-9A-Za-z]{24,}|AIza[0-9A-Za-z_-]{35})\b"
+    r"|-----BEGIN [A-Z ]*PRIVATE KEY-----"
+)
+
+# The spaCy model used for the named entity recognition of the PERSON and LOCATION entities
+spacy_model = "en_core_web_sm"
+
+_ner_entities = (prompt_guard.PIIEntityType.PERSON, prompt_guard.PIIEntityType.LOCATION)
+
+_nlp_engine: SpacyNlpEngine | None = None
+_nlp_engine_lock = threading.Lock()
+
+
+def _get_nlp_engine() -> SpacyNlpEngine:
+    """
+    _get_nlp_engine returns the spaCy NLP engine shared by all the detectors, loading the
+    model on first use as it takes a few seconds and is only needed for PERSON and LOCATION.
+    """
+    global _nlp_engine
+    with _nlp_engine_lock:
+        if _nlp_engine is None:
+            engine = SpacyNlpEngine(
+                models=[{"lang_code": "en", "model_name": spacy_model}]
+            )
+            engine.load()
+            _nlp_engine = engine
+    return _nlp_engine
+
+
+def _recognizers(entity: prompt_guard.PIIEntityType) -> list[EntityRecognizer]:
+    match entity:
+        case prompt_guard.PIIEntityType.PERSON:
+            return [SpacyRecognizer(supported_entities=["PERSON"])]
+        case prompt_guard.PIIEntityType.LOCATION:
+            return [SpacyRecognizer(supported_entities=["LOCATION"])]
+        case prompt_guard.PIIEntityType.NATIONAL_ID:
+            # These recognizers validate the checksums of the identifiers
+            return [
+                UsSsnRecognizer(),
+                UkNhsRecognizer(),
+                EsNifRecognizer(),
+                ItFiscalCodeRecognizer(),
+                AuTfnRecognizer(),
+            ]
+        case prompt_guard.PIIEntityType.IBAN:
+            return [IbanRecognizer()]
+        case prompt_guard.PIIEntityType.API_KEY:
+            return [
+                PatternRecognizer(
+                    supported_entity=entity.value,
+                    name="api_key",
+                    patterns=[Pattern(entity.value, api_key_pattern, 1.0)],
+                )
+            ]
+
+
+class PIIRejection(RegexRejection):
+    """
+    PIIRejection is an exception that is raised when a PII entity with the REJECT action is detected.
+    """
+
+
+class PIIDetector:
+    """
+    PIIDetector detects the PII entities of a prompt guard and masks, hashes or rejects them
+    according to the action of each entity. A detector is shared by all the requests of the
+    routes with the same prompt guard config.
+    """
+
+    def __init__(self, detection: prompt_guard.PIIDetection):
+        self.actions: dict[prompt_guard.PIIEntityType, prompt_guard.PIIAction] = {
+            entity.type: entity.action for entity in detection.entities
+        }
+        self._recognizers: dict[prompt_guard.PIIEntityType, list[EntityRecognizer]] = {
+            entity: _recognizers(entity) for entity in self.actions
+        }
+        self.operators: dict[str, OperatorConfig] = {}
+        for entity, action in self.actions.items():
+            match action:
+                case prompt_guard.PIIAction.MASK:
+                    self.operators[entity.value] = OperatorConfig(
+                        "replace", {"new_value": f"<{entity.value}>"}
+                    )
+                case prompt_guard.PIIAction.HASH:
+                    self.operators[entity.value] = OperatorConfig(
+                        "hash", {"hash_type": "sha256"}
+                    )
+
+    def analyze(self, content: str) -> list[RecognizerResult]:
+        """
+        analyze returns the PII entities detected in the content, with the type of the PII entity
+        of the prompt guard as their entity type.
+        """
+        nlp_artifacts: NlpArtifacts | None = None
+        if any(entity in self.actions for entity in _ner_entities):
+            nlp_artifacts = _get_nlp_engine().process_text(content, "en")
+
+        results = []
+        for entity, recognizers in self._recognizers.items():
+            for recognizer in recognizers:
+                for result in recognizer.analyze(
+                    content,
+                    recognizer.supported_entities,
+                    nlp_artifacts=nlp_artifacts,  # type: ignore
+                ):
+                    results.append(
+                        RecognizerResult(
+                            entity_type=entity.value,
+                            start=result.start,
+                            end=result.end,
+                            score=result.score,
+                        )
+                    )
+        EntityRecognizer.remove_duplicates(results)
+        return results
+
+
+def pii_transform(
+    content: str,
+    detector: PIIDetector | None,
+    anon: AnonymizerEngine,
+    on_detect: (
+        Callable[[prompt_guard.PIIEntityType, prompt_guard.PIIAction], None] | None
+    ) = None,
+) -> str:
+    """
+    pii_transform returns the content with the detected PII entities masked or hashed, and raises
+    PIIRejection if an entity with the REJECT action is detected. on_detect is called for each
+    detected entity.
+    """
+    if detector is None:
+        return content
+
+    results = detector.analyze(content)
+    if len(results) == 0:
+        return content
+
+    rejected = []
+    for result in results:
+        entity = prompt_guard.PIIEntityType(result.entity_type)
+        action = detector.actions[entity]
+        if on_detect is not None:
+            on_detect(entity, action)
+        if action == prompt_guard.PIIAction.REJECT:
+            rejected.append(result)
+    if len(rejected) > 0:
+        # Do not include the content of the entities in the error as it ends up in the logs
+        raise PIIRejection(
+            " ".join(sorted({result.entity_type for result in rejected}))
+        )
+
+    return anon.anonymize(
+        text=content,
+        analyzer_results=results,
+        operators=detector.operators,
+    ).text
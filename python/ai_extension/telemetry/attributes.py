@@ -24,9 +24,17 @@ AI_REGEX_ACTION: Final = "ai.regex.action"
 AI_REGEX_RESULT: Final = "ai.regex.result"
 """Indicates the outcome of the regular expression guard. It's 'reject' if the action is 'reject' and the content was indeed rejected; otherwise, it's 'passed'. This helps quickly identify rejected traffic."""
 
+# PII detection attributes for PromptGuard PII functionality
+AI_PII_RESULT: Final = "ai.pii.result"
+"""Indicates the outcome of the PII detection guard. It's 'reject' if a PII entity with the 'reject' action was detected; otherwise, it's 'passed'."""
+
 # Content moderation attributes
 AI_MODERATION_MODEL: Final = "ai.moderation.model"
 """ Indicates the model used for moderation (e.g., `omni-moderation-latest`), distinct from the main LLM model."""
 
 AI_MODERATION_FLAGGED: Final = "ai.moderation.flagged"
 """A boolean value indicating whether the request was rejected by the moderation guardrails due to content moderation (true or false), serving as a direct measure of moderation effectiveness."""
+
+# Semantic cache attributes
+AI_SEMANTIC_CACHE_HIT: Final = "ai.semantic_cache.hit"
+"""A boolean value indicating whether the response was served from the semantic cache (true or false)."""
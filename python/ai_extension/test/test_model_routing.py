@@ -0,0 +1,54 @@
+from ai_extension.api.kgateway.policy.ai.model_routing import model_routing_from_json
+
+
+class TestModelRouting:
+    def test_from_json(self):
+        routing = model_routing_from_json(
+            """
+            {
+                "routes": [
+                    {"models": ["gpt-4o", "gpt-4o-2024-*"], "provider": "azure-gpt-4o"},
+                    {"models": ["fast"], "model": "gpt-4o-mini"}
+                ],
+                "unknownModelResponse": {"message": "unknown model"}
+            }
+            """
+        )
+        assert len(routing.routes) == 2
+        assert routing.routes[0].provider == "azure-gpt-4o"
+        assert routing.routes[0].model is None
+        assert routing.routes[1].provider is None
+        assert routing.routes[1].model == "gpt-4o-mini"
+        assert routing.unknown_model_response.message == "unknown model"
+        assert routing.unknown_model_response.status_code == 404
+
+    def test_first_matching_route(self):
+        routing = model_routing_from_json(
+            """
+            {
+                "routes": [
+                    {"models": ["gpt-4o-mini"], "provider": "mini"},
+                    {"models": ["gpt-4o*"], "provider": "default"}
+                ]
+            }
+            """
+        )
+        assert routing.route("gpt-4o-mini").provider == "mini"
+        assert routing.route("gpt-4o").provider == "default"
+        assert routing.route("gpt-4o-2024-08-06").provider == "default"
+        assert routing.unknown_model_response is None
+
+    def test_unknown_model(self):
+        routing = model_routing_from_json(
+            '{"routes": [{"models": ["claude-*"], "provider": "anthropic"}]}'
+        )
+        assert routing.route("gpt-4o") is None
+        # the wildcard must match the whole name
+        assert routing.route("my-claude-3") is None
+
+    def test_wildcard_escapes_other_characters(self):
+        routing = model_routing_from_json(
+            '{"routes": [{"models": ["gpt-4.1*"], "model": "gpt-4.1"}]}'
+        )
+        assert routing.route("gpt-4.1-mini") is not None
+        assert routing.route("gpt-401") is None
@@ -0,0 +1,78 @@
+import re
+
+import pytest
+from presidio_anonymizer import AnonymizerEngine
+
+from api.kgateway.policy.ai import prompt_guard
+from guardrails.pii import PIIDetector, PIIRejection, pii_transform
+
+valid_iban = "GB82 WEST 1234 5698 7654 32"
+invalid_iban = "GB82 WEST 1234 5698 7654 33"
+api_key = "sk-proj-abcdefghijklmnopqrstuvwxyz012345"
+
+
+def new_detector(entities: list[dict]) -> PIIDetector:
+    return PIIDetector(prompt_guard.PIIDetection.from_json({"entities": entities}))
+
+
+class TestPII:
+    def test_from_json(self):
+        guard = prompt_guard.req_from_json(
+            '{"pii": {"entities": [{"type": "IBAN"}, {"type": "API_KEY", "action": "REJECT"}]}}'
+        )
+        assert guard.pii == prompt_guard.PIIDetection(
+            entities=[
+                prompt_guard.PIIEntity(
+                    type=prompt_guard.PIIEntityType.IBAN,
+                    action=prompt_guard.PIIAction.MASK,
+                ),
+                prompt_guard.PIIEntity(
+                    type=prompt_guard.PIIEntityType.API_KEY,
+                    action=prompt_guard.PIIAction.REJECT,
+                ),
+            ]
+        )
+        assert prompt_guard.resp_from_json("{}").pii is None
+
+    def test_mask(self):
+        detector = new_detector([{"type": "IBAN"}, {"type": "API_KEY"}])
+        detected = []
+        content = pii_transform(
+            f"pay to {valid_iban} with the key {api_key}",
+            detector,
+            AnonymizerEngine(),
+            lambda entity, action: detected.append((entity, action)),
+        )
+        assert content == "pay to <IBAN> with the key <API_KEY>"
+        assert sorted(detected, key=lambda d: d[0].value) == [
+            (prompt_guard.PIIEntityType.API_KEY, prompt_guard.PIIAction.MASK),
+            (prompt_guard.PIIEntityType.IBAN, prompt_guard.PIIAction.MASK),
+        ]
+
+    def test_checksum_validation(self):
+        detector = new_detector([{"type": "IBAN"}])
+        content = f"pay to {invalid_iban}"
+        assert pii_transform(content, detector, AnonymizerEngine()) == content
+
+    def test_hash(self):
+        detector = new_detector([{"type": "API_KEY", "action": "HASH"}])
+        content = pii_transform(f"the key is {api_key}", detector, AnonymizerEngine())
+        assert api_key not in content
+        assert re.fullmatch(r"the key is [0-9a-f]{64}", content)
+
+    def test_reject(self):
+        detector = new_detector([{"type": "IBAN"}, {"type": "API_KEY", "action": "REJECT"}])
+        detected = []
+        with pytest.raises(PIIRejection) as exc:
+            pii_transform(
+                f"pay to {valid_iban} with the key {api_key}",
+                detector,
+                AnonymizerEngine(),
+                lambda entity, action: detected.append((entity, action)),
+            )
+        # the rejection does not leak the detected entities
+        assert str(exc.value) == "API_KEY"
+        assert len(detected) == 2
+
+    def test_no_detector(self):
+        assert pii_transform("content", None, AnonymizerEngine()) == "content"
@@ -0,0 +1,54 @@
+from ai_extension.ext_proc.ratelimit import TokenBucket
+
+
+class FakeClock:
+    def __init__(self):
+        self.now = 0.0
+
+    def __call__(self) -> float:
+        return self.now
+
+
+class TestTokenBucket:
+    def test_from_json(self):
+        bucket = TokenBucket.from_json(
+            '{"maxTokens": 100, "tokensPerFill": 10, "fillIntervalMs": 60000}'
+        )
+        assert bucket.max_tokens == 100
+        assert bucket.tokens_per_fill == 10
+        assert bucket.fill_interval_ms == 60000
+        assert bucket.has_tokens()
+
+    def test_consume_until_empty(self):
+        bucket = TokenBucket(
+            max_tokens=100, tokens_per_fill=10, fill_interval_ms=1000, clock=FakeClock()
+        )
+        bucket.consume(60)
+        assert bucket.has_tokens()
+        bucket.consume(60)
+        assert not bucket.has_tokens()
+
+    def test_refill(self):
+        clock = FakeClock()
+        bucket = TokenBucket(
+            max_tokens=100, tokens_per_fill=10, fill_interval_ms=1000, clock=clock
+        )
+        bucket.consume(105)
+        assert not bucket.has_tokens()
+
+        # a partial interval does not refill the bucket
+        clock.now = 0.5
+        assert not bucket.has_tokens()
+
+        clock.now = 1.0
+        assert bucket.has_tokens()
+
+    def test_refill_is_capped(self):
+        clock = FakeClock()
+        bucket = TokenBucket(
+            max_tokens=100, tokens_per_fill=10, fill_interval_ms=1000, clock=clock
+        )
+        bucket.consume(50)
+        clock.now = 3600.0
+        bucket.consume(100)
+        assert not bucket.has_tokens()
@@ -0,0 +1,168 @@
+import pytest
+
+from ext_proc.provider import (
+    OpenAI,
+    OpenAICompletions,
+    OpenAIEmbeddings,
+    OpenAIImages,
+    OpenAIResponses,
+    provider_for_route_type,
+    route_type_from_path,
+)
+from ext_proc.stream import Handler as StreamHandler
+from ext_proc.streamchunkdata import StreamChunkDataType
+from guardrails import api as webhook_api
+
+
+@pytest.mark.parametrize(
+    "path, route_type",
+    [
+        ("/v1/chat/completions", "CHAT"),
+        ("/v1/completions", "COMPLETIONS"),
+        ("/v1/embeddings?api-version=2024-10-21", "EMBEDDINGS"),
+        ("/openai/deployments/gpt-4o/embeddings", "EMBEDDINGS"),
+        ("/v1/responses/", "RESPONSES"),
+        ("/v1/images/generations", "IMAGES"),
+        ("/v1/models", "CHAT"),
+    ],
+)
+def test_route_type_from_path(path, route_type):
+    assert route_type_from_path(path) == route_type
+
+
+def test_provider_for_route_type():
+    assert type(provider_for_route_type("CHAT")) is OpenAI
+    assert type(provider_for_route_type("EMBEDDINGS")) is OpenAIEmbeddings
+
+
+def test_handler_route_type():
+    handler = StreamHandler.from_metadata(
+        {"x-llm-provider": "openai", "x-route-type": "RESPONSES"}
+    )
+    assert isinstance(handler.provider, OpenAIResponses)
+    assert handler.get_operation_name() == "chat"
+
+    # only the OpenAI compatible providers support the other route types
+    handler = StreamHandler.from_metadata(
+        {"x-llm-provider": "gemini", "x-route-type": "EMBEDDINGS"}
+    )
+    assert not isinstance(handler.provider, OpenAI)
+
+
+def test_completions():
+    provider = OpenAICompletions()
+    body = {"model": "gpt-3.5-turbo-instruct", "prompt": ["hello", "world"]}
+    provider.iterate_str_req_messages(body, lambda role, content: content.upper())
+    assert body["prompt"] == ["HELLO", "WORLD"]
+    assert provider.get_num_tokens_from_body(body) > 0
+
+    resp = {
+        "choices": [{"index": 0, "text": "hi there", "finish_reason": "stop"}],
+        "usage": {"prompt_tokens": 2, "completion_tokens": 3, "total_tokens": 5},
+    }
+    provider.iterate_str_resp_messages(resp, lambda role, content: content.upper())
+    assert resp["choices"][0]["text"] == "HI THERE"
+    tokens = provider.tokens(resp)
+    assert tokens.prompt == 2
+    assert tokens.completion == 3
+
+    chunk = {"choices": [{"index": 0, "text": "hi", "finish_reason": None}]}
+    assert provider.extract_contents_from_resp_chunk(chunk) == [b"hi"]
+    assert provider.get_stream_resp_chunk_type(chunk) == StreamChunkDataType.NORMAL_TEXT
+    provider.update_stream_resp_contents(chunk, 0, b"ho")
+    assert chunk["choices"][0]["text"] == "ho"
+
+
+def test_embeddings():
+    provider = OpenAIEmbeddings()
+    body = {"model": "text-embedding-3-small", "input": "my email is a@b.com"}
+    webhook_body = provider.construct_request_webhook_request_body(body)
+    assert webhook_body.messages[0].content == "my email is a@b.com"
+    provider.update_request_body_from_webhook(
+        body,
+        webhook_api.PromptMessages(
+            messages=[webhook_api.Message(role="user", content="my email is <EMAIL>")]
+        ),
+    )
+    assert body["input"] == "my email is <EMAIL>"
+
+    # token ids count as one token each
+    assert provider.get_num_tokens_from_body({"input": [[1, 2, 3], [4]]}) == 4
+
+    resp = {
+        "data": [{"embedding": [0.1, 0.2], "index": 0}],
+        "usage": {"prompt_tokens": 8, "total_tokens": 8},
+    }
+    assert provider.tokens(resp).prompt == 8
+    assert len(provider.construct_response_webhook_request_body(resp).choices) == 0
+
+
+def test_responses():
+    provider = OpenAIResponses()
+    body = {
+        "model": "gpt-4o",
+        "instructions": "be nice",
+        "input": [
+            {"role": "user", "content": "hello"},
+            {"role": "user", "content": [{"type": "input_text", "text": "world"}]},
+            {"type": "function_call_output", "call_id": "1", "output": "{}"},
+        ],
+    }
+    provider.iterate_str_req_messages(body, lambda role, content: content.upper())
+    assert body["instructions"] == "BE NICE"
+    assert body["input"][0]["content"] == "HELLO"
+    assert body["input"][1]["content"][0]["text"] == "WORLD"
+    assert provider.all_req_content(body) == (
+        "role: system:\nBE NICE\nrole: user:\nHELLO\nrole: user:\nWORLD"
+    )
+    assert len(provider.construct_request_webhook_request_body(body).messages) == 3
+
+    resp = {
+        "id": "resp_1",
+        "model": "gpt-4o-2024-08-06",
+        "output": [
+            {
+                "type": "message",
+                "role": "assistant",
+                "content": [{"type": "output_text", "text": "hi there"}],
+            }
+        ],
+        "usage": {
+            "input_tokens": 10,
+            "output_tokens": 4,
+            "output_tokens_details": {"reasoning_tokens": 1},
+        },
+    }
+    provider.iterate_str_resp_messages(resp, lambda role, content: content.upper())
+    assert resp["output"][0]["content"][0]["text"] == "HI THERE"
+    tokens = provider.tokens(resp)
+    assert tokens.prompt == 10
+    assert tokens.completion == 4
+    assert tokens.completion_details.reasoning == 1
+    assert not provider.has_function_call_finish_reason(resp)
+
+    delta = {"type": "response.output_text.delta", "output_index": 0, "delta": "hi"}
+    assert provider.extract_contents_from_resp_chunk(delta) == [b"hi"]
+    assert provider.get_stream_resp_chunk_type(delta) == StreamChunkDataType.NORMAL_TEXT
+    provider.update_stream_resp_contents(delta, 0, b"ho")
+    assert delta["delta"] == "ho"
+
+    completed = {"type": "response.completed", "response": resp}
+    assert provider.tokens(completed).total_tokens() == 14
+    assert provider.get_model_resp(completed) == "gpt-4o-2024-08-06"
+
+
+def test_images():
+    provider = OpenAIImages()
+    body = {"model": "gpt-image-1", "prompt": "a cat"}
+    assert provider.all_req_content(body) == "a cat"
+    provider.iterate_str_req_messages(body, lambda role, content: content.upper())
+    assert body["prompt"] == "A CAT"
+
+    resp = {
+        "data": [{"b64_json": "abc", "revised_prompt": "a cute cat"}],
+        "usage": {"input_tokens": 5, "output_tokens": 100, "total_tokens": 105},
+    }
+    provider.iterate_str_resp_messages(resp, lambda role, content: content.upper())
+    assert resp["data"][0]["revised_prompt"] == "A CUTE CAT"
+    assert provider.tokens(resp).completion == 100
@@ -0,0 +1,203 @@
+import asyncio
+import re
+from types import SimpleNamespace
+
+import numpy as np
+from redis.exceptions import ResponseError
+
+from api.kgateway.policy.ai.semantic_cache import semantic_cache_from_json
+from ext_proc.semantic_cache import SemanticCache, escape_tag, partition_tag
+
+
+class FakeEmbeddings:
+    def __init__(self, embeddings: dict[str, list[float]]):
+        self.embeddings = embeddings
+
+    async def create(self, input: str, model: str):
+        return SimpleNamespace(
+            data=[SimpleNamespace(embedding=self.embeddings[input])]
+        )
+
+
+class FakePipeline:
+    def __init__(self, redis: "FakeRedis"):
+        self.redis = redis
+
+    async def __aenter__(self):
+        return self
+
+    async def __aexit__(self, *args):
+        pass
+
+    def hset(self, key: str, mapping: dict):
+        self.redis.hashes[key] = mapping
+
+    def expire(self, key: str, seconds: int):
+        self.redis.ttls[key] = seconds
+
+    async def execute(self):
+        pass
+
+
+class FakeRedis:
+    """
+    FakeRedis implements the commands of the Redis Query Engine used by the semantic
+    cache, with an exact KNN search over the stored hashes.
+    """
+
+    def __init__(self):
+        self.indexes: dict[str, list] = {}
+        self.hashes: dict[str, dict] = {}
+        self.ttls: dict[str, int] = {}
+
+    def pipeline(self, transaction: bool):
+        return FakePipeline(self)
+
+    async def execute_command(self, *args):
+        match args[0]:
+            case "FT.INFO":
+                if args[1] not in self.indexes:
+                    raise ResponseError("Unknown index name")
+                return []
+            case "FT.CREATE":
+                self.indexes[args[1]] = list(args[2:])
+                return "OK"
+            case "FT.SEARCH":
+                tags = {
+                    name: value.replace("\\", "")
+                    for name, value in re.findall(
+                        r"@(\w+):\{((?:\\.|[^}])*)\}", args[2]
+                    )
+                }
+                vec = np.frombuffer(args[6], dtype=np.float32)
+                best = None
+                for key, fields in self.hashes.items():
+                    if any(fields[name] != value for name, value in tags.items()):
+                        continue
+                    stored = np.frombuffer(fields["embedding"], dtype=np.float32)
+                    distance = 1 - float(
+                        np.dot(vec, stored)
+                        / (np.linalg.norm(vec) * np.linalg.norm(stored))
+                    )
+                    if best is None or distance < best[1]:
+                        best = (key, distance)
+                if best is None:
+                    return [0]
+                key, distance = best
+                return [
+                    1,
+                    key.encode(),
+                    [
+                        b"distance",
+                        str(distance).encode(),
+                        b"response",
+                        self.hashes[key]["response"],
+                    ],
+                ]
+
+
+def new_cache(redis: FakeRedis, threshold: float = 0.9) -> SemanticCache:
+    config = semantic_cache_from_json(
+        f"""
+        {{
+            "redis": {{"host": "redis", "port": 6379, "index": "cache"}},
+            "embedding": {{"openai": {{"authToken": "token", "model": "embed"}}}},
+            "similarityThreshold": {threshold},
+            "ttlSeconds": 60
+        }}
+        """
+    )
+    embeddings = FakeEmbeddings(
+        {
+            "what is the capital of France?": [1.0, 0.0, 0.0],
+            "what's the capital of France?": [0.99, 0.1, 0.0],
+            "how tall is the Eiffel tower?": [0.0, 1.0, 0.0],
+        }
+    )
+    return SemanticCache(config, redis=redis, embeddings=embeddings)
+
+
+class TestSemanticCache:
+    def test_from_json(self):
+        config = semantic_cache_from_json(
+            '{"redis": {"host": "redis.default.svc.cluster.local", "port": 6380},'
+            ' "embedding": {"openai": {"authToken": "token"}}}'
+        )
+        assert config.redis.host == "redis.default.svc.cluster.local"
+        assert config.redis.port == 6380
+        assert config.redis.index == "kgateway-semantic-cache"
+        assert config.redis.password is None
+        assert config.embedding.openai.auth_token == "token"
+        assert config.embedding.openai.model == "text-embedding-3-small"
+        assert config.similarity_threshold == 0.95
+        assert config.ttl_seconds == 3600
+        assert not config.partition_by_key
+
+        config = semantic_cache_from_json(
+            '{"redis": {"host": "redis", "port": 6379},'
+            ' "embedding": {"openai": {"authToken": "token"}}, "partitionByKey": true}'
+        )
+        assert config.partition_by_key
+
+    def test_escape_tag(self):
+        assert escape_tag("gpt-4o") == "gpt\\-4o"
+        assert escape_tag("gpt_4") == "gpt_4"
+
+    def test_similar_prompt_hit(self):
+        redis = FakeRedis()
+        cache = new_cache(redis)
+
+        async def run():
+            embedding = await cache.embed("what is the capital of France?")
+            assert await cache.lookup("p", "gpt-4o", embedding) is None
+            await cache.store("p", "gpt-4o", embedding, b'{"answer": "Paris"}')
+
+            similar = await cache.embed("what's the capital of France?")
+            assert await cache.lookup("p", "gpt-4o", similar) == b'{"answer": "Paris"}'
+
+            # responses are only served for the model of the cached request
+            assert await cache.lookup("p", "gpt-4o-mini", similar) is None
+
+            different = await cache.embed("how tall is the Eiffel tower?")
+            assert await cache.lookup("p", "gpt-4o", different) is None
+
+        asyncio.run(run())
+        assert "DIM" in redis.indexes["cache"]
+        assert redis.indexes["cache"][redis.indexes["cache"].index("DIM") + 1] == "3"
+        assert list(redis.ttls.values()) == [60]
+
+    def test_similarity_threshold(self):
+        redis = FakeRedis()
+        cache = new_cache(redis, threshold=1.0)
+
+        async def run():
+            embedding = await cache.embed("what is the capital of France?")
+            await cache.store("p", "gpt-4o", embedding, b'{"answer": "Paris"}')
+            similar = await cache.embed("what's the capital of France?")
+            assert await cache.lookup("p", "gpt-4o", similar) is None
+
+        asyncio.run(run())
+
+    def test_partitions(self):
+        redis = FakeRedis()
+        cache = new_cache(redis)
+        route_a = "default/policy;gateway.networking.k8s.io/HTTPRoute/default/a;ai"
+        route_b = "default/policy;gateway.networking.k8s.io/HTTPRoute/default/b;ai"
+        assert partition_tag(route_a) != partition_tag(route_b)
+        assert partition_tag(route_a, "tenant-1") != partition_tag(route_a, "tenant-2")
+
+        async def run():
+            embedding = await cache.embed("what is the capital of France?")
+            tenant = partition_tag(route_a, "tenant-1")
+            await cache.store(tenant, "gpt-4o", embedding, b'{"answer": "Paris"}')
+            cached = await cache.lookup(tenant, "gpt-4o", embedding)
+            assert cached == b'{"answer": "Paris"}'
+
+            # responses are only served to the requests of the partition of the cached one
+            other_tenant = partition_tag(route_a, "tenant-2")
+            assert await cache.lookup(other_tenant, "gpt-4o", embedding) is None
+            other_route = partition_tag(route_b, "tenant-1")
+            assert await cache.lookup(other_route, "gpt-4o", embedding) is None
+
+        asyncio.run(run())
+        assert "partition" in redis.indexes["cache"]
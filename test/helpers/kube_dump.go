@@ -132,6 +132,7 @@ func recordKubeState(ctx context.Context, kubectlCli *kubectl.Cli, f *os.File) {
 		"gatewayextensions.gateway.kgateway.dev",
 		"gatewayparameters.gateway.kgateway.dev",
 		"httplistenerpolicies.gateway.kgateway.dev",
+		"tcppolicies.gateway.kgateway.dev",
 		"trafficpolicies.gateway.kgateway.dev",
 	}
 
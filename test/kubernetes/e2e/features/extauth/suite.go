@@ -8,9 +8,11 @@ import (
 
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/suite"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/requestutils/curl"
 	testmatchers "github.com/kgateway-dev/kgateway/v2/test/gomega/matchers"
@@ -277,6 +279,31 @@ func (s *testingSuite) TestRouteTargetedExtAuthPolicy() {
 	}
 }
 
+// TestShadowExtAuthPolicy tests that a policy with ExtAuth in shadow mode is not accepted, since
+// ExtAuth does not support shadow mode
+func (s *testingSuite) TestShadowExtAuthPolicy() {
+	s.T().Cleanup(func() {
+		err := s.testInstallation.Actions.Kubectl().DeleteFileSafe(s.ctx, shadowRouteManifest)
+		s.Require().NoError(err)
+		s.testInstallation.Assertions.EventuallyObjectsNotExist(s.ctx, shadowRoute, shadowTrafficPolicy)
+	})
+	err := s.testInstallation.Actions.Kubectl().ApplyFile(s.ctx, shadowRouteManifest)
+	s.Require().NoError(err, "can apply "+shadowRouteManifest)
+	s.testInstallation.Assertions.EventuallyObjectsExist(s.ctx, shadowRoute, shadowTrafficPolicy)
+
+	s.testInstallation.Assertions.Gomega.Eventually(func(g gomega.Gomega) {
+		tp := &v1alpha1.TrafficPolicy{}
+		err := s.testInstallation.ClusterContext.Client.Get(s.ctx, client.ObjectKeyFromObject(shadowTrafficPolicy), tp)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(tp.Status.Ancestors).To(gomega.HaveLen(1))
+		cond := meta.FindStatusCondition(tp.Status.Ancestors[0].Conditions, string(v1alpha1.PolicyConditionAccepted))
+		g.Expect(cond).NotTo(gomega.BeNil())
+		g.Expect(cond.Status).To(gomega.Equal(metav1.ConditionFalse))
+		g.Expect(cond.Reason).To(gomega.Equal(string(v1alpha1.PolicyReasonInvalid)))
+		g.Expect(cond.Message).To(gomega.ContainSubstring("extAuth does not support shadow mode"))
+	}).WithTimeout(time.Minute).Should(gomega.Succeed())
+}
+
 func (s *testingSuite) ensureBasicRunning() {
 	s.testInstallation.Assertions.EventuallyPodsRunning(s.ctx, testdefaults.CurlPod.GetNamespace(), metav1.ListOptions{
 		LabelSelector: "app.kubernetes.io/name=curl",
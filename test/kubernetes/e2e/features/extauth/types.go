@@ -109,6 +109,18 @@ var (
 			Namespace: "kgateway-test",
 		},
 	}
+	shadowRoute = &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route-example-shadow",
+			Namespace: "kgateway-test",
+		},
+	}
+	shadowTrafficPolicy = &v1alpha1.TrafficPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shadow-route-policy",
+			Namespace: "kgateway-test",
+		},
+	}
 
 	// Manifest files
 	gatewayWithRouteManifest     = getTestFile("common.yaml")
@@ -117,6 +129,7 @@ var (
 	securedGatewayPolicyManifest = getTestFile("secured-gateway-policy.yaml")
 	securedRouteManifest         = getTestFile("secured-route.yaml")
 	insecureRouteManifest        = getTestFile("insecure-route.yaml")
+	shadowRouteManifest          = getTestFile("shadow-route.yaml")
 )
 
 func getTestFile(filename string) string {
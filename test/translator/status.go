@@ -24,6 +24,7 @@ type Statuses struct {
 	HTTPRoutes   map[string]*gwv1.RouteStatus        `json:"httpRoutes,omitempty"`
 	TCPRoutes    map[string]*gwv1.RouteStatus        `json:"tcpRoutes,omitempty"`
 	TLSRoutes    map[string]*gwv1.RouteStatus        `json:"tlsRoutes,omitempty"`
+	UDPRoutes    map[string]*gwv1.RouteStatus        `json:"udpRoutes,omitempty"`
 	GRPCRoutes   map[string]*gwv1.RouteStatus        `json:"grpcRoutes,omitempty"`
 	Policies     map[string]*gwv1.PolicyStatus       `json:"policies,omitempty"`
 }
@@ -46,6 +47,7 @@ func buildStatusesFromReports(
 		HTTPRoutes:   make(map[string]*gwv1.RouteStatus),
 		TCPRoutes:    make(map[string]*gwv1.RouteStatus),
 		TLSRoutes:    make(map[string]*gwv1.RouteStatus),
+		UDPRoutes:    make(map[string]*gwv1.RouteStatus),
 		GRPCRoutes:   make(map[string]*gwv1.RouteStatus),
 		Policies:     make(map[string]*gwv1.PolicyStatus),
 	}
@@ -134,6 +136,20 @@ func buildStatusesFromReports(
 		}
 	}
 
+	// Build UDPRoute statuses
+	for routeNN := range reportsMap.UDPRoutes {
+		route := gwv1a2.UDPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      routeNN.Name,
+				Namespace: routeNN.Namespace,
+			},
+		}
+		if status := reportsMap.BuildRouteStatus(ctx, &route, wellknown.DefaultGatewayClassName); status != nil {
+			normalizeRouteStatus(status, fixedTime)
+			statuses.UDPRoutes[routeNN.String()] = status
+		}
+	}
+
 	// Build GRPCRoute statuses
 	for routeNN := range reportsMap.GRPCRoutes {
 		route := gwv1.GRPCRoute{
@@ -243,6 +259,7 @@ func sortStatuses(statuses *Statuses) *Statuses {
 		HTTPRoutes:   make(map[string]*gwv1.RouteStatus),
 		TCPRoutes:    make(map[string]*gwv1.RouteStatus),
 		TLSRoutes:    make(map[string]*gwv1.RouteStatus),
+		UDPRoutes:    make(map[string]*gwv1.RouteStatus),
 		GRPCRoutes:   make(map[string]*gwv1.RouteStatus),
 		Policies:     make(map[string]*gwv1.PolicyStatus),
 	}
@@ -594,11 +594,13 @@ func (tc TestCase) Run(
 		gvr.Pod,
 		gvr.TCPRoute,
 		gvr.TLSRoute,
+		gvr.UDPRoute,
 		gvr.ServiceEntry,
 		gvr.WorkloadEntry,
 		gvr.AuthorizationPolicy,
 		wellknown.XListenerSetGVR,
 		wellknown.BackendTLSPolicyGVR,
+		wellknown.XBackendTrafficPolicyGVR,
 	} {
 		clienttest.MakeCRDWithAnnotations(t, cli, crd, map[string]string{
 			consts.BundleVersionAnnotation: consts.BundleVersion,